@@ -7,25 +7,50 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Haussmann000/tfimport/internal/aws/iam"
 	"github.com/Haussmann000/tfimport/internal/di"
 )
 
 func main() {
-	var resourceTypes, resourceName, clusterName, serviceName, securityGroupID, dbClusterIdentifier, dbInstanceIdentifier, bucketName string
-	flag.StringVar(&resourceTypes, "resource-types", "", "aws resource type. s3, vpc, ecs, elbv2, iam, security_group, rds")
-	flag.StringVar(&resourceName, "resource-name", "", "aws resource name (for vpc, elbv2, iam, rds parameter group)")
+	var resourceTypes, resourceName, iamPolicyFormat, iamServiceLinkedRoles, clusterName, serviceName, securityGroupID, dbClusterIdentifier, dbInstanceIdentifier, bucketName, lambdaCodeBucket, apiGatewayExportStage, instanceIDs, vpcID, launchTemplateVersion string
+	var securityGroupInline, networkAclRules, targetGroupAttachments, iamAuthorizationDetails, apiGatewayOpenAPIBody bool
+	var iamConcurrency int
+	flag.StringVar(&resourceTypes, "resource-types", "", "aws resource type. s3, vpc, ec2_instance, nacl, dhcp_options, flow_log, vpc_endpoint, vpc_peering, transit_gateway, launch_template, autoscaling_group, ecs, elbv2, target_group, classic_elb, iam, iam_provider, iam_user, iam_group, security_group, rds, rds_proxy, rds_global_cluster, rds_event_subscription, lambda, api_gateway, apigatewayv2")
+	flag.StringVar(&resourceName, "resource-name", "", "aws resource name (for vpc, ec2_instance, nacl, dhcp_options, flow_log, vpc_endpoint, vpc_peering, transit_gateway, launch_template, autoscaling_group, elbv2, target_group, classic_elb, iam, iam_provider, iam_user, iam_group, rds parameter group, rds_proxy, rds_global_cluster, rds_event_subscription, lambda, api_gateway, apigatewayv2)")
 	flag.StringVar(&bucketName, "bucket-name", "", "s3 bucket name")
-	flag.StringVar(&clusterName, "cluster-name", "", "ecs cluster name")
+	flag.StringVar(&clusterName, "cluster-name", "", "ecs cluster name (all clusters when omitted)")
 	flag.StringVar(&serviceName, "service-name", "", "ecs service name")
 	flag.StringVar(&securityGroupID, "security-group-id", "", "comma separated security group ids")
+	flag.BoolVar(&securityGroupInline, "security-group-inline-rules", false, "render security group rules as inline ingress/egress blocks instead of separate rule resources")
+	flag.BoolVar(&networkAclRules, "network-acl-rule-resources", false, "render network acl rules as aws_network_acl_rule resources instead of inline blocks")
+	flag.StringVar(&instanceIDs, "instance-id", "", "comma separated ec2 instance ids")
+	flag.StringVar(&vpcID, "vpc-id", "", "vpc id to filter ec2 instances")
+	flag.BoolVar(&targetGroupAttachments, "target-group-attachments", false, "render registered targets of instance/ip target groups as aws_lb_target_group_attachment resources")
+	flag.StringVar(&iamPolicyFormat, "iam-policy-format", "string", "iam policy document format. string, jsonencode or policy_document")
+	flag.StringVar(&iamServiceLinkedRoles, "iam-service-linked-roles", "skip", "how to handle service-linked roles. skip or emit (as aws_iam_service_linked_role)")
+	flag.IntVar(&iamConcurrency, "iam-concurrency", iam.DefaultConcurrency, "max number of concurrent IAM API calls")
+	flag.BoolVar(&iamAuthorizationDetails, "iam-authorization-details", false, "fetch roles and policies in bulk with GetAccountAuthorizationDetails")
+	flag.StringVar(&launchTemplateVersion, "launch-template-version", "latest", "launch template version to export. latest or default")
 	flag.StringVar(&dbClusterIdentifier, "db-cluster-identifier", "", "rds db cluster identifier")
 	flag.StringVar(&dbInstanceIdentifier, "db-instance-identifier", "", "rds db instance identifier")
+	flag.BoolVar(&apiGatewayOpenAPIBody, "api-gateway-openapi-body", false, "export rest apis as an openapi body instead of discrete resources, methods and integrations. the body is the deployed definition of a stage and overwrites the live api on apply")
+	flag.StringVar(&apiGatewayExportStage, "api-gateway-export-stage", "", "stage to export the openapi body from (required when stages refer to different deployments)")
+	flag.StringVar(&lambdaCodeBucket, "lambda-code-bucket", "", "s3 bucket to reference lambda function code from (local filename placeholder when omitted)")
 
 	flag.Parse()
 
 	if resourceTypes == "" {
 		log.Fatal("resource-types is required")
 	}
+	if iamPolicyFormat != "string" && iamPolicyFormat != "jsonencode" && iamPolicyFormat != "policy_document" {
+		log.Fatalf("unsupported iam-policy-format: %s", iamPolicyFormat)
+	}
+	if iamConcurrency < 1 {
+		log.Fatalf("iam-concurrency must be at least 1: %d", iamConcurrency)
+	}
+	if iamServiceLinkedRoles != "skip" && iamServiceLinkedRoles != "emit" {
+		log.Fatalf("unsupported iam-service-linked-roles: %s", iamServiceLinkedRoles)
+	}
 
 	ctx := context.Background()
 	app, err := di.BuildApp(ctx)
@@ -34,14 +59,27 @@ func main() {
 	}
 
 	options := di.RunOptions{
-		ResourceTypes:        strings.Split(resourceTypes, ","),
-		ResourceName:         resourceName,
-		BucketName:           bucketName,
-		ClusterName:          clusterName,
-		ServiceName:          serviceName,
-		SecurityGroupID:      securityGroupID,
-		DBClusterIdentifier:  dbClusterIdentifier,
-		DBInstanceIdentifier: dbInstanceIdentifier,
+		ResourceTypes:           strings.Split(resourceTypes, ","),
+		ResourceName:            resourceName,
+		BucketName:              bucketName,
+		ClusterName:             clusterName,
+		ServiceName:             serviceName,
+		SecurityGroupID:         securityGroupID,
+		SecurityGroupInline:     securityGroupInline,
+		NetworkAclRules:         networkAclRules,
+		InstanceIDs:             instanceIDs,
+		VpcID:                   vpcID,
+		LaunchTemplateVersion:   launchTemplateVersion,
+		DBClusterIdentifier:     dbClusterIdentifier,
+		DBInstanceIdentifier:    dbInstanceIdentifier,
+		TargetGroupAttachments:  targetGroupAttachments,
+		IamPolicyFormat:         iamPolicyFormat,
+		IamServiceLinkedRoles:   iamServiceLinkedRoles,
+		IamConcurrency:          iamConcurrency,
+		IamAuthorizationDetails: iamAuthorizationDetails,
+		LambdaCodeBucket:        lambdaCodeBucket,
+		ApiGatewayOpenAPIBody:   apiGatewayOpenAPIBody,
+		ApiGatewayExportStage:   apiGatewayExportStage,
 	}
 
 	if err := app.Run(ctx, options); err != nil {
@@ -0,0 +1,180 @@
+package apigateway
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+)
+
+// APIGatewayRepositoryInterface はAPI Gateway (REST API) リソースへのアクセスを抽象化します。
+type APIGatewayRepositoryInterface interface {
+	GetRestApis(ctx context.Context) ([]types.RestApi, error)
+	GetResources(ctx context.Context, restAPIID string) ([]types.Resource, error)
+	GetStages(ctx context.Context, restAPIID string) ([]types.Stage, error)
+	GetDeployments(ctx context.Context, restAPIID string) ([]types.Deployment, error)
+	GetExport(ctx context.Context, restAPIID string, stageName string) ([]byte, error)
+	GetUsagePlans(ctx context.Context) ([]types.UsagePlan, error)
+	GetUsagePlanKeys(ctx context.Context, usagePlanID string) ([]types.UsagePlanKey, error)
+	GetApiKeys(ctx context.Context) ([]types.ApiKey, error)
+	GetDomainNames(ctx context.Context) ([]types.DomainName, error)
+	GetBasePathMappings(ctx context.Context, domainName string) ([]types.BasePathMapping, error)
+}
+
+// APIGatewayRepository はAPIGatewayRepositoryInterfaceを実装します。
+type APIGatewayRepository struct {
+	client *apigateway.Client
+}
+
+// NewAPIGatewayRepository は新しいAPIGatewayRepositoryを生成します。
+func NewAPIGatewayRepository(client *apigateway.Client) *APIGatewayRepository {
+	return &APIGatewayRepository{client: client}
+}
+
+// GetRestApis はAWSからREST APIのリストを取得します。
+func (r *APIGatewayRepository) GetRestApis(ctx context.Context) ([]types.RestApi, error) {
+	var apis []types.RestApi
+	paginator := apigateway.NewGetRestApisPaginator(r.client, &apigateway.GetRestApisInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, output.Items...)
+	}
+	return apis, nil
+}
+
+// GetResources はREST APIのリソースを、メソッドと統合を埋め込んだ状態で取得します。
+func (r *APIGatewayRepository) GetResources(ctx context.Context, restAPIID string) ([]types.Resource, error) {
+	var resources []types.Resource
+	paginator := apigateway.NewGetResourcesPaginator(r.client, &apigateway.GetResourcesInput{
+		RestApiId: aws.String(restAPIID),
+		Embed:     []string{"methods"},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, output.Items...)
+	}
+	return resources, nil
+}
+
+// GetStages はREST APIのステージを取得します。
+func (r *APIGatewayRepository) GetStages(ctx context.Context, restAPIID string) ([]types.Stage, error) {
+	output, err := r.client.GetStages(ctx, &apigateway.GetStagesInput{
+		RestApiId: aws.String(restAPIID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Item, nil
+}
+
+// GetDeployments はREST APIのデプロイメントを取得します。
+func (r *APIGatewayRepository) GetDeployments(ctx context.Context, restAPIID string) ([]types.Deployment, error) {
+	var deployments []types.Deployment
+	paginator := apigateway.NewGetDeploymentsPaginator(r.client, &apigateway.GetDeploymentsInput{
+		RestApiId: aws.String(restAPIID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, output.Items...)
+	}
+	return deployments, nil
+}
+
+// GetExport はステージにデプロイされたREST APIを、API Gateway拡張を含むOpenAPI 3.0のJSONとしてエクスポートします。
+func (r *APIGatewayRepository) GetExport(ctx context.Context, restAPIID string, stageName string) ([]byte, error) {
+	output, err := r.client.GetExport(ctx, &apigateway.GetExportInput{
+		RestApiId:  aws.String(restAPIID),
+		StageName:  aws.String(stageName),
+		ExportType: aws.String("oas30"),
+		Accepts:    aws.String("application/json"),
+		Parameters: map[string]string{"extensions": "apigateway"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Body, nil
+}
+
+// GetUsagePlans は使用量プランのリストを取得します。
+func (r *APIGatewayRepository) GetUsagePlans(ctx context.Context) ([]types.UsagePlan, error) {
+	var plans []types.UsagePlan
+	paginator := apigateway.NewGetUsagePlansPaginator(r.client, &apigateway.GetUsagePlansInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, output.Items...)
+	}
+	return plans, nil
+}
+
+// GetUsagePlanKeys は使用量プランに関連付けられたAPIキーを取得します。
+func (r *APIGatewayRepository) GetUsagePlanKeys(ctx context.Context, usagePlanID string) ([]types.UsagePlanKey, error) {
+	var keys []types.UsagePlanKey
+	paginator := apigateway.NewGetUsagePlanKeysPaginator(r.client, &apigateway.GetUsagePlanKeysInput{
+		UsagePlanId: aws.String(usagePlanID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, output.Items...)
+	}
+	return keys, nil
+}
+
+// GetApiKeys はAPIキーのリストを取得します。キーの値は取得しません。
+func (r *APIGatewayRepository) GetApiKeys(ctx context.Context) ([]types.ApiKey, error) {
+	var keys []types.ApiKey
+	paginator := apigateway.NewGetApiKeysPaginator(r.client, &apigateway.GetApiKeysInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, output.Items...)
+	}
+	return keys, nil
+}
+
+// GetDomainNames はカスタムドメイン名のリストを取得します。
+func (r *APIGatewayRepository) GetDomainNames(ctx context.Context) ([]types.DomainName, error) {
+	var domains []types.DomainName
+	paginator := apigateway.NewGetDomainNamesPaginator(r.client, &apigateway.GetDomainNamesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, output.Items...)
+	}
+	return domains, nil
+}
+
+// GetBasePathMappings はカスタムドメイン名のベースパスマッピングを取得します。
+func (r *APIGatewayRepository) GetBasePathMappings(ctx context.Context, domainName string) ([]types.BasePathMapping, error) {
+	var mappings []types.BasePathMapping
+	paginator := apigateway.NewGetBasePathMappingsPaginator(r.client, &apigateway.GetBasePathMappingsInput{
+		DomainName: aws.String(domainName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, output.Items...)
+	}
+	return mappings, nil
+}
@@ -0,0 +1,540 @@
+package apigateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// --- Domain Models ---
+
+// Integration はメソッドのバックエンド統合です。
+type Integration struct {
+	Type                string
+	HTTPMethod          string
+	URI                 string
+	ConnectionType      string
+	ConnectionID        string
+	Credentials         string
+	RequestParameters   map[string]string
+	RequestTemplates    map[string]string
+	PassthroughBehavior string
+	ContentHandling     string
+	TimeoutInMillis     int32
+	CacheKeyParameters  []string
+	CacheNamespace      string
+}
+
+// Method はリソースのHTTPメソッドです。
+type Method struct {
+	HTTPMethod          string
+	AuthorizationType   string
+	AuthorizerID        string
+	AuthorizationScopes []string
+	ApiKeyRequired      bool
+	OperationName       string
+	RequestValidatorID  string
+	RequestParameters   map[string]bool
+	RequestModels       map[string]string
+	Integration         *Integration
+}
+
+// Resource はREST APIのリソース(パス)です。ルートリソース("/")の ParentID は空です。
+type Resource struct {
+	ID       string
+	ParentID string
+	PathPart string
+	Path     string
+	Methods  []Method
+}
+
+// Deployment はステージが参照するデプロイメントです。
+type Deployment struct {
+	ID          string
+	Description string
+}
+
+// AccessLogSettings はステージのアクセスログの出力設定です。
+type AccessLogSettings struct {
+	DestinationArn string
+	Format         string
+}
+
+// Stage はREST APIのステージです。
+type Stage struct {
+	Name                string
+	DeploymentID        string
+	Description         string
+	CacheClusterEnabled bool
+	CacheClusterSize    string
+	TracingEnabled      bool
+	ClientCertificateID string
+	Variables           map[string]string
+	AccessLogSettings   *AccessLogSettings
+	Tags                map[string]string
+}
+
+// RestAPI はHCL生成に必要なREST APIの情報を保持します。
+type RestAPI struct {
+	ID                        string
+	Name                      string
+	Description               string
+	RootResourceID            string
+	EndpointTypes             []string
+	VpcEndpointIDs            []string
+	BinaryMediaTypes          []string
+	MinimumCompressionSize    *int32
+	ApiKeySource              string
+	DisableExecuteApiEndpoint bool
+	Policy                    string
+	Tags                      map[string]string
+	Resources                 []Resource
+	Deployments               []Deployment
+	Stages                    []Stage
+	// Body はOpenAPI形式でエクスポートした定義です。エクスポートしない場合や対象のステージが無い場合は空です。
+	Body []byte
+	// BodyStage は Body をエクスポートしたステージの名前です。
+	BodyStage string
+}
+
+// UsagePlanAPIStage は使用量プランに関連付けられたAPIのステージです。
+type UsagePlanAPIStage struct {
+	APIID string
+	Stage string
+}
+
+// ThrottleSettings はスロットリングの設定です。
+type ThrottleSettings struct {
+	BurstLimit int32
+	RateLimit  float64
+}
+
+// QuotaSettings はクォータの設定です。
+type QuotaSettings struct {
+	Limit  int32
+	Offset int32
+	Period string
+}
+
+// UsagePlanKey は使用量プランに関連付けられたAPIキーです。
+type UsagePlanKey struct {
+	ID   string
+	Type string
+}
+
+// UsagePlan は使用量プランです。
+type UsagePlan struct {
+	ID          string
+	Name        string
+	Description string
+	APIStages   []UsagePlanAPIStage
+	Throttle    *ThrottleSettings
+	Quota       *QuotaSettings
+	Keys        []UsagePlanKey
+	Tags        map[string]string
+}
+
+// ApiKey はAPIキーです。キーの値は出力しません。
+type ApiKey struct {
+	ID          string
+	Name        string
+	Description string
+	Enabled     bool
+	Tags        map[string]string
+}
+
+// BasePathMapping はカスタムドメイン名からREST APIのステージへのマッピングです。
+// BasePath はベースパスが無い場合は空です。
+type BasePathMapping struct {
+	BasePath  string
+	RestAPIID string
+	Stage     string
+}
+
+// DomainName はカスタムドメイン名です。
+type DomainName struct {
+	Name                   string
+	CertificateArn         string
+	RegionalCertificateArn string
+	EndpointTypes          []string
+	SecurityPolicy         string
+	Tags                   map[string]string
+	BasePathMappings       []BasePathMapping
+}
+
+// noneBasePath はベースパスが無いマッピングに対してAPIが返す値です。
+const noneBasePath = "(none)"
+
+// --- Service ---
+
+// Service はAPI Gateway (REST API) 関連のビジネスロジックを定義します。
+type Service interface {
+	ListRestAPIs(ctx context.Context, nameContains string, exportBody bool, exportStage string) ([]RestAPI, error)
+	ListUsagePlans(ctx context.Context, restAPIIDs []string) ([]UsagePlan, error)
+	ListApiKeys(ctx context.Context, keyIDs []string) ([]ApiKey, error)
+	ListDomainNames(ctx context.Context, restAPIIDs []string) ([]DomainName, error)
+}
+
+// APIGatewayService はServiceを実装します。
+type APIGatewayService struct {
+	repo APIGatewayRepositoryInterface
+}
+
+// NewAPIGatewayService は新しいAPIGatewayServiceを生成します。
+func NewAPIGatewayService(repo APIGatewayRepositoryInterface) *APIGatewayService {
+	return &APIGatewayService{repo: repo}
+}
+
+// ListRestAPIs は名前に nameContains を含むREST APIを、リソース、メソッド、統合、ステージとあわせて取得します。
+// exportBody が true の場合は、ステージにデプロイされたOpenAPI形式の定義もエクスポートします。
+// エクスポートするのは exportStage のステージです。exportStage が空の場合は、すべてのステージが同じデプロイメントを
+// 参照しているときに限りそのデプロイメントをエクスポートし、ステージごとにデプロイメントが異なる場合はエラーを返します。
+// exportStage のステージが無いREST APIはエクスポートしません。
+func (s *APIGatewayService) ListRestAPIs(ctx context.Context, nameContains string, exportBody bool, exportStage string) ([]RestAPI, error) {
+	awsAPIs, err := s.repo.GetRestApis(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []types.RestApi
+	for _, a := range awsAPIs {
+		if nameContains == "" || strings.Contains(aws.ToString(a.Name), nameContains) {
+			filtered = append(filtered, a)
+		}
+	}
+
+	apis := make([]RestAPI, len(filtered))
+	var eg errgroup.Group
+	for i, a := range filtered {
+		i, a := i, a
+		eg.Go(func() error {
+			api, err := s.buildRestAPI(ctx, a, exportBody, exportStage)
+			if err != nil {
+				return err
+			}
+			apis[i] = *api
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return apis, nil
+}
+
+func (s *APIGatewayService) buildRestAPI(ctx context.Context, a types.RestApi, exportBody bool, exportStage string) (*RestAPI, error) {
+	id := aws.ToString(a.Id)
+	api := &RestAPI{
+		ID:                        id,
+		Name:                      aws.ToString(a.Name),
+		Description:               aws.ToString(a.Description),
+		RootResourceID:            aws.ToString(a.RootResourceId),
+		BinaryMediaTypes:          a.BinaryMediaTypes,
+		MinimumCompressionSize:    a.MinimumCompressionSize,
+		ApiKeySource:              string(a.ApiKeySource),
+		DisableExecuteApiEndpoint: a.DisableExecuteApiEndpoint,
+		Policy:                    decodePolicy(aws.ToString(a.Policy)),
+		Tags:                      a.Tags,
+	}
+	if ec := a.EndpointConfiguration; ec != nil {
+		for _, t := range ec.Types {
+			api.EndpointTypes = append(api.EndpointTypes, string(t))
+		}
+		api.VpcEndpointIDs = ec.VpcEndpointIds
+	}
+
+	resources, err := s.repo.GetResources(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		api.Resources = append(api.Resources, convertResource(r))
+	}
+	// 親リソースが先に出力されるようにパスでソートします。
+	sort.Slice(api.Resources, func(i, j int) bool {
+		return api.Resources[i].Path < api.Resources[j].Path
+	})
+
+	stages, err := s.repo.GetStages(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	deploymentIDs := make(map[string]struct{})
+	for _, st := range stages {
+		stage := Stage{
+			Name:                aws.ToString(st.StageName),
+			DeploymentID:        aws.ToString(st.DeploymentId),
+			Description:         aws.ToString(st.Description),
+			CacheClusterEnabled: st.CacheClusterEnabled,
+			CacheClusterSize:    string(st.CacheClusterSize),
+			TracingEnabled:      st.TracingEnabled,
+			ClientCertificateID: aws.ToString(st.ClientCertificateId),
+			Variables:           st.Variables,
+			Tags:                st.Tags,
+		}
+		if als := st.AccessLogSettings; als != nil && aws.ToString(als.DestinationArn) != "" {
+			stage.AccessLogSettings = &AccessLogSettings{
+				DestinationArn: aws.ToString(als.DestinationArn),
+				Format:         aws.ToString(als.Format),
+			}
+		}
+		deploymentIDs[stage.DeploymentID] = struct{}{}
+		api.Stages = append(api.Stages, stage)
+	}
+	sort.Slice(api.Stages, func(i, j int) bool {
+		return api.Stages[i].Name < api.Stages[j].Name
+	})
+
+	// 過去のデプロイメントは出力せず、ステージが参照しているものだけを対象にします。
+	deployments, err := s.repo.GetDeployments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deployments {
+		if _, ok := deploymentIDs[aws.ToString(d.Id)]; !ok {
+			continue
+		}
+		api.Deployments = append(api.Deployments, Deployment{
+			ID:          aws.ToString(d.Id),
+			Description: aws.ToString(d.Description),
+		})
+	}
+
+	if exportBody {
+		stageName, err := bodyStage(api, exportStage)
+		if err != nil {
+			return nil, err
+		}
+		if stageName != "" {
+			api.Body, err = s.repo.GetExport(ctx, id, stageName)
+			if err != nil {
+				return nil, err
+			}
+			api.BodyStage = stageName
+		}
+	}
+
+	return api, nil
+}
+
+// decodePolicy はJSON文字列としてエスケープされた状態で返されるREST APIのポリシーをデコードします。
+// デコードできない場合はそのまま返します。
+func decodePolicy(policy string) string {
+	var decoded string
+	if err := json.Unmarshal([]byte(`"`+policy+`"`), &decoded); err != nil {
+		return policy
+	}
+	return decoded
+}
+
+// bodyStage はOpenAPI定義をエクスポートするステージの名前を返します。対象のステージが無い場合は空文字列を返します。
+// exportStage を指定しない場合に、ステージごとに異なるデプロイメントを参照していると、どのステージの定義が
+// 現在のAPIに相当するか判断できないためエラーにします。
+func bodyStage(api *RestAPI, exportStage string) (string, error) {
+	if exportStage != "" {
+		for _, st := range api.Stages {
+			if st.Name == exportStage {
+				return st.Name, nil
+			}
+		}
+		return "", nil
+	}
+	if len(api.Stages) == 0 {
+		return "", nil
+	}
+	for _, st := range api.Stages[1:] {
+		if st.DeploymentID != api.Stages[0].DeploymentID {
+			return "", fmt.Errorf("stages of rest api %s (%s) refer to different deployments; specify the stage to export", api.Name, api.ID)
+		}
+	}
+	return api.Stages[0].Name, nil
+}
+
+func convertResource(r types.Resource) Resource {
+	resource := Resource{
+		ID:       aws.ToString(r.Id),
+		ParentID: aws.ToString(r.ParentId),
+		PathPart: aws.ToString(r.PathPart),
+		Path:     aws.ToString(r.Path),
+	}
+	for _, m := range r.ResourceMethods {
+		method := Method{
+			HTTPMethod:          aws.ToString(m.HttpMethod),
+			AuthorizationType:   aws.ToString(m.AuthorizationType),
+			AuthorizerID:        aws.ToString(m.AuthorizerId),
+			AuthorizationScopes: m.AuthorizationScopes,
+			ApiKeyRequired:      aws.ToBool(m.ApiKeyRequired),
+			OperationName:       aws.ToString(m.OperationName),
+			RequestValidatorID:  aws.ToString(m.RequestValidatorId),
+			RequestParameters:   m.RequestParameters,
+			RequestModels:       m.RequestModels,
+		}
+		if in := m.MethodIntegration; in != nil {
+			method.Integration = &Integration{
+				Type:                string(in.Type),
+				HTTPMethod:          aws.ToString(in.HttpMethod),
+				URI:                 aws.ToString(in.Uri),
+				ConnectionType:      string(in.ConnectionType),
+				ConnectionID:        aws.ToString(in.ConnectionId),
+				Credentials:         aws.ToString(in.Credentials),
+				RequestParameters:   in.RequestParameters,
+				RequestTemplates:    in.RequestTemplates,
+				PassthroughBehavior: aws.ToString(in.PassthroughBehavior),
+				ContentHandling:     string(in.ContentHandling),
+				TimeoutInMillis:     in.TimeoutInMillis,
+				CacheKeyParameters:  in.CacheKeyParameters,
+				CacheNamespace:      aws.ToString(in.CacheNamespace),
+			}
+		}
+		resource.Methods = append(resource.Methods, method)
+	}
+	sort.Slice(resource.Methods, func(i, j int) bool {
+		return resource.Methods[i].HTTPMethod < resource.Methods[j].HTTPMethod
+	})
+	return resource
+}
+
+// ListUsagePlans は restAPIIDs のいずれかのステージに関連付けられた使用量プランを、APIキーの関連付けとあわせて取得します。
+func (s *APIGatewayService) ListUsagePlans(ctx context.Context, restAPIIDs []string) ([]UsagePlan, error) {
+	awsPlans, err := s.repo.GetUsagePlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiIDs := make(map[string]struct{})
+	for _, id := range restAPIIDs {
+		apiIDs[id] = struct{}{}
+	}
+
+	var plans []UsagePlan
+	for _, p := range awsPlans {
+		plan := UsagePlan{
+			ID:          aws.ToString(p.Id),
+			Name:        aws.ToString(p.Name),
+			Description: aws.ToString(p.Description),
+			Tags:        p.Tags,
+		}
+		matched := false
+		for _, as := range p.ApiStages {
+			if _, ok := apiIDs[aws.ToString(as.ApiId)]; ok {
+				matched = true
+			}
+			plan.APIStages = append(plan.APIStages, UsagePlanAPIStage{
+				APIID: aws.ToString(as.ApiId),
+				Stage: aws.ToString(as.Stage),
+			})
+		}
+		if !matched {
+			continue
+		}
+		if t := p.Throttle; t != nil {
+			plan.Throttle = &ThrottleSettings{BurstLimit: t.BurstLimit, RateLimit: t.RateLimit}
+		}
+		if q := p.Quota; q != nil {
+			plan.Quota = &QuotaSettings{Limit: q.Limit, Offset: q.Offset, Period: string(q.Period)}
+		}
+
+		keys, err := s.repo.GetUsagePlanKeys(ctx, plan.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			plan.Keys = append(plan.Keys, UsagePlanKey{ID: aws.ToString(k.Id), Type: aws.ToString(k.Type)})
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// ListApiKeys は keyIDs に含まれるAPIキーを取得します。
+func (s *APIGatewayService) ListApiKeys(ctx context.Context, keyIDs []string) ([]ApiKey, error) {
+	if len(keyIDs) == 0 {
+		return nil, nil
+	}
+	awsKeys, err := s.repo.GetApiKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{})
+	for _, id := range keyIDs {
+		ids[id] = struct{}{}
+	}
+
+	var keys []ApiKey
+	for _, k := range awsKeys {
+		if _, ok := ids[aws.ToString(k.Id)]; !ok {
+			continue
+		}
+		keys = append(keys, ApiKey{
+			ID:          aws.ToString(k.Id),
+			Name:        aws.ToString(k.Name),
+			Description: aws.ToString(k.Description),
+			Enabled:     k.Enabled,
+			Tags:        k.Tags,
+		})
+	}
+	return keys, nil
+}
+
+// ListDomainNames は restAPIIDs のいずれかにマッピングされたカスタムドメイン名を、ベースパスマッピングとあわせて取得します。
+func (s *APIGatewayService) ListDomainNames(ctx context.Context, restAPIIDs []string) ([]DomainName, error) {
+	awsDomains, err := s.repo.GetDomainNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiIDs := make(map[string]struct{})
+	for _, id := range restAPIIDs {
+		apiIDs[id] = struct{}{}
+	}
+
+	var domains []DomainName
+	for _, d := range awsDomains {
+		domain := DomainName{
+			Name:                   aws.ToString(d.DomainName),
+			CertificateArn:         aws.ToString(d.CertificateArn),
+			RegionalCertificateArn: aws.ToString(d.RegionalCertificateArn),
+			SecurityPolicy:         string(d.SecurityPolicy),
+			Tags:                   d.Tags,
+		}
+		if ec := d.EndpointConfiguration; ec != nil {
+			for _, t := range ec.Types {
+				domain.EndpointTypes = append(domain.EndpointTypes, string(t))
+			}
+		}
+
+		mappings, err := s.repo.GetBasePathMappings(ctx, domain.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mappings {
+			if _, ok := apiIDs[aws.ToString(m.RestApiId)]; !ok {
+				continue
+			}
+			basePath := aws.ToString(m.BasePath)
+			if basePath == noneBasePath {
+				basePath = ""
+			}
+			domain.BasePathMappings = append(domain.BasePathMappings, BasePathMapping{
+				BasePath:  basePath,
+				RestAPIID: aws.ToString(m.RestApiId),
+				Stage:     aws.ToString(m.Stage),
+			})
+		}
+		if len(domain.BasePathMappings) == 0 {
+			continue
+		}
+		domains = append(domains, domain)
+	}
+
+	return domains, nil
+}
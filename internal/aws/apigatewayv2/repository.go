@@ -0,0 +1,150 @@
+package apigatewayv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+// APIGatewayV2RepositoryInterface はAPI Gateway v2 (HTTP API / WebSocket API) リソースへのアクセスを抽象化します。
+type APIGatewayV2RepositoryInterface interface {
+	GetApis(ctx context.Context) ([]types.Api, error)
+	GetRoutes(ctx context.Context, apiID string) ([]types.Route, error)
+	GetIntegrations(ctx context.Context, apiID string) ([]types.Integration, error)
+	GetStages(ctx context.Context, apiID string) ([]types.Stage, error)
+	GetAuthorizers(ctx context.Context, apiID string) ([]types.Authorizer, error)
+	GetDomainNames(ctx context.Context) ([]types.DomainName, error)
+	GetApiMappings(ctx context.Context, domainName string) ([]types.ApiMapping, error)
+}
+
+// APIGatewayV2Repository はAPIGatewayV2RepositoryInterfaceを実装します。
+// apigatewayv2 にはページネータが無いため、NextToken を辿って全件を取得します。
+type APIGatewayV2Repository struct {
+	client *apigatewayv2.Client
+}
+
+// NewAPIGatewayV2Repository は新しいAPIGatewayV2Repositoryを生成します。
+func NewAPIGatewayV2Repository(client *apigatewayv2.Client) *APIGatewayV2Repository {
+	return &APIGatewayV2Repository{client: client}
+}
+
+// GetApis はAWSからHTTP APIとWebSocket APIのリストを取得します。
+func (r *APIGatewayV2Repository) GetApis(ctx context.Context) ([]types.Api, error) {
+	var apis []types.Api
+	input := &apigatewayv2.GetApisInput{}
+	for {
+		output, err := r.client.GetApis(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, output.Items...)
+		if output.NextToken == nil {
+			return apis, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// GetRoutes はAPIのルートを取得します。
+func (r *APIGatewayV2Repository) GetRoutes(ctx context.Context, apiID string) ([]types.Route, error) {
+	var routes []types.Route
+	input := &apigatewayv2.GetRoutesInput{ApiId: aws.String(apiID)}
+	for {
+		output, err := r.client.GetRoutes(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, output.Items...)
+		if output.NextToken == nil {
+			return routes, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// GetIntegrations はAPIの統合を取得します。
+func (r *APIGatewayV2Repository) GetIntegrations(ctx context.Context, apiID string) ([]types.Integration, error) {
+	var integrations []types.Integration
+	input := &apigatewayv2.GetIntegrationsInput{ApiId: aws.String(apiID)}
+	for {
+		output, err := r.client.GetIntegrations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		integrations = append(integrations, output.Items...)
+		if output.NextToken == nil {
+			return integrations, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// GetStages はAPIのステージを取得します。
+func (r *APIGatewayV2Repository) GetStages(ctx context.Context, apiID string) ([]types.Stage, error) {
+	var stages []types.Stage
+	input := &apigatewayv2.GetStagesInput{ApiId: aws.String(apiID)}
+	for {
+		output, err := r.client.GetStages(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, output.Items...)
+		if output.NextToken == nil {
+			return stages, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// GetAuthorizers はAPIのオーソライザーを取得します。
+func (r *APIGatewayV2Repository) GetAuthorizers(ctx context.Context, apiID string) ([]types.Authorizer, error) {
+	var authorizers []types.Authorizer
+	input := &apigatewayv2.GetAuthorizersInput{ApiId: aws.String(apiID)}
+	for {
+		output, err := r.client.GetAuthorizers(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		authorizers = append(authorizers, output.Items...)
+		if output.NextToken == nil {
+			return authorizers, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// GetDomainNames はカスタムドメイン名のリストを取得します。
+func (r *APIGatewayV2Repository) GetDomainNames(ctx context.Context) ([]types.DomainName, error) {
+	var domains []types.DomainName
+	input := &apigatewayv2.GetDomainNamesInput{}
+	for {
+		output, err := r.client.GetDomainNames(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, output.Items...)
+		if output.NextToken == nil {
+			return domains, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// GetApiMappings はカスタムドメイン名のAPIマッピングを取得します。
+func (r *APIGatewayV2Repository) GetApiMappings(ctx context.Context, domainName string) ([]types.ApiMapping, error) {
+	var mappings []types.ApiMapping
+	input := &apigatewayv2.GetApiMappingsInput{DomainName: aws.String(domainName)}
+	for {
+		output, err := r.client.GetApiMappings(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, output.Items...)
+		if output.NextToken == nil {
+			return mappings, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
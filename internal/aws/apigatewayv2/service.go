@@ -0,0 +1,347 @@
+package apigatewayv2
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// --- Domain Models ---
+
+// Cors はHTTP APIのCORS設定です。
+type Cors struct {
+	AllowCredentials bool
+	AllowHeaders     []string
+	AllowMethods     []string
+	AllowOrigins     []string
+	ExposeHeaders    []string
+	MaxAge           int32
+}
+
+// Route はAPIのルートです。Target は "integrations/<統合ID>" の形式です。
+type Route struct {
+	ID                  string
+	RouteKey            string
+	Target              string
+	AuthorizationType   string
+	AuthorizerID        string
+	AuthorizationScopes []string
+	ApiKeyRequired      bool
+	OperationName       string
+}
+
+// Integration はAPIの統合です。
+type Integration struct {
+	ID                          string
+	Type                        string
+	Subtype                     string
+	URI                         string
+	Method                      string
+	ConnectionType              string
+	ConnectionID                string
+	CredentialsArn              string
+	Description                 string
+	PayloadFormatVersion        string
+	PassthroughBehavior         string
+	TemplateSelectionExpression string
+	TimeoutInMillis             int32
+	RequestParameters           map[string]string
+	RequestTemplates            map[string]string
+}
+
+// AccessLogSettings はステージのアクセスログの出力設定です。
+type AccessLogSettings struct {
+	DestinationArn string
+	Format         string
+}
+
+// Stage はAPIのステージです。
+type Stage struct {
+	Name              string
+	DeploymentID      string
+	AutoDeploy        bool
+	Description       string
+	StageVariables    map[string]string
+	AccessLogSettings *AccessLogSettings
+	Tags              map[string]string
+}
+
+// Authorizer はAPIのオーソライザーです。JWTオーソライザーの場合は JwtAudience と JwtIssuer が設定されます。
+type Authorizer struct {
+	ID                    string
+	Name                  string
+	Type                  string
+	URI                   string
+	CredentialsArn        string
+	IdentitySources       []string
+	PayloadFormatVersion  string
+	EnableSimpleResponses bool
+	ResultTtlInSeconds    *int32
+	JwtAudience           []string
+	JwtIssuer             string
+}
+
+// API はHCL生成に必要なHTTP APIまたはWebSocket APIの情報を保持します。
+type API struct {
+	ID                        string
+	Name                      string
+	ProtocolType              string
+	Description               string
+	RouteSelectionExpression  string
+	ApiKeySelectionExpression string
+	DisableExecuteApiEndpoint bool
+	Cors                      *Cors
+	Tags                      map[string]string
+	Routes                    []Route
+	Integrations              []Integration
+	Stages                    []Stage
+	Authorizers               []Authorizer
+}
+
+// ApiMapping はカスタムドメイン名からAPIのステージへのマッピングです。
+type ApiMapping struct {
+	ID         string
+	APIID      string
+	Stage      string
+	MappingKey string
+}
+
+// DomainName はカスタムドメイン名です。
+type DomainName struct {
+	Name           string
+	CertificateArn string
+	EndpointType   string
+	SecurityPolicy string
+	Tags           map[string]string
+	ApiMappings    []ApiMapping
+}
+
+// --- Service ---
+
+// Service はAPI Gateway v2 関連のビジネスロジックを定義します。
+type Service interface {
+	ListAPIs(ctx context.Context, nameContains string) ([]API, error)
+	ListDomainNames(ctx context.Context, apiIDs []string) ([]DomainName, error)
+}
+
+// APIGatewayV2Service はServiceを実装します。
+type APIGatewayV2Service struct {
+	repo APIGatewayV2RepositoryInterface
+}
+
+// NewAPIGatewayV2Service は新しいAPIGatewayV2Serviceを生成します。
+func NewAPIGatewayV2Service(repo APIGatewayV2RepositoryInterface) *APIGatewayV2Service {
+	return &APIGatewayV2Service{repo: repo}
+}
+
+// ListAPIs は名前に nameContains を含むHTTP APIとWebSocket APIを、ルート、統合、ステージ、オーソライザーとあわせて取得します。
+// クイック作成でAPI Gatewayが管理しているルート、統合、ステージは、APIの属性から再作成されるため除外します。
+func (s *APIGatewayV2Service) ListAPIs(ctx context.Context, nameContains string) ([]API, error) {
+	awsAPIs, err := s.repo.GetApis(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []types.Api
+	for _, a := range awsAPIs {
+		if nameContains == "" || strings.Contains(aws.ToString(a.Name), nameContains) {
+			filtered = append(filtered, a)
+		}
+	}
+
+	apis := make([]API, len(filtered))
+	var eg errgroup.Group
+	for i, a := range filtered {
+		i, a := i, a
+		eg.Go(func() error {
+			api, err := s.buildAPI(ctx, a)
+			if err != nil {
+				return err
+			}
+			apis[i] = *api
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return apis, nil
+}
+
+func (s *APIGatewayV2Service) buildAPI(ctx context.Context, a types.Api) (*API, error) {
+	id := aws.ToString(a.ApiId)
+	api := &API{
+		ID:                        id,
+		Name:                      aws.ToString(a.Name),
+		ProtocolType:              string(a.ProtocolType),
+		Description:               aws.ToString(a.Description),
+		RouteSelectionExpression:  aws.ToString(a.RouteSelectionExpression),
+		ApiKeySelectionExpression: aws.ToString(a.ApiKeySelectionExpression),
+		DisableExecuteApiEndpoint: aws.ToBool(a.DisableExecuteApiEndpoint),
+		Tags:                      a.Tags,
+	}
+	if c := a.CorsConfiguration; c != nil {
+		api.Cors = &Cors{
+			AllowCredentials: aws.ToBool(c.AllowCredentials),
+			AllowHeaders:     c.AllowHeaders,
+			AllowMethods:     c.AllowMethods,
+			AllowOrigins:     c.AllowOrigins,
+			ExposeHeaders:    c.ExposeHeaders,
+			MaxAge:           aws.ToInt32(c.MaxAge),
+		}
+	}
+
+	routes, err := s.repo.GetRoutes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range routes {
+		if aws.ToBool(r.ApiGatewayManaged) {
+			continue
+		}
+		api.Routes = append(api.Routes, Route{
+			ID:                  aws.ToString(r.RouteId),
+			RouteKey:            aws.ToString(r.RouteKey),
+			Target:              aws.ToString(r.Target),
+			AuthorizationType:   string(r.AuthorizationType),
+			AuthorizerID:        aws.ToString(r.AuthorizerId),
+			AuthorizationScopes: r.AuthorizationScopes,
+			ApiKeyRequired:      aws.ToBool(r.ApiKeyRequired),
+			OperationName:       aws.ToString(r.OperationName),
+		})
+	}
+	sort.Slice(api.Routes, func(i, j int) bool {
+		return api.Routes[i].RouteKey < api.Routes[j].RouteKey
+	})
+
+	integrations, err := s.repo.GetIntegrations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, in := range integrations {
+		if aws.ToBool(in.ApiGatewayManaged) {
+			continue
+		}
+		api.Integrations = append(api.Integrations, Integration{
+			ID:                          aws.ToString(in.IntegrationId),
+			Type:                        string(in.IntegrationType),
+			Subtype:                     aws.ToString(in.IntegrationSubtype),
+			URI:                         aws.ToString(in.IntegrationUri),
+			Method:                      aws.ToString(in.IntegrationMethod),
+			ConnectionType:              string(in.ConnectionType),
+			ConnectionID:                aws.ToString(in.ConnectionId),
+			CredentialsArn:              aws.ToString(in.CredentialsArn),
+			Description:                 aws.ToString(in.Description),
+			PayloadFormatVersion:        aws.ToString(in.PayloadFormatVersion),
+			PassthroughBehavior:         string(in.PassthroughBehavior),
+			TemplateSelectionExpression: aws.ToString(in.TemplateSelectionExpression),
+			TimeoutInMillis:             aws.ToInt32(in.TimeoutInMillis),
+			RequestParameters:           in.RequestParameters,
+			RequestTemplates:            in.RequestTemplates,
+		})
+	}
+
+	stages, err := s.repo.GetStages(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range stages {
+		if aws.ToBool(st.ApiGatewayManaged) {
+			continue
+		}
+		stage := Stage{
+			Name:           aws.ToString(st.StageName),
+			DeploymentID:   aws.ToString(st.DeploymentId),
+			AutoDeploy:     aws.ToBool(st.AutoDeploy),
+			Description:    aws.ToString(st.Description),
+			StageVariables: st.StageVariables,
+			Tags:           st.Tags,
+		}
+		if als := st.AccessLogSettings; als != nil && aws.ToString(als.DestinationArn) != "" {
+			stage.AccessLogSettings = &AccessLogSettings{
+				DestinationArn: aws.ToString(als.DestinationArn),
+				Format:         aws.ToString(als.Format),
+			}
+		}
+		api.Stages = append(api.Stages, stage)
+	}
+
+	authorizers, err := s.repo.GetAuthorizers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, au := range authorizers {
+		authorizer := Authorizer{
+			ID:                    aws.ToString(au.AuthorizerId),
+			Name:                  aws.ToString(au.Name),
+			Type:                  string(au.AuthorizerType),
+			URI:                   aws.ToString(au.AuthorizerUri),
+			CredentialsArn:        aws.ToString(au.AuthorizerCredentialsArn),
+			IdentitySources:       au.IdentitySource,
+			PayloadFormatVersion:  aws.ToString(au.AuthorizerPayloadFormatVersion),
+			EnableSimpleResponses: aws.ToBool(au.EnableSimpleResponses),
+			ResultTtlInSeconds:    au.AuthorizerResultTtlInSeconds,
+		}
+		if jwt := au.JwtConfiguration; jwt != nil {
+			authorizer.JwtAudience = jwt.Audience
+			authorizer.JwtIssuer = aws.ToString(jwt.Issuer)
+		}
+		api.Authorizers = append(api.Authorizers, authorizer)
+	}
+
+	return api, nil
+}
+
+// ListDomainNames は apiIDs のいずれかにマッピングされたカスタムドメイン名を、APIマッピングとあわせて取得します。
+func (s *APIGatewayV2Service) ListDomainNames(ctx context.Context, apiIDs []string) ([]DomainName, error) {
+	awsDomains, err := s.repo.GetDomainNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{})
+	for _, id := range apiIDs {
+		ids[id] = struct{}{}
+	}
+
+	var domains []DomainName
+	for _, d := range awsDomains {
+		domain := DomainName{
+			Name: aws.ToString(d.DomainName),
+			Tags: d.Tags,
+		}
+		if len(d.DomainNameConfigurations) > 0 {
+			c := d.DomainNameConfigurations[0]
+			domain.CertificateArn = aws.ToString(c.CertificateArn)
+			domain.EndpointType = string(c.EndpointType)
+			domain.SecurityPolicy = string(c.SecurityPolicy)
+		}
+
+		mappings, err := s.repo.GetApiMappings(ctx, domain.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mappings {
+			if _, ok := ids[aws.ToString(m.ApiId)]; !ok {
+				continue
+			}
+			domain.ApiMappings = append(domain.ApiMappings, ApiMapping{
+				ID:         aws.ToString(m.ApiMappingId),
+				APIID:      aws.ToString(m.ApiId),
+				Stage:      aws.ToString(m.Stage),
+				MappingKey: aws.ToString(m.ApiMappingKey),
+			})
+		}
+		if len(domain.ApiMappings) == 0 {
+			continue
+		}
+		domains = append(domains, domain)
+	}
+
+	return domains, nil
+}
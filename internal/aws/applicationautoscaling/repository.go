@@ -0,0 +1,80 @@
+package applicationautoscaling
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+)
+
+// ApplicationAutoScalingRepositoryInterface はApplication Auto Scalingリソースへのアクセスを抽象化します。
+type ApplicationAutoScalingRepositoryInterface interface {
+	DescribeScalableTargets(ctx context.Context, namespace string, resourceIDs []string) ([]types.ScalableTarget, error)
+	DescribeScalingPolicies(ctx context.Context, namespace, resourceID string) ([]types.ScalingPolicy, error)
+	DescribeScheduledActions(ctx context.Context, namespace, resourceID string) ([]types.ScheduledAction, error)
+}
+
+// ApplicationAutoScalingRepository はApplicationAutoScalingRepositoryInterfaceを実装します。
+type ApplicationAutoScalingRepository struct {
+	client *applicationautoscaling.Client
+}
+
+// NewApplicationAutoScalingRepository は新しいApplicationAutoScalingRepositoryを生成します。
+func NewApplicationAutoScalingRepository(client *applicationautoscaling.Client) *ApplicationAutoScalingRepository {
+	return &ApplicationAutoScalingRepository{client: client}
+}
+
+// DescribeScalableTargets はスケーラブルターゲットを取得します。APIの上限に合わせて50件ずつ問い合わせます。
+func (r *ApplicationAutoScalingRepository) DescribeScalableTargets(ctx context.Context, namespace string, resourceIDs []string) ([]types.ScalableTarget, error) {
+	var targets []types.ScalableTarget
+	for start := 0; start < len(resourceIDs); start += 50 {
+		end := min(start+50, len(resourceIDs))
+		paginator := applicationautoscaling.NewDescribeScalableTargetsPaginator(r.client, &applicationautoscaling.DescribeScalableTargetsInput{
+			ServiceNamespace: types.ServiceNamespace(namespace),
+			ResourceIds:      resourceIDs[start:end],
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, output.ScalableTargets...)
+		}
+	}
+	return targets, nil
+}
+
+// DescribeScalingPolicies はリソースのスケーリングポリシーを取得します。
+func (r *ApplicationAutoScalingRepository) DescribeScalingPolicies(ctx context.Context, namespace, resourceID string) ([]types.ScalingPolicy, error) {
+	var policies []types.ScalingPolicy
+	paginator := applicationautoscaling.NewDescribeScalingPoliciesPaginator(r.client, &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace: types.ServiceNamespace(namespace),
+		ResourceId:       aws.String(resourceID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, output.ScalingPolicies...)
+	}
+	return policies, nil
+}
+
+// DescribeScheduledActions はリソースのスケジュールアクションを取得します。
+func (r *ApplicationAutoScalingRepository) DescribeScheduledActions(ctx context.Context, namespace, resourceID string) ([]types.ScheduledAction, error) {
+	var actions []types.ScheduledAction
+	paginator := applicationautoscaling.NewDescribeScheduledActionsPaginator(r.client, &applicationautoscaling.DescribeScheduledActionsInput{
+		ServiceNamespace: types.ServiceNamespace(namespace),
+		ResourceId:       aws.String(resourceID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, output.ScheduledActions...)
+	}
+	return actions, nil
+}
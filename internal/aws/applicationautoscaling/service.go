@@ -0,0 +1,276 @@
+package applicationautoscaling
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// --- Domain Models ---
+
+// StepAdjustment はステップスケーリングポリシーの1ステップを保持します。
+type StepAdjustment struct {
+	MetricIntervalLowerBound *float64
+	MetricIntervalUpperBound *float64
+	ScalingAdjustment        int32
+}
+
+// StepScalingConfiguration はステップスケーリングの設定を保持します。
+type StepScalingConfiguration struct {
+	AdjustmentType         string
+	Cooldown               *int32
+	MetricAggregationType  string
+	MinAdjustmentMagnitude *int32
+	StepAdjustments        []StepAdjustment
+}
+
+// MetricDimension はCloudWatchメトリクスのディメンションです。
+type MetricDimension struct {
+	Name  string
+	Value string
+}
+
+// MetricStat はメトリクス計算に使うメトリクスと統計を保持します。
+type MetricStat struct {
+	MetricName string
+	Namespace  string
+	Dimensions []MetricDimension
+	Stat       string
+	Unit       string
+}
+
+// MetricDataQuery はカスタムメトリクスのメトリクス計算の1クエリを保持します。
+type MetricDataQuery struct {
+	ID         string
+	Expression string
+	Label      string
+	ReturnData *bool
+	MetricStat *MetricStat
+}
+
+// CustomizedMetricSpecification はターゲット追跡スケーリングのカスタムメトリクスを保持します。
+// 単一のメトリクスを使う場合は MetricName 等を、メトリクス計算を使う場合は Metrics を設定します。
+type CustomizedMetricSpecification struct {
+	MetricName string
+	Namespace  string
+	Statistic  string
+	Unit       string
+	Dimensions []MetricDimension
+	Metrics    []MetricDataQuery
+}
+
+// TargetTrackingConfiguration はターゲット追跡スケーリングの設定を保持します。
+type TargetTrackingConfiguration struct {
+	TargetValue          float64
+	DisableScaleIn       bool
+	ScaleInCooldown      *int32
+	ScaleOutCooldown     *int32
+	PredefinedMetricType string
+	ResourceLabel        string
+	CustomizedMetric     *CustomizedMetricSpecification
+}
+
+// ScalingPolicy はスケーラブルターゲットのスケーリングポリシーを保持します。
+type ScalingPolicy struct {
+	Name           string
+	PolicyType     string
+	StepScaling    *StepScalingConfiguration
+	TargetTracking *TargetTrackingConfiguration
+}
+
+// ScheduledAction はスケーラブルターゲットのスケジュールアクションを保持します。
+type ScheduledAction struct {
+	Name        string
+	Schedule    string
+	Timezone    string
+	StartTime   string
+	EndTime     string
+	MinCapacity *int32
+	MaxCapacity *int32
+}
+
+// ScalableTarget はHCL生成に必要なスケーラブルターゲットの情報を保持します。
+type ScalableTarget struct {
+	ServiceNamespace           string
+	ResourceID                 string
+	ScalableDimension          string
+	MinCapacity                int32
+	MaxCapacity                int32
+	DynamicScalingInSuspended  bool
+	DynamicScalingOutSuspended bool
+	ScheduledScalingSuspended  bool
+	Policies                   []ScalingPolicy
+	ScheduledActions           []ScheduledAction
+}
+
+// --- Service Interface and Implementation ---
+
+// Service はApplication Auto Scaling関連のビジネスロジックを定義します。
+type Service interface {
+	ListScalableTargets(ctx context.Context, namespace string, resourceIDs []string) ([]ScalableTarget, error)
+}
+
+// ApplicationAutoScalingService はServiceインターフェースを実装します。
+type ApplicationAutoScalingService struct {
+	repo ApplicationAutoScalingRepositoryInterface
+}
+
+// NewApplicationAutoScalingService は新しいApplicationAutoScalingServiceを生成します。
+func NewApplicationAutoScalingService(repo ApplicationAutoScalingRepositoryInterface) *ApplicationAutoScalingService {
+	return &ApplicationAutoScalingService{repo: repo}
+}
+
+// ListScalableTargets は指定されたリソースのスケーラブルターゲットを、ポリシーとスケジュールアクション付きで取得します。
+func (s *ApplicationAutoScalingService) ListScalableTargets(ctx context.Context, namespace string, resourceIDs []string) ([]ScalableTarget, error) {
+	if len(resourceIDs) == 0 {
+		return nil, nil
+	}
+	awsTargets, err := s.repo.DescribeScalableTargets(ctx, namespace, resourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]ScalableTarget, len(awsTargets))
+	var eg errgroup.Group
+	for i, t := range awsTargets {
+		i, t := i, t
+		eg.Go(func() error {
+			target := ScalableTarget{
+				ServiceNamespace:  string(t.ServiceNamespace),
+				ResourceID:        aws.ToString(t.ResourceId),
+				ScalableDimension: string(t.ScalableDimension),
+				MinCapacity:       aws.ToInt32(t.MinCapacity),
+				MaxCapacity:       aws.ToInt32(t.MaxCapacity),
+			}
+			if ss := t.SuspendedState; ss != nil {
+				target.DynamicScalingInSuspended = aws.ToBool(ss.DynamicScalingInSuspended)
+				target.DynamicScalingOutSuspended = aws.ToBool(ss.DynamicScalingOutSuspended)
+				target.ScheduledScalingSuspended = aws.ToBool(ss.ScheduledScalingSuspended)
+			}
+
+			policies, err := s.repo.DescribeScalingPolicies(ctx, namespace, target.ResourceID)
+			if err != nil {
+				return err
+			}
+			for _, p := range policies {
+				if string(p.ScalableDimension) != target.ScalableDimension {
+					continue
+				}
+				target.Policies = append(target.Policies, convertScalingPolicy(p))
+			}
+
+			actions, err := s.repo.DescribeScheduledActions(ctx, namespace, target.ResourceID)
+			if err != nil {
+				return err
+			}
+			for _, a := range actions {
+				if string(a.ScalableDimension) != target.ScalableDimension {
+					continue
+				}
+				action := ScheduledAction{
+					Name:      aws.ToString(a.ScheduledActionName),
+					Schedule:  aws.ToString(a.Schedule),
+					Timezone:  aws.ToString(a.Timezone),
+					StartTime: formatTime(a.StartTime),
+					EndTime:   formatTime(a.EndTime),
+				}
+				if a.ScalableTargetAction != nil {
+					action.MinCapacity = a.ScalableTargetAction.MinCapacity
+					action.MaxCapacity = a.ScalableTargetAction.MaxCapacity
+				}
+				target.ScheduledActions = append(target.ScheduledActions, action)
+			}
+
+			targets[i] = target
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+func convertScalingPolicy(p types.ScalingPolicy) ScalingPolicy {
+	policy := ScalingPolicy{
+		Name:       aws.ToString(p.PolicyName),
+		PolicyType: string(p.PolicyType),
+	}
+	if sc := p.StepScalingPolicyConfiguration; sc != nil {
+		policy.StepScaling = &StepScalingConfiguration{
+			AdjustmentType:         string(sc.AdjustmentType),
+			Cooldown:               sc.Cooldown,
+			MetricAggregationType:  string(sc.MetricAggregationType),
+			MinAdjustmentMagnitude: sc.MinAdjustmentMagnitude,
+		}
+		for _, step := range sc.StepAdjustments {
+			policy.StepScaling.StepAdjustments = append(policy.StepScaling.StepAdjustments, StepAdjustment{
+				MetricIntervalLowerBound: step.MetricIntervalLowerBound,
+				MetricIntervalUpperBound: step.MetricIntervalUpperBound,
+				ScalingAdjustment:        aws.ToInt32(step.ScalingAdjustment),
+			})
+		}
+	}
+	if tt := p.TargetTrackingScalingPolicyConfiguration; tt != nil {
+		policy.TargetTracking = &TargetTrackingConfiguration{
+			TargetValue:      aws.ToFloat64(tt.TargetValue),
+			DisableScaleIn:   aws.ToBool(tt.DisableScaleIn),
+			ScaleInCooldown:  tt.ScaleInCooldown,
+			ScaleOutCooldown: tt.ScaleOutCooldown,
+		}
+		if pm := tt.PredefinedMetricSpecification; pm != nil {
+			policy.TargetTracking.PredefinedMetricType = string(pm.PredefinedMetricType)
+			policy.TargetTracking.ResourceLabel = aws.ToString(pm.ResourceLabel)
+		}
+		if cm := tt.CustomizedMetricSpecification; cm != nil {
+			policy.TargetTracking.CustomizedMetric = convertCustomizedMetricSpecification(cm)
+		}
+	}
+	return policy
+}
+
+func convertCustomizedMetricSpecification(cm *types.CustomizedMetricSpecification) *CustomizedMetricSpecification {
+	spec := &CustomizedMetricSpecification{
+		MetricName: aws.ToString(cm.MetricName),
+		Namespace:  aws.ToString(cm.Namespace),
+		Statistic:  string(cm.Statistic),
+		Unit:       aws.ToString(cm.Unit),
+	}
+	for _, d := range cm.Dimensions {
+		spec.Dimensions = append(spec.Dimensions, MetricDimension{Name: aws.ToString(d.Name), Value: aws.ToString(d.Value)})
+	}
+	for _, q := range cm.Metrics {
+		query := MetricDataQuery{
+			ID:         aws.ToString(q.Id),
+			Expression: aws.ToString(q.Expression),
+			Label:      aws.ToString(q.Label),
+			ReturnData: q.ReturnData,
+		}
+		if ms := q.MetricStat; ms != nil {
+			query.MetricStat = &MetricStat{
+				Stat: aws.ToString(ms.Stat),
+				Unit: aws.ToString(ms.Unit),
+			}
+			if m := ms.Metric; m != nil {
+				query.MetricStat.MetricName = aws.ToString(m.MetricName)
+				query.MetricStat.Namespace = aws.ToString(m.Namespace)
+				for _, d := range m.Dimensions {
+					query.MetricStat.Dimensions = append(query.MetricStat.Dimensions, MetricDimension{Name: aws.ToString(d.Name), Value: aws.ToString(d.Value)})
+				}
+			}
+		}
+		spec.Metrics = append(spec.Metrics, query)
+	}
+	return spec
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,86 @@
+package autoscaling
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+)
+
+// AutoScalingRepositoryInterface はAuto Scalingリソースへのアクセスを抽象化します。
+type AutoScalingRepositoryInterface interface {
+	DescribeAutoScalingGroups(ctx context.Context, names []string) ([]types.AutoScalingGroup, error)
+	DescribePolicies(ctx context.Context, asgName string) ([]types.ScalingPolicy, error)
+	DescribeLifecycleHooks(ctx context.Context, asgName string) ([]types.LifecycleHook, error)
+	DescribeScheduledActions(ctx context.Context, asgName string) ([]types.ScheduledUpdateGroupAction, error)
+}
+
+// AutoScalingRepository はAutoScalingRepositoryInterfaceを実装します。
+type AutoScalingRepository struct {
+	client *autoscaling.Client
+}
+
+// NewAutoScalingRepository は新しいAutoScalingRepositoryを生成します。
+func NewAutoScalingRepository(client *autoscaling.Client) *AutoScalingRepository {
+	return &AutoScalingRepository{client: client}
+}
+
+// DescribeAutoScalingGroups はAWSからAuto Scalingグループのリストを取得します。
+func (r *AutoScalingRepository) DescribeAutoScalingGroups(ctx context.Context, names []string) ([]types.AutoScalingGroup, error) {
+	var groups []types.AutoScalingGroup
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(r.client, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: names,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, output.AutoScalingGroups...)
+	}
+	return groups, nil
+}
+
+// DescribePolicies はAuto Scalingグループのスケーリングポリシーを取得します。
+func (r *AutoScalingRepository) DescribePolicies(ctx context.Context, asgName string) ([]types.ScalingPolicy, error) {
+	var policies []types.ScalingPolicy
+	paginator := autoscaling.NewDescribePoliciesPaginator(r.client, &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String(asgName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, output.ScalingPolicies...)
+	}
+	return policies, nil
+}
+
+// DescribeLifecycleHooks はAuto Scalingグループのライフサイクルフックを取得します。
+func (r *AutoScalingRepository) DescribeLifecycleHooks(ctx context.Context, asgName string) ([]types.LifecycleHook, error) {
+	result, err := r.client.DescribeLifecycleHooks(ctx, &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String(asgName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.LifecycleHooks, nil
+}
+
+// DescribeScheduledActions はAuto Scalingグループのスケジュールアクションを取得します。
+func (r *AutoScalingRepository) DescribeScheduledActions(ctx context.Context, asgName string) ([]types.ScheduledUpdateGroupAction, error) {
+	var actions []types.ScheduledUpdateGroupAction
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(r.client, &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: aws.String(asgName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, output.ScheduledUpdateGroupActions...)
+	}
+	return actions, nil
+}
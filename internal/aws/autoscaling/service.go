@@ -0,0 +1,428 @@
+package autoscaling
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// --- Domain Models ---
+
+// Tag はpropagate_at_launchを含むAuto Scalingグループのタグを保持します。
+type Tag struct {
+	Key               string
+	Value             string
+	PropagateAtLaunch bool
+}
+
+// StepAdjustment はステップスケーリングポリシーの1ステップを保持します。
+type StepAdjustment struct {
+	MetricIntervalLowerBound *float64
+	MetricIntervalUpperBound *float64
+	ScalingAdjustment        int32
+}
+
+// MetricDimension はCloudWatchメトリクスのディメンションです。
+type MetricDimension struct {
+	Name  string
+	Value string
+}
+
+// MetricStat はメトリクス計算に使うメトリクスと統計を保持します。
+type MetricStat struct {
+	MetricName string
+	Namespace  string
+	Dimensions []MetricDimension
+	Stat       string
+	Unit       string
+}
+
+// MetricDataQuery はカスタムメトリクスのメトリクス計算の1クエリを保持します。
+type MetricDataQuery struct {
+	ID         string
+	Expression string
+	Label      string
+	ReturnData *bool
+	MetricStat *MetricStat
+}
+
+// CustomizedMetricSpecification はターゲット追跡スケーリングのカスタムメトリクスを保持します。
+// 単一のメトリクスを使う場合は MetricName 等を、メトリクス計算を使う場合は Metrics を設定します。
+type CustomizedMetricSpecification struct {
+	MetricName string
+	Namespace  string
+	Statistic  string
+	Unit       string
+	Dimensions []MetricDimension
+	Metrics    []MetricDataQuery
+}
+
+// TargetTrackingConfiguration はターゲット追跡スケーリングの設定を保持します。
+type TargetTrackingConfiguration struct {
+	TargetValue          float64
+	PredefinedMetricType string
+	ResourceLabel        string
+	DisableScaleIn       bool
+	CustomizedMetric     *CustomizedMetricSpecification
+}
+
+// ScalingPolicy はAuto Scalingグループのスケーリングポリシーを保持します。
+type ScalingPolicy struct {
+	Name                    string
+	PolicyType              string
+	AdjustmentType          string
+	ScalingAdjustment       *int32
+	Cooldown                *int32
+	EstimatedInstanceWarmup *int32
+	MinAdjustmentMagnitude  *int32
+	MetricAggregationType   string
+	StepAdjustments         []StepAdjustment
+	TargetTracking          *TargetTrackingConfiguration
+}
+
+// LifecycleHook はAuto Scalingグループのライフサイクルフックを保持します。
+type LifecycleHook struct {
+	Name                  string
+	LifecycleTransition   string
+	DefaultResult         string
+	HeartbeatTimeout      int32
+	NotificationMetadata  string
+	NotificationTargetARN string
+	RoleARN               string
+}
+
+// ScheduledAction はAuto Scalingグループのスケジュールアクションを保持します。
+type ScheduledAction struct {
+	Name            string
+	MinSize         *int32
+	MaxSize         *int32
+	DesiredCapacity *int32
+	Recurrence      string
+	StartTime       string
+	EndTime         string
+	TimeZone        string
+}
+
+// LaunchTemplateSpecification は起動テンプレートとそのバージョンの指定を保持します。
+type LaunchTemplateSpecification struct {
+	ID      string
+	Name    string
+	Version string
+}
+
+// LaunchTemplateOverride は混合インスタンスポリシーのインスタンスタイプごとの上書き設定を保持します。
+// LaunchTemplate はインスタンスタイプごとに別の起動テンプレートを使う場合にのみ設定されます。
+type LaunchTemplateOverride struct {
+	InstanceType     string
+	WeightedCapacity string
+	LaunchTemplate   *LaunchTemplateSpecification
+}
+
+// InstancesDistribution は混合インスタンスポリシーのオンデマンドとスポットの配分を保持します。
+type InstancesDistribution struct {
+	OnDemandAllocationStrategy          string
+	OnDemandBaseCapacity                *int32
+	OnDemandPercentageAboveBaseCapacity *int32
+	SpotAllocationStrategy              string
+	SpotInstancePools                   *int32
+	SpotMaxPrice                        string
+}
+
+// MixedInstancesPolicy はAuto Scalingグループの混合インスタンスポリシーを保持します。
+type MixedInstancesPolicy struct {
+	LaunchTemplate        LaunchTemplateSpecification
+	Overrides             []LaunchTemplateOverride
+	InstancesDistribution *InstancesDistribution
+}
+
+// AutoScalingGroup はHCL生成に必要なAuto Scalingグループの情報を保持します。
+// LaunchTemplateID, LaunchTemplateName, LaunchTemplateVersion はグループに直接指定された起動テンプレートで、
+// 混合インスタンスポリシーの起動テンプレートは MixedInstancesPolicy に保持します。
+type AutoScalingGroup struct {
+	Name                   string
+	Arn                    string
+	MinSize                int32
+	MaxSize                int32
+	DesiredCapacity        int32
+	DefaultCooldown        int32
+	HealthCheckType        string
+	HealthCheckGracePeriod int32
+	AvailabilityZones      []string
+	SubnetIDs              []string
+	LaunchTemplateID       string
+	LaunchTemplateName     string
+	LaunchTemplateVersion  string
+	MixedInstancesPolicy   *MixedInstancesPolicy
+	TargetGroupARNs        []string
+	LoadBalancerNames      []string
+	TerminationPolicies    []string
+	Tags                   []Tag
+	Policies               []ScalingPolicy
+	LifecycleHooks         []LifecycleHook
+	ScheduledActions       []ScheduledAction
+}
+
+// --- Service Interface and Implementation ---
+
+// Service はAuto Scaling関連のビジネスロジックを定義します。
+type Service interface {
+	ListAutoScalingGroups(ctx context.Context, name string) ([]AutoScalingGroup, error)
+}
+
+// AutoScalingService はServiceインターフェースを実装します。
+type AutoScalingService struct {
+	repo AutoScalingRepositoryInterface
+}
+
+// NewAutoScalingService は新しいAutoScalingServiceを生成します。
+func NewAutoScalingService(repo AutoScalingRepositoryInterface) *AutoScalingService {
+	return &AutoScalingService{repo: repo}
+}
+
+// ListAutoScalingGroups はAuto Scalingグループとその付随リソースを取得します。
+// nameが指定された場合はその名前のグループのみを対象とします。
+func (s *AutoScalingService) ListAutoScalingGroups(ctx context.Context, name string) ([]AutoScalingGroup, error) {
+	var names []string
+	if name != "" {
+		names = append(names, name)
+	}
+
+	awsGroups, err := s.repo.DescribeAutoScalingGroups(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]AutoScalingGroup, len(awsGroups))
+	var eg errgroup.Group
+	for i, g := range awsGroups {
+		i, g := i, g
+		eg.Go(func() error {
+			group, err := s.buildAutoScalingGroup(ctx, g)
+			if err != nil {
+				return err
+			}
+			groups[i] = group
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (s *AutoScalingService) buildAutoScalingGroup(ctx context.Context, g types.AutoScalingGroup) (AutoScalingGroup, error) {
+	group := AutoScalingGroup{
+		Name:                   aws.ToString(g.AutoScalingGroupName),
+		Arn:                    aws.ToString(g.AutoScalingGroupARN),
+		MinSize:                aws.ToInt32(g.MinSize),
+		MaxSize:                aws.ToInt32(g.MaxSize),
+		DesiredCapacity:        aws.ToInt32(g.DesiredCapacity),
+		DefaultCooldown:        aws.ToInt32(g.DefaultCooldown),
+		HealthCheckType:        aws.ToString(g.HealthCheckType),
+		HealthCheckGracePeriod: aws.ToInt32(g.HealthCheckGracePeriod),
+		TargetGroupARNs:        g.TargetGroupARNs,
+		LoadBalancerNames:      g.LoadBalancerNames,
+		TerminationPolicies:    g.TerminationPolicies,
+	}
+
+	// VPCZoneIdentifierはサブネットIDのカンマ区切り文字列です。
+	if zones := aws.ToString(g.VPCZoneIdentifier); zones != "" {
+		group.SubnetIDs = strings.Split(zones, ",")
+	} else {
+		group.AvailabilityZones = g.AvailabilityZones
+	}
+
+	if lt := g.LaunchTemplate; lt != nil {
+		group.LaunchTemplateID = aws.ToString(lt.LaunchTemplateId)
+		group.LaunchTemplateName = aws.ToString(lt.LaunchTemplateName)
+		group.LaunchTemplateVersion = aws.ToString(lt.Version)
+	}
+	if mip := g.MixedInstancesPolicy; mip != nil {
+		group.MixedInstancesPolicy = convertMixedInstancesPolicy(mip)
+	}
+
+	for _, t := range g.Tags {
+		group.Tags = append(group.Tags, Tag{
+			Key:               aws.ToString(t.Key),
+			Value:             aws.ToString(t.Value),
+			PropagateAtLaunch: aws.ToBool(t.PropagateAtLaunch),
+		})
+	}
+
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		policies, err := s.repo.DescribePolicies(ctx, group.Name)
+		if err != nil {
+			return err
+		}
+		for _, p := range policies {
+			group.Policies = append(group.Policies, convertScalingPolicy(p))
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		hooks, err := s.repo.DescribeLifecycleHooks(ctx, group.Name)
+		if err != nil {
+			return err
+		}
+		for _, h := range hooks {
+			group.LifecycleHooks = append(group.LifecycleHooks, LifecycleHook{
+				Name:                  aws.ToString(h.LifecycleHookName),
+				LifecycleTransition:   aws.ToString(h.LifecycleTransition),
+				DefaultResult:         aws.ToString(h.DefaultResult),
+				HeartbeatTimeout:      aws.ToInt32(h.HeartbeatTimeout),
+				NotificationMetadata:  aws.ToString(h.NotificationMetadata),
+				NotificationTargetARN: aws.ToString(h.NotificationTargetARN),
+				RoleARN:               aws.ToString(h.RoleARN),
+			})
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		actions, err := s.repo.DescribeScheduledActions(ctx, group.Name)
+		if err != nil {
+			return err
+		}
+		for _, a := range actions {
+			group.ScheduledActions = append(group.ScheduledActions, ScheduledAction{
+				Name:            aws.ToString(a.ScheduledActionName),
+				MinSize:         a.MinSize,
+				MaxSize:         a.MaxSize,
+				DesiredCapacity: a.DesiredCapacity,
+				Recurrence:      aws.ToString(a.Recurrence),
+				StartTime:       formatTime(a.StartTime),
+				EndTime:         formatTime(a.EndTime),
+				TimeZone:        aws.ToString(a.TimeZone),
+			})
+		}
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		return AutoScalingGroup{}, err
+	}
+	return group, nil
+}
+
+func convertScalingPolicy(p types.ScalingPolicy) ScalingPolicy {
+	policy := ScalingPolicy{
+		Name:                    aws.ToString(p.PolicyName),
+		PolicyType:              aws.ToString(p.PolicyType),
+		AdjustmentType:          aws.ToString(p.AdjustmentType),
+		ScalingAdjustment:       p.ScalingAdjustment,
+		Cooldown:                p.Cooldown,
+		EstimatedInstanceWarmup: p.EstimatedInstanceWarmup,
+		MinAdjustmentMagnitude:  p.MinAdjustmentMagnitude,
+		MetricAggregationType:   aws.ToString(p.MetricAggregationType),
+	}
+	for _, step := range p.StepAdjustments {
+		policy.StepAdjustments = append(policy.StepAdjustments, StepAdjustment{
+			MetricIntervalLowerBound: step.MetricIntervalLowerBound,
+			MetricIntervalUpperBound: step.MetricIntervalUpperBound,
+			ScalingAdjustment:        aws.ToInt32(step.ScalingAdjustment),
+		})
+	}
+	if tt := p.TargetTrackingConfiguration; tt != nil {
+		policy.TargetTracking = &TargetTrackingConfiguration{
+			TargetValue:    aws.ToFloat64(tt.TargetValue),
+			DisableScaleIn: aws.ToBool(tt.DisableScaleIn),
+		}
+		if pm := tt.PredefinedMetricSpecification; pm != nil {
+			policy.TargetTracking.PredefinedMetricType = string(pm.PredefinedMetricType)
+			policy.TargetTracking.ResourceLabel = aws.ToString(pm.ResourceLabel)
+		}
+		if cm := tt.CustomizedMetricSpecification; cm != nil {
+			policy.TargetTracking.CustomizedMetric = convertCustomizedMetricSpecification(cm)
+		}
+	}
+	return policy
+}
+
+func convertMixedInstancesPolicy(p *types.MixedInstancesPolicy) *MixedInstancesPolicy {
+	policy := &MixedInstancesPolicy{}
+	if lt := p.LaunchTemplate; lt != nil {
+		if spec := convertLaunchTemplateSpecification(lt.LaunchTemplateSpecification); spec != nil {
+			policy.LaunchTemplate = *spec
+		}
+		for _, o := range lt.Overrides {
+			policy.Overrides = append(policy.Overrides, LaunchTemplateOverride{
+				InstanceType:     aws.ToString(o.InstanceType),
+				WeightedCapacity: aws.ToString(o.WeightedCapacity),
+				LaunchTemplate:   convertLaunchTemplateSpecification(o.LaunchTemplateSpecification),
+			})
+		}
+	}
+	if d := p.InstancesDistribution; d != nil {
+		policy.InstancesDistribution = &InstancesDistribution{
+			OnDemandAllocationStrategy:          aws.ToString(d.OnDemandAllocationStrategy),
+			OnDemandBaseCapacity:                d.OnDemandBaseCapacity,
+			OnDemandPercentageAboveBaseCapacity: d.OnDemandPercentageAboveBaseCapacity,
+			SpotAllocationStrategy:              aws.ToString(d.SpotAllocationStrategy),
+			SpotInstancePools:                   d.SpotInstancePools,
+			SpotMaxPrice:                        aws.ToString(d.SpotMaxPrice),
+		}
+	}
+	return policy
+}
+
+func convertLaunchTemplateSpecification(spec *types.LaunchTemplateSpecification) *LaunchTemplateSpecification {
+	if spec == nil {
+		return nil
+	}
+	return &LaunchTemplateSpecification{
+		ID:      aws.ToString(spec.LaunchTemplateId),
+		Name:    aws.ToString(spec.LaunchTemplateName),
+		Version: aws.ToString(spec.Version),
+	}
+}
+
+func convertCustomizedMetricSpecification(cm *types.CustomizedMetricSpecification) *CustomizedMetricSpecification {
+	spec := &CustomizedMetricSpecification{
+		MetricName: aws.ToString(cm.MetricName),
+		Namespace:  aws.ToString(cm.Namespace),
+		Statistic:  string(cm.Statistic),
+		Unit:       aws.ToString(cm.Unit),
+	}
+	for _, d := range cm.Dimensions {
+		spec.Dimensions = append(spec.Dimensions, MetricDimension{Name: aws.ToString(d.Name), Value: aws.ToString(d.Value)})
+	}
+	for _, q := range cm.Metrics {
+		query := MetricDataQuery{
+			ID:         aws.ToString(q.Id),
+			Expression: aws.ToString(q.Expression),
+			Label:      aws.ToString(q.Label),
+			ReturnData: q.ReturnData,
+		}
+		if ms := q.MetricStat; ms != nil {
+			query.MetricStat = &MetricStat{
+				Stat: aws.ToString(ms.Stat),
+				Unit: aws.ToString(ms.Unit),
+			}
+			if m := ms.Metric; m != nil {
+				query.MetricStat.MetricName = aws.ToString(m.MetricName)
+				query.MetricStat.Namespace = aws.ToString(m.Namespace)
+				for _, d := range m.Dimensions {
+					query.MetricStat.Dimensions = append(query.MetricStat.Dimensions, MetricDimension{Name: aws.ToString(d.Name), Value: aws.ToString(d.Value)})
+				}
+			}
+		}
+		spec.Metrics = append(spec.Metrics, query)
+	}
+	return spec
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
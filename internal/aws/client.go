@@ -6,13 +6,24 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
 // NewConfig はAWSの設定をロードして返します。
@@ -29,6 +40,16 @@ func NewEC2Client(cfg aws.Config) *ec2.Client {
 	return ec2.NewFromConfig(cfg)
 }
 
+// NewAutoScalingClient はAuto Scalingサービスクライアントを生成します。
+func NewAutoScalingClient(cfg aws.Config) *autoscaling.Client {
+	return autoscaling.NewFromConfig(cfg)
+}
+
+// NewApplicationAutoScalingClient はApplication Auto Scalingサービスクライアントを生成します。
+func NewApplicationAutoScalingClient(cfg aws.Config) *applicationautoscaling.Client {
+	return applicationautoscaling.NewFromConfig(cfg)
+}
+
 // NewS3Client はS3サービスクライアントを生成します。
 func NewS3Client(cfg aws.Config) *s3.Client {
 	return s3.NewFromConfig(cfg)
@@ -44,9 +65,25 @@ func NewELBV2Client(cfg aws.Config) *elbv2.Client {
 	return elbv2.NewFromConfig(cfg)
 }
 
+// NewClassicELBClient は Classic Load Balancer (elasticloadbalancing) のサービスクライアントを生成します。
+func NewClassicELBClient(cfg aws.Config) *elb.Client {
+	return elb.NewFromConfig(cfg)
+}
+
+// iamMaxAttempts はIAM APIのリトライを含めた最大試行回数です。
+const iamMaxAttempts = 10
+
 // NewIAMClient はIAMサービスクライアントを生成します。
+// IAM はアカウント単位でレート制限が厳しいため、Throttling エラーを受けると
+// 送信レートを自動で下げる adaptive モードでリトライします。
 func NewIAMClient(cfg aws.Config) *iam.Client {
-	return iam.NewFromConfig(cfg)
+	return iam.NewFromConfig(cfg, func(o *iam.Options) {
+		o.Retryer = retry.NewAdaptiveMode(func(ao *retry.AdaptiveModeOptions) {
+			ao.StandardOptions = append(ao.StandardOptions, func(so *retry.StandardOptions) {
+				so.MaxAttempts = iamMaxAttempts
+			})
+		})
+	})
 }
 
 // NewRDSClient はRDSサービスクライアントを生成します。
@@ -54,4 +91,39 @@ func NewRDSClient(cfg aws.Config) *rds.Client {
 	return rds.NewFromConfig(cfg)
 }
 
-// NewS3Client ... (今後他のクライアントもここに追加) 
+// NewServiceDiscoveryClient はCloud Mapサービスクライアントを生成します。
+func NewServiceDiscoveryClient(cfg aws.Config) *servicediscovery.Client {
+	return servicediscovery.NewFromConfig(cfg)
+}
+
+// NewRoute53Client はRoute 53サービスクライアントを生成します。
+func NewRoute53Client(cfg aws.Config) *route53.Client {
+	return route53.NewFromConfig(cfg)
+}
+
+// NewLambdaClient はLambdaサービスクライアントを生成します。
+func NewLambdaClient(cfg aws.Config) *lambda.Client {
+	return lambda.NewFromConfig(cfg)
+}
+
+// NewCloudWatchLogsClient はCloudWatch Logsサービスクライアントを生成します。
+func NewCloudWatchLogsClient(cfg aws.Config) *cloudwatchlogs.Client {
+	return cloudwatchlogs.NewFromConfig(cfg)
+}
+
+// NewAPIGatewayClient はAPI Gateway (REST API) サービスクライアントを生成します。
+func NewAPIGatewayClient(cfg aws.Config) *apigateway.Client {
+	return apigateway.NewFromConfig(cfg)
+}
+
+// NewAPIGatewayV2Client はAPI Gateway v2 (HTTP API / WebSocket API) サービスクライアントを生成します。
+func NewAPIGatewayV2Client(cfg aws.Config) *apigatewayv2.Client {
+	return apigatewayv2.NewFromConfig(cfg)
+}
+
+// NewSTSClient はSTSサービスクライアントを生成します。
+func NewSTSClient(cfg aws.Config) *sts.Client {
+	return sts.NewFromConfig(cfg)
+}
+
+// NewS3Client ... (今後他のクライアントもここに追加)
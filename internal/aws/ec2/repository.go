@@ -4,25 +4,57 @@ package ec2
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
 // EC2RepositoryInterface はEC2リソースへのアクセスを抽象化します。
 type EC2RepositoryInterface interface {
 	DescribeVpcs(ctx context.Context, filters []types.Filter) ([]types.Vpc, error)
 	DescribeSecurityGroups(ctx context.Context, groupIds []string) ([]types.SecurityGroup, error)
+	DescribeSecurityGroupsByFilters(ctx context.Context, filters []types.Filter) ([]types.SecurityGroup, error)
+	DescribeSecurityGroupRules(ctx context.Context, filters []types.Filter) ([]types.SecurityGroupRule, error)
+	DescribeVpcAttribute(ctx context.Context, vpcID string, attribute types.VpcAttributeName) (bool, error)
+	DescribeSubnets(ctx context.Context, filters []types.Filter) ([]types.Subnet, error)
+	DescribeRouteTables(ctx context.Context, filters []types.Filter) ([]types.RouteTable, error)
+	DescribeInternetGateways(ctx context.Context, filters []types.Filter) ([]types.InternetGateway, error)
+	DescribeNatGateways(ctx context.Context, filters []types.Filter) ([]types.NatGateway, error)
+	DescribeAddresses(ctx context.Context, allocationIds []string) ([]types.Address, error)
+	DescribeNetworkAcls(ctx context.Context, filters []types.Filter) ([]types.NetworkAcl, error)
+	DescribeInstances(ctx context.Context, instanceIds []string, filters []types.Filter) ([]types.Instance, error)
+	DescribeInstanceUserData(ctx context.Context, instanceID string) (string, error)
+	DescribeLaunchTemplates(ctx context.Context, launchTemplateIds []string, filters []types.Filter) ([]types.LaunchTemplate, error)
+	DescribeLaunchTemplateVersion(ctx context.Context, launchTemplateID, version string) (*types.LaunchTemplateVersion, error)
+	DescribeVolumes(ctx context.Context, volumeIds []string) ([]types.Volume, error)
+	DescribeNetworkInterfaces(ctx context.Context, filters []types.Filter) ([]types.NetworkInterface, error)
+	DescribeAddressesByFilters(ctx context.Context, filters []types.Filter) ([]types.Address, error)
+	DescribeDhcpOptions(ctx context.Context, filters []types.Filter) ([]types.DhcpOptions, error)
+	DescribeFlowLogs(ctx context.Context, filters []types.Filter) ([]types.FlowLog, error)
+	DescribeVpcEndpoints(ctx context.Context, filters []types.Filter) ([]types.VpcEndpoint, error)
+	DescribeVpcPeeringConnections(ctx context.Context, filters []types.Filter) ([]types.VpcPeeringConnection, error)
+	DescribeTransitGateways(ctx context.Context, filters []types.Filter) ([]types.TransitGateway, error)
+	DescribeTransitGatewayVpcAttachments(ctx context.Context, filters []types.Filter) ([]types.TransitGatewayVpcAttachment, error)
+	DescribeTransitGatewayRouteTables(ctx context.Context, filters []types.Filter) ([]types.TransitGatewayRouteTable, error)
+	GetTransitGatewayRouteTableAssociations(ctx context.Context, routeTableID string) ([]types.TransitGatewayRouteTableAssociation, error)
+	GetTransitGatewayRouteTablePropagations(ctx context.Context, routeTableID string) ([]types.TransitGatewayRouteTablePropagation, error)
+	SearchTransitGatewayStaticRoutes(ctx context.Context, routeTableID string) ([]types.TransitGatewayRoute, error)
+	GetCallerAccount(ctx context.Context) (string, error)
+	Region() string
 }
 
 // EC2Repository はEC2RepositoryInterfaceを実装します。
 type EC2Repository struct {
-	client *ec2.Client
+	client    *ec2.Client
+	stsClient *sts.Client
 }
 
 // NewEC2Repository は新しいEC2Repositoryを生成します。
-func NewEC2Repository(client *ec2.Client) *EC2Repository {
+func NewEC2Repository(client *ec2.Client, stsClient *sts.Client) *EC2Repository {
 	return &EC2Repository{
-		client: client,
+		client:    client,
+		stsClient: stsClient,
 	}
 }
 
@@ -48,4 +80,436 @@ func (r *EC2Repository) DescribeSecurityGroups(ctx context.Context, groupIds []s
 		return nil, err
 	}
 	return result.SecurityGroups, nil
-} 
+}
+
+// DescribeSecurityGroupsByFilters はフィルタに一致するSecurityGroupのリストを取得します。
+func (r *EC2Repository) DescribeSecurityGroupsByFilters(ctx context.Context, filters []types.Filter) ([]types.SecurityGroup, error) {
+	var sgs []types.SecurityGroup
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(r.client, &ec2.DescribeSecurityGroupsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sgs = append(sgs, output.SecurityGroups...)
+	}
+	return sgs, nil
+}
+
+// DescribeSecurityGroupRules はフィルタに一致するSecurityGroupRuleのリストを取得します。
+func (r *EC2Repository) DescribeSecurityGroupRules(ctx context.Context, filters []types.Filter) ([]types.SecurityGroupRule, error) {
+	var rules []types.SecurityGroupRule
+	paginator := ec2.NewDescribeSecurityGroupRulesPaginator(r.client, &ec2.DescribeSecurityGroupRulesInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, output.SecurityGroupRules...)
+	}
+	return rules, nil
+}
+
+// DescribeVpcAttribute はVPCのDNS関連の属性値を取得します。
+func (r *EC2Repository) DescribeVpcAttribute(ctx context.Context, vpcID string, attribute types.VpcAttributeName) (bool, error) {
+	input := &ec2.DescribeVpcAttributeInput{
+		VpcId:     aws.String(vpcID),
+		Attribute: attribute,
+	}
+	result, err := r.client.DescribeVpcAttribute(ctx, input)
+	if err != nil {
+		return false, err
+	}
+	switch attribute {
+	case types.VpcAttributeNameEnableDnsSupport:
+		if result.EnableDnsSupport != nil {
+			return aws.ToBool(result.EnableDnsSupport.Value), nil
+		}
+	case types.VpcAttributeNameEnableDnsHostnames:
+		if result.EnableDnsHostnames != nil {
+			return aws.ToBool(result.EnableDnsHostnames.Value), nil
+		}
+	}
+	return false, nil
+}
+
+// DescribeSubnets はAWSからSubnetのリストを取得します。
+func (r *EC2Repository) DescribeSubnets(ctx context.Context, filters []types.Filter) ([]types.Subnet, error) {
+	var subnets []types.Subnet
+	paginator := ec2.NewDescribeSubnetsPaginator(r.client, &ec2.DescribeSubnetsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		subnets = append(subnets, output.Subnets...)
+	}
+	return subnets, nil
+}
+
+// DescribeRouteTables はAWSからRouteTableのリストを取得します。
+func (r *EC2Repository) DescribeRouteTables(ctx context.Context, filters []types.Filter) ([]types.RouteTable, error) {
+	var routeTables []types.RouteTable
+	paginator := ec2.NewDescribeRouteTablesPaginator(r.client, &ec2.DescribeRouteTablesInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		routeTables = append(routeTables, output.RouteTables...)
+	}
+	return routeTables, nil
+}
+
+// DescribeInternetGateways はAWSからInternetGatewayのリストを取得します。
+func (r *EC2Repository) DescribeInternetGateways(ctx context.Context, filters []types.Filter) ([]types.InternetGateway, error) {
+	var igws []types.InternetGateway
+	paginator := ec2.NewDescribeInternetGatewaysPaginator(r.client, &ec2.DescribeInternetGatewaysInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		igws = append(igws, output.InternetGateways...)
+	}
+	return igws, nil
+}
+
+// DescribeNatGateways はAWSからNatGatewayのリストを取得します。
+func (r *EC2Repository) DescribeNatGateways(ctx context.Context, filters []types.Filter) ([]types.NatGateway, error) {
+	var natGateways []types.NatGateway
+	paginator := ec2.NewDescribeNatGatewaysPaginator(r.client, &ec2.DescribeNatGatewaysInput{
+		Filter: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		natGateways = append(natGateways, output.NatGateways...)
+	}
+	return natGateways, nil
+}
+
+// DescribeAddresses はAWSからElastic IPのリストを取得します。
+func (r *EC2Repository) DescribeAddresses(ctx context.Context, allocationIds []string) ([]types.Address, error) {
+	input := &ec2.DescribeAddressesInput{
+		AllocationIds: allocationIds,
+	}
+	result, err := r.client.DescribeAddresses(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.Addresses, nil
+}
+
+// DescribeInstances はAWSからEC2インスタンスのリストを取得します。
+func (r *EC2Repository) DescribeInstances(ctx context.Context, instanceIds []string, filters []types.Filter) ([]types.Instance, error) {
+	var instances []types.Instance
+	paginator := ec2.NewDescribeInstancesPaginator(r.client, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIds,
+		Filters:     filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range output.Reservations {
+			instances = append(instances, reservation.Instances...)
+		}
+	}
+	return instances, nil
+}
+
+// DescribeInstanceUserData はEC2インスタンスのユーザーデータ(Base64エンコード済み)を取得します。
+func (r *EC2Repository) DescribeInstanceUserData(ctx context.Context, instanceID string) (string, error) {
+	input := &ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  types.InstanceAttributeNameUserData,
+	}
+	result, err := r.client.DescribeInstanceAttribute(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	if result.UserData == nil {
+		return "", nil
+	}
+	return aws.ToString(result.UserData.Value), nil
+}
+
+// DescribeLaunchTemplates はAWSから起動テンプレートのリストを取得します。
+func (r *EC2Repository) DescribeLaunchTemplates(ctx context.Context, launchTemplateIds []string, filters []types.Filter) ([]types.LaunchTemplate, error) {
+	var templates []types.LaunchTemplate
+	paginator := ec2.NewDescribeLaunchTemplatesPaginator(r.client, &ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: launchTemplateIds,
+		Filters:           filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, output.LaunchTemplates...)
+	}
+	return templates, nil
+}
+
+// DescribeLaunchTemplateVersion は起動テンプレートの指定されたバージョンを取得します。
+func (r *EC2Repository) DescribeLaunchTemplateVersion(ctx context.Context, launchTemplateID, version string) (*types.LaunchTemplateVersion, error) {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+		Versions:         []string{version},
+	}
+	result, err := r.client.DescribeLaunchTemplateVersions(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.LaunchTemplateVersions) == 0 {
+		return nil, nil
+	}
+	return &result.LaunchTemplateVersions[0], nil
+}
+
+// DescribeVolumes はAWSからEBSボリュームのリストを取得します。
+func (r *EC2Repository) DescribeVolumes(ctx context.Context, volumeIds []string) ([]types.Volume, error) {
+	var volumes []types.Volume
+	paginator := ec2.NewDescribeVolumesPaginator(r.client, &ec2.DescribeVolumesInput{
+		VolumeIds: volumeIds,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, output.Volumes...)
+	}
+	return volumes, nil
+}
+
+// DescribeNetworkInterfaces はAWSからネットワークインターフェースのリストを取得します。
+func (r *EC2Repository) DescribeNetworkInterfaces(ctx context.Context, filters []types.Filter) ([]types.NetworkInterface, error) {
+	var enis []types.NetworkInterface
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(r.client, &ec2.DescribeNetworkInterfacesInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		enis = append(enis, output.NetworkInterfaces...)
+	}
+	return enis, nil
+}
+
+// DescribeAddressesByFilters はフィルタに一致するElastic IPのリストを取得します。
+func (r *EC2Repository) DescribeAddressesByFilters(ctx context.Context, filters []types.Filter) ([]types.Address, error) {
+	input := &ec2.DescribeAddressesInput{
+		Filters: filters,
+	}
+	result, err := r.client.DescribeAddresses(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.Addresses, nil
+}
+
+// DescribeNetworkAcls はAWSからNetworkAclのリストを取得します。
+func (r *EC2Repository) DescribeNetworkAcls(ctx context.Context, filters []types.Filter) ([]types.NetworkAcl, error) {
+	var acls []types.NetworkAcl
+	paginator := ec2.NewDescribeNetworkAclsPaginator(r.client, &ec2.DescribeNetworkAclsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		acls = append(acls, output.NetworkAcls...)
+	}
+	return acls, nil
+}
+
+// DescribeDhcpOptions はAWSからDHCPオプションセットのリストを取得します。
+func (r *EC2Repository) DescribeDhcpOptions(ctx context.Context, filters []types.Filter) ([]types.DhcpOptions, error) {
+	var dhcpOptions []types.DhcpOptions
+	paginator := ec2.NewDescribeDhcpOptionsPaginator(r.client, &ec2.DescribeDhcpOptionsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		dhcpOptions = append(dhcpOptions, output.DhcpOptions...)
+	}
+	return dhcpOptions, nil
+}
+
+// DescribeFlowLogs はAWSからフローログのリストを取得します。
+func (r *EC2Repository) DescribeFlowLogs(ctx context.Context, filters []types.Filter) ([]types.FlowLog, error) {
+	var flowLogs []types.FlowLog
+	paginator := ec2.NewDescribeFlowLogsPaginator(r.client, &ec2.DescribeFlowLogsInput{
+		Filter: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		flowLogs = append(flowLogs, output.FlowLogs...)
+	}
+	return flowLogs, nil
+}
+
+// DescribeVpcEndpoints はAWSからVPCエンドポイントのリストを取得します。
+func (r *EC2Repository) DescribeVpcEndpoints(ctx context.Context, filters []types.Filter) ([]types.VpcEndpoint, error) {
+	var endpoints []types.VpcEndpoint
+	paginator := ec2.NewDescribeVpcEndpointsPaginator(r.client, &ec2.DescribeVpcEndpointsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, output.VpcEndpoints...)
+	}
+	return endpoints, nil
+}
+
+// DescribeVpcPeeringConnections はAWSからVPCピアリング接続のリストを取得します。
+func (r *EC2Repository) DescribeVpcPeeringConnections(ctx context.Context, filters []types.Filter) ([]types.VpcPeeringConnection, error) {
+	var peerings []types.VpcPeeringConnection
+	paginator := ec2.NewDescribeVpcPeeringConnectionsPaginator(r.client, &ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		peerings = append(peerings, output.VpcPeeringConnections...)
+	}
+	return peerings, nil
+}
+
+// GetCallerAccount は認証情報のAWSアカウントIDを取得します。
+func (r *EC2Repository) GetCallerAccount(ctx context.Context) (string, error) {
+	output, err := r.stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(output.Account), nil
+}
+
+// Region はEC2クライアントのリージョンを返します。
+func (r *EC2Repository) Region() string {
+	return r.client.Options().Region
+}
+
+// DescribeTransitGateways はAWSからTransit Gatewayのリストを取得します。
+func (r *EC2Repository) DescribeTransitGateways(ctx context.Context, filters []types.Filter) ([]types.TransitGateway, error) {
+	var tgws []types.TransitGateway
+	paginator := ec2.NewDescribeTransitGatewaysPaginator(r.client, &ec2.DescribeTransitGatewaysInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tgws = append(tgws, output.TransitGateways...)
+	}
+	return tgws, nil
+}
+
+// DescribeTransitGatewayVpcAttachments はAWSからTransit GatewayのVPCアタッチメントのリストを取得します。
+func (r *EC2Repository) DescribeTransitGatewayVpcAttachments(ctx context.Context, filters []types.Filter) ([]types.TransitGatewayVpcAttachment, error) {
+	var attachments []types.TransitGatewayVpcAttachment
+	paginator := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(r.client, &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, output.TransitGatewayVpcAttachments...)
+	}
+	return attachments, nil
+}
+
+// DescribeTransitGatewayRouteTables はAWSからTransit Gatewayルートテーブルのリストを取得します。
+func (r *EC2Repository) DescribeTransitGatewayRouteTables(ctx context.Context, filters []types.Filter) ([]types.TransitGatewayRouteTable, error) {
+	var routeTables []types.TransitGatewayRouteTable
+	paginator := ec2.NewDescribeTransitGatewayRouteTablesPaginator(r.client, &ec2.DescribeTransitGatewayRouteTablesInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		routeTables = append(routeTables, output.TransitGatewayRouteTables...)
+	}
+	return routeTables, nil
+}
+
+// GetTransitGatewayRouteTableAssociations はTransit Gatewayルートテーブルの関連付けを取得します。
+func (r *EC2Repository) GetTransitGatewayRouteTableAssociations(ctx context.Context, routeTableID string) ([]types.TransitGatewayRouteTableAssociation, error) {
+	var associations []types.TransitGatewayRouteTableAssociation
+	paginator := ec2.NewGetTransitGatewayRouteTableAssociationsPaginator(r.client, &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		associations = append(associations, output.Associations...)
+	}
+	return associations, nil
+}
+
+// GetTransitGatewayRouteTablePropagations はTransit Gatewayルートテーブルの伝播設定を取得します。
+func (r *EC2Repository) GetTransitGatewayRouteTablePropagations(ctx context.Context, routeTableID string) ([]types.TransitGatewayRouteTablePropagation, error) {
+	var propagations []types.TransitGatewayRouteTablePropagation
+	paginator := ec2.NewGetTransitGatewayRouteTablePropagationsPaginator(r.client, &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		propagations = append(propagations, output.TransitGatewayRouteTablePropagations...)
+	}
+	return propagations, nil
+}
+
+// SearchTransitGatewayStaticRoutes はTransit Gatewayルートテーブルの静的ルートを取得します。
+func (r *EC2Repository) SearchTransitGatewayStaticRoutes(ctx context.Context, routeTableID string) ([]types.TransitGatewayRoute, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+		Filters: []types.Filter{
+			{Name: aws.String("type"), Values: []string{string(types.TransitGatewayRouteTypeStatic)}},
+		},
+	}
+	result, err := r.client.SearchTransitGatewayRoutes(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.Routes, nil
+}
@@ -3,30 +3,389 @@ package ec2
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"golang.org/x/sync/errgroup"
 )
 
 // Vpc はHCL生成に必要なVPCの情報を保持します。
 type Vpc struct {
+	ID                    string
+	CidrBlock             string
+	InstanceTenancy       string
+	EnableDnsSupport      bool
+	EnableDnsHostnames    bool
+	CidrBlockAssociations []CidrBlockAssociation
+	Subnets               []Subnet
+	RouteTables           []RouteTable
+	InternetGateways      []InternetGateway
+	NatGateways           []NatGateway
+	NetworkAcls           []NetworkAcl
+	DefaultNetworkAcl     *NetworkAcl
+	DefaultSecurityGroup  *SecurityGroup
+	DhcpOptions           *DhcpOptions
+	FlowLogs              []FlowLog
+	Tags                  map[string]string
+}
+
+// CidrBlockAssociation はVPCのセカンダリCIDRの関連付けを保持します。
+type CidrBlockAssociation struct {
+	AssociationID string
+	CidrBlock     string
+}
+
+// Subnet はHCL生成に必要なサブネットの情報を保持します。
+type Subnet struct {
+	ID                  string
+	CidrBlock           string
+	AvailabilityZone    string
+	MapPublicIpOnLaunch bool
+	Tags                map[string]string
+}
+
+// Route はルートテーブルの1つのルートを保持します。
+type Route struct {
+	DestinationCidrBlock     string
+	DestinationIpv6CidrBlock string
+	DestinationPrefixListID  string
+	GatewayID                string
+	NatGatewayID             string
+	TransitGatewayID         string
+	VpcPeeringConnectionID   string
+	NetworkInterfaceID       string
+}
+
+// RouteTableAssociation はルートテーブルとサブネットまたはゲートウェイの関連付けを保持します。
+type RouteTableAssociation struct {
 	ID        string
-	CidrBlock string
+	SubnetID  string
+	GatewayID string
+}
+
+// RouteTable はHCL生成に必要なルートテーブルの情報を保持します。
+type RouteTable struct {
+	ID           string
+	Main         bool
+	Routes       []Route
+	Associations []RouteTableAssociation
+	Tags         map[string]string
+}
+
+// InternetGateway はHCL生成に必要なインターネットゲートウェイの情報を保持します。
+type InternetGateway struct {
+	ID   string
+	Tags map[string]string
+}
+
+// Eip はHCL生成に必要なElastic IPの情報を保持します。
+// AssociationID等はインスタンスまたはENIに関連付けられている場合のみ設定されます。
+type Eip struct {
+	AllocationID       string
+	PublicIp           string
+	AssociationID      string
+	NetworkInterfaceID string
+	PrivateIpAddress   string
+	Tags               map[string]string
+}
+
+// NatGateway はHCL生成に必要なNATゲートウェイの情報を保持します。
+type NatGateway struct {
+	ID               string
+	SubnetID         string
+	ConnectivityType string
+	Eip              *Eip
+	Tags             map[string]string
+}
+
+// NetworkAclEntry はネットワークACLの1つのルールを保持します。
+type NetworkAclEntry struct {
+	RuleNumber    int32
+	Protocol      string
+	Action        string
+	CidrBlock     string
+	Ipv6CidrBlock string
+	FromPort      int32
+	ToPort        int32
+	IcmpType      int32
+	IcmpCode      int32
+}
+
+// NetworkAcl はHCL生成に必要なネットワークACLの情報を保持します。
+type NetworkAcl struct {
+	ID        string
+	VpcID     string
+	IsDefault bool
+	SubnetIDs []string
+	Ingress   []NetworkAclEntry
+	Egress    []NetworkAclEntry
 	Tags      map[string]string
 }
 
+// DhcpOptions はHCL生成に必要なDHCPオプションセットの情報を保持します。
+type DhcpOptions struct {
+	ID                 string
+	DomainName         string
+	DomainNameServers  []string
+	NtpServers         []string
+	NetbiosNameServers []string
+	NetbiosNodeType    string
+	VpcIDs             []string
+	Tags               map[string]string
+}
+
+// FlowLog はHCL生成に必要なフローログの情報を保持します。
+type FlowLog struct {
+	ID                     string
+	ResourceID             string
+	TrafficType            string
+	LogDestinationType     string
+	LogDestination         string
+	DeliverLogsPermission  string
+	LogFormat              string
+	MaxAggregationInterval int32
+	Tags                   map[string]string
+}
+
+// IpPermission はセキュリティグループのインラインルール1件分の情報を保持します。
+type IpPermission struct {
+	Protocol       string
+	FromPort       int32
+	ToPort         int32
+	CidrBlocks     []string
+	Ipv6CidrBlocks []string
+	PrefixListIDs  []string
+	SecurityGroups []string
+	Self           bool
+	Description    string
+}
+
+// SecurityGroupRule はHCL生成に必要なセキュリティグループルール(sgr-)の情報を保持します。
+type SecurityGroupRule struct {
+	ID                        string
+	IsEgress                  bool
+	IpProtocol                string
+	FromPort                  int32
+	ToPort                    int32
+	CidrIpv4                  string
+	CidrIpv6                  string
+	PrefixListID              string
+	ReferencedSecurityGroupID string
+	Description               string
+	Tags                      map[string]string
+}
+
 // SecurityGroup はHCL生成に必要なセキュリティグループの情報を保持します。
+// Ingress/Egressはインラインブロック用、Rulesは個別のルールリソース用の表現です。
 type SecurityGroup struct {
 	ID          string
 	Name        string
 	Description string
+	VpcID       string
+	Ingress     []IpPermission
+	Egress      []IpPermission
+	Rules       []SecurityGroupRule
 	Tags        map[string]string
 }
 
+// VpcEndpoint はHCL生成に必要なVPCエンドポイントの情報を保持します。
+type VpcEndpoint struct {
+	ID                string
+	VpcID             string
+	ServiceName       string
+	Type              string
+	PolicyDocument    string
+	PrivateDnsEnabled bool
+	RouteTableIDs     []string
+	SubnetIDs         []string
+	SecurityGroupIDs  []string
+	Tags              map[string]string
+}
+
+// VpcPeeringConnection はHCL生成に必要なVPCピアリング接続の情報を保持します。
+type VpcPeeringConnection struct {
+	ID               string
+	RequesterVpcID   string
+	RequesterOwnerID string
+	RequesterRegion  string
+	AccepterVpcID    string
+	AccepterOwnerID  string
+	AccepterRegion   string
+	// Accepter は実行中のアカウント・リージョンがアクセプタ側であることを表します。
+	Accepter bool
+	Tags     map[string]string
+}
+
+// TransitGatewayVpcAttachment はHCL生成に必要なTransit GatewayのVPCアタッチメントの情報を保持します。
+type TransitGatewayVpcAttachment struct {
+	ID                   string
+	VpcID                string
+	SubnetIDs            []string
+	DnsSupport           string
+	Ipv6Support          string
+	ApplianceModeSupport string
+	Tags                 map[string]string
+}
+
+// TransitGatewayRoute はTransit Gatewayルートテーブルの静的ルートを保持します。
+type TransitGatewayRoute struct {
+	DestinationCidrBlock string
+	AttachmentID         string
+	Blackhole            bool
+}
+
+// TransitGatewayRouteTable はHCL生成に必要なTransit Gatewayルートテーブルの情報を保持します。
+type TransitGatewayRouteTable struct {
+	ID                 string
+	DefaultAssociation bool
+	DefaultPropagation bool
+	AssociationIDs     []string
+	PropagationIDs     []string
+	Routes             []TransitGatewayRoute
+	Tags               map[string]string
+}
+
+// TransitGateway はHCL生成に必要なTransit Gatewayとそのアタッチメント、ルートテーブルの情報を保持します。
+type TransitGateway struct {
+	ID                             string
+	Description                    string
+	AmazonSideAsn                  int64
+	AutoAcceptSharedAttachments    string
+	DefaultRouteTableAssociation   string
+	DefaultRouteTablePropagation   string
+	DnsSupport                     string
+	VpnEcmpSupport                 string
+	AssociationDefaultRouteTableID string
+	PropagationDefaultRouteTableID string
+	VpcAttachments                 []TransitGatewayVpcAttachment
+	RouteTables                    []TransitGatewayRouteTable
+	Tags                           map[string]string
+}
+
+// EbsVolume はインスタンスにアタッチされたEBSボリュームの情報を保持します。
+type EbsVolume struct {
+	ID                  string
+	DeviceName          string
+	AvailabilityZone    string
+	Size                int32
+	Type                string
+	Iops                int32
+	Throughput          int32
+	Encrypted           bool
+	KmsKeyID            string
+	SnapshotID          string
+	DeleteOnTermination bool
+	Tags                map[string]string
+}
+
+// NetworkInterface はインスタンスにアタッチされたセカンダリENIの情報を保持します。
+type NetworkInterface struct {
+	ID               string
+	SubnetID         string
+	Description      string
+	PrivateIps       []string
+	SecurityGroupIDs []string
+	DeviceIndex      int32
+	SourceDestCheck  bool
+	Tags             map[string]string
+}
+
+// InstanceMetadataOptions はインスタンスメタデータサービスの設定を保持します。
+type InstanceMetadataOptions struct {
+	HttpEndpoint            string
+	HttpTokens              string
+	HttpPutResponseHopLimit int32
+	InstanceMetadataTags    string
+}
+
+// Instance はHCL生成に必要なEC2インスタンスとその関連リソースの情報を保持します。
+type Instance struct {
+	ID                 string
+	AmiID              string
+	InstanceType       string
+	SubnetID           string
+	PrivateIp          string
+	KeyName            string
+	IamInstanceProfile string
+	SecurityGroupIDs   []string
+	EbsOptimized       bool
+	Monitoring         bool
+	SourceDestCheck    bool
+	MetadataOptions    *InstanceMetadataOptions
+	RootBlockDevice    *EbsVolume
+	EbsVolumes         []EbsVolume
+	NetworkInterfaces  []NetworkInterface
+	Eips               []Eip
+	UserData           string
+	Tags               map[string]string
+}
+
+// LaunchTemplateBlockDevice は起動テンプレートのブロックデバイスマッピングを保持します。
+type LaunchTemplateBlockDevice struct {
+	DeviceName          string
+	VolumeSize          int32
+	VolumeType          string
+	Iops                int32
+	Throughput          int32
+	Encrypted           *bool
+	KmsKeyID            string
+	SnapshotID          string
+	DeleteOnTermination *bool
+}
+
+// LaunchTemplateNetworkInterface は起動テンプレートのネットワークインターフェース設定を保持します。
+type LaunchTemplateNetworkInterface struct {
+	DeviceIndex              int32
+	SubnetID                 string
+	Description              string
+	SecurityGroupIDs         []string
+	AssociatePublicIpAddress *bool
+	DeleteOnTermination      *bool
+}
+
+// LaunchTemplateTagSpecification は起動時に付与するタグの設定を保持します。
+type LaunchTemplateTagSpecification struct {
+	ResourceType string
+	Tags         map[string]string
+}
+
+// LaunchTemplate はHCL生成に必要な起動テンプレートとその1バージョン分の設定を保持します。
+type LaunchTemplate struct {
+	ID                  string
+	Name                string
+	Description         string
+	DefaultVersion      int64
+	LatestVersion       int64
+	ImageID             string
+	InstanceType        string
+	KeyName             string
+	IamInstanceProfile  string
+	SecurityGroupIDs    []string
+	EbsOptimized        *bool
+	Monitoring          bool
+	UserData            string
+	BlockDeviceMappings []LaunchTemplateBlockDevice
+	NetworkInterfaces   []LaunchTemplateNetworkInterface
+	MetadataOptions     *InstanceMetadataOptions
+	TagSpecifications   []LaunchTemplateTagSpecification
+	Tags                map[string]string
+}
+
 // Service はEC2関連のビジネスロジックを定義します。
 type Service interface {
 	ListVpcs(ctx context.Context, resourceName string) ([]Vpc, error)
 	ListSecurityGroups(ctx context.Context, groupIDs []string) ([]SecurityGroup, error)
+	ListVpcEndpoints(ctx context.Context, resourceName string) ([]VpcEndpoint, error)
+	ListVpcPeeringConnections(ctx context.Context, resourceName string) ([]VpcPeeringConnection, error)
+	ListTransitGateways(ctx context.Context, resourceName string) ([]TransitGateway, error)
+	ListNetworkAcls(ctx context.Context, resourceName string) ([]NetworkAcl, error)
+	ListDhcpOptions(ctx context.Context, resourceName string) ([]DhcpOptions, error)
+	ListFlowLogs(ctx context.Context, resourceName string) ([]FlowLog, error)
+	ListInstances(ctx context.Context, instanceIDs []string, resourceName, vpcID string) ([]Instance, error)
+	ListLaunchTemplates(ctx context.Context, launchTemplateIDs []string, resourceName string, useDefaultVersion bool) ([]LaunchTemplate, error)
 }
 
 // EC2Service はServiceを実装します。
@@ -43,28 +402,239 @@ func NewEC2Service(repo EC2RepositoryInterface) *EC2Service {
 
 // ListVpcs はVPCのリストを取得し、ドメインオブジェクトに変換します。
 func (s *EC2Service) ListVpcs(ctx context.Context, resourceName string) ([]Vpc, error) {
-	var filters []types.Filter
-	if resourceName != "" {
-		filters = append(filters, types.Filter{
-			Name:   aws.String("tag:Name"),
-			Values: []string{resourceName + "*"},
+	awsVpcs, err := s.repo.DescribeVpcs(ctx, nameFilters(resourceName))
+	if err != nil {
+		return nil, err
+	}
+
+	vpcs := make([]Vpc, len(awsVpcs))
+	var eg errgroup.Group
+	for i, v := range awsVpcs {
+		i, v := i, v
+		eg.Go(func() error {
+			vpc, err := s.buildVpc(ctx, v)
+			if err != nil {
+				return err
+			}
+			vpcs[i] = *vpc
+			return nil
 		})
 	}
 
-	awsVpcs, err := s.repo.DescribeVpcs(ctx, filters)
-	if err != nil {
+	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
+	return vpcs, nil
+}
+
+// buildVpc はVPCに属するサブネット、ルートテーブル、ゲートウェイ等を取得してVpcを組み立てます。
+func (s *EC2Service) buildVpc(ctx context.Context, v types.Vpc) (*Vpc, error) {
+	vpc := &Vpc{
+		ID:              *v.VpcId,
+		CidrBlock:       *v.CidrBlock,
+		InstanceTenancy: string(v.InstanceTenancy),
+		Tags:            convertTags(v.Tags),
+	}
 
-	var vpcs []Vpc
-	for _, v := range awsVpcs {
-		vpcs = append(vpcs, Vpc{
-			ID:        *v.VpcId,
-			CidrBlock: *v.CidrBlock,
-			Tags:      convertTags(v.Tags),
+	for _, assoc := range v.CidrBlockAssociationSet {
+		// プライマリCIDRはaws_vpc自体で管理するため除外します。
+		if aws.ToString(assoc.CidrBlock) == vpc.CidrBlock {
+			continue
+		}
+		if assoc.CidrBlockState != nil && assoc.CidrBlockState.State != types.VpcCidrBlockStateCodeAssociated {
+			continue
+		}
+		vpc.CidrBlockAssociations = append(vpc.CidrBlockAssociations, CidrBlockAssociation{
+			AssociationID: aws.ToString(assoc.AssociationId),
+			CidrBlock:     aws.ToString(assoc.CidrBlock),
 		})
 	}
-	return vpcs, nil
+
+	vpcFilter := []types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpc.ID}}}
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		var err error
+		vpc.EnableDnsSupport, err = s.repo.DescribeVpcAttribute(ctx, vpc.ID, types.VpcAttributeNameEnableDnsSupport)
+		return err
+	})
+
+	eg.Go(func() error {
+		var err error
+		vpc.EnableDnsHostnames, err = s.repo.DescribeVpcAttribute(ctx, vpc.ID, types.VpcAttributeNameEnableDnsHostnames)
+		return err
+	})
+
+	eg.Go(func() error {
+		awsSubnets, err := s.repo.DescribeSubnets(ctx, vpcFilter)
+		if err != nil {
+			return err
+		}
+		for _, sn := range awsSubnets {
+			vpc.Subnets = append(vpc.Subnets, Subnet{
+				ID:                  *sn.SubnetId,
+				CidrBlock:           aws.ToString(sn.CidrBlock),
+				AvailabilityZone:    aws.ToString(sn.AvailabilityZone),
+				MapPublicIpOnLaunch: aws.ToBool(sn.MapPublicIpOnLaunch),
+				Tags:                convertTags(sn.Tags),
+			})
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		awsRouteTables, err := s.repo.DescribeRouteTables(ctx, vpcFilter)
+		if err != nil {
+			return err
+		}
+		for _, rt := range awsRouteTables {
+			vpc.RouteTables = append(vpc.RouteTables, convertRouteTable(rt))
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		awsIgws, err := s.repo.DescribeInternetGateways(ctx, []types.Filter{
+			{Name: aws.String("attachment.vpc-id"), Values: []string{vpc.ID}},
+		})
+		if err != nil {
+			return err
+		}
+		for _, igw := range awsIgws {
+			vpc.InternetGateways = append(vpc.InternetGateways, InternetGateway{
+				ID:   *igw.InternetGatewayId,
+				Tags: convertTags(igw.Tags),
+			})
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		natGateways, err := s.listNatGateways(ctx, vpcFilter)
+		if err != nil {
+			return err
+		}
+		vpc.NatGateways = natGateways
+		return nil
+	})
+
+	eg.Go(func() error {
+		awsAcls, err := s.repo.DescribeNetworkAcls(ctx, vpcFilter)
+		if err != nil {
+			return err
+		}
+		for _, a := range awsAcls {
+			acl := convertNetworkAcl(a)
+			if acl.IsDefault {
+				vpc.DefaultNetworkAcl = &acl
+				continue
+			}
+			vpc.NetworkAcls = append(vpc.NetworkAcls, acl)
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		dhcpOptionsID := aws.ToString(v.DhcpOptionsId)
+		// "default"はAWSが提供するDHCPオプションセットが関連付けられていないことを表します。
+		if dhcpOptionsID == "" || dhcpOptionsID == "default" {
+			return nil
+		}
+		awsDhcpOptions, err := s.repo.DescribeDhcpOptions(ctx, []types.Filter{
+			{Name: aws.String("dhcp-options-id"), Values: []string{dhcpOptionsID}},
+		})
+		if err != nil {
+			return err
+		}
+		if len(awsDhcpOptions) > 0 {
+			dhcpOptions := convertDhcpOptions(awsDhcpOptions[0])
+			dhcpOptions.VpcIDs = []string{vpc.ID}
+			vpc.DhcpOptions = &dhcpOptions
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		awsFlowLogs, err := s.repo.DescribeFlowLogs(ctx, []types.Filter{
+			{Name: aws.String("resource-id"), Values: []string{vpc.ID}},
+		})
+		if err != nil {
+			return err
+		}
+		for _, fl := range awsFlowLogs {
+			vpc.FlowLogs = append(vpc.FlowLogs, convertFlowLog(fl))
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		awsSgs, err := s.repo.DescribeSecurityGroupsByFilters(ctx, append(vpcFilter, types.Filter{
+			Name:   aws.String("group-name"),
+			Values: []string{"default"},
+		}))
+		if err != nil {
+			return err
+		}
+		if len(awsSgs) > 0 {
+			sg := convertSecurityGroup(awsSgs[0])
+			vpc.DefaultSecurityGroup = &sg
+		}
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return vpc, nil
+}
+
+// listNatGateways は利用可能なNATゲートウェイと、それに割り当てられたElastic IPを取得します。
+func (s *EC2Service) listNatGateways(ctx context.Context, filters []types.Filter) ([]NatGateway, error) {
+	awsNatGateways, err := s.repo.DescribeNatGateways(ctx, append(filters, types.Filter{
+		Name:   aws.String("state"),
+		Values: []string{string(types.NatGatewayStateAvailable)},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var natGateways []NatGateway
+	var allocationIDs []string
+	for _, ngw := range awsNatGateways {
+		natGateway := NatGateway{
+			ID:               *ngw.NatGatewayId,
+			SubnetID:         aws.ToString(ngw.SubnetId),
+			ConnectivityType: string(ngw.ConnectivityType),
+			Tags:             convertTags(ngw.Tags),
+		}
+		for _, addr := range ngw.NatGatewayAddresses {
+			if aws.ToBool(addr.IsPrimary) && addr.AllocationId != nil {
+				natGateway.Eip = &Eip{AllocationID: *addr.AllocationId, PublicIp: aws.ToString(addr.PublicIp)}
+				allocationIDs = append(allocationIDs, *addr.AllocationId)
+			}
+		}
+		natGateways = append(natGateways, natGateway)
+	}
+
+	if len(allocationIDs) == 0 {
+		return natGateways, nil
+	}
+
+	awsAddresses, err := s.repo.DescribeAddresses(ctx, allocationIDs)
+	if err != nil {
+		return nil, err
+	}
+	addressTags := make(map[string]map[string]string)
+	for _, addr := range awsAddresses {
+		addressTags[aws.ToString(addr.AllocationId)] = convertTags(addr.Tags)
+	}
+	for i := range natGateways {
+		if natGateways[i].Eip != nil {
+			natGateways[i].Eip.Tags = addressTags[natGateways[i].Eip.AllocationID]
+		}
+	}
+
+	return natGateways, nil
 }
 
 // ListSecurityGroups は指定されたIDのセキュリティグループを取得します。
@@ -77,23 +647,826 @@ func (s *EC2Service) ListSecurityGroups(ctx context.Context, groupIDs []string)
 		return nil, err
 	}
 
+	awsRules, err := s.repo.DescribeSecurityGroupRules(ctx, []types.Filter{
+		{Name: aws.String("group-id"), Values: groupIDs},
+	})
+	if err != nil {
+		return nil, err
+	}
+	rulesByGroup := make(map[string][]SecurityGroupRule)
+	for _, r := range awsRules {
+		groupID := aws.ToString(r.GroupId)
+		rulesByGroup[groupID] = append(rulesByGroup[groupID], convertSecurityGroupRule(r))
+	}
+
 	var sgs []SecurityGroup
-	for _, sg := range awsSgs {
-		sgs = append(sgs, SecurityGroup{
-			ID:          *sg.GroupId,
-			Name:        *sg.GroupName,
-			Description: *sg.Description,
-			Tags:        convertTags(sg.Tags),
-		})
+	for _, awsSg := range awsSgs {
+		sg := convertSecurityGroup(awsSg)
+		sg.Rules = rulesByGroup[sg.ID]
+		sgs = append(sgs, sg)
 	}
 
 	return sgs, nil
 }
 
+func convertSecurityGroupRule(r types.SecurityGroupRule) SecurityGroupRule {
+	rule := SecurityGroupRule{
+		ID:           aws.ToString(r.SecurityGroupRuleId),
+		IsEgress:     aws.ToBool(r.IsEgress),
+		IpProtocol:   aws.ToString(r.IpProtocol),
+		FromPort:     aws.ToInt32(r.FromPort),
+		ToPort:       aws.ToInt32(r.ToPort),
+		CidrIpv4:     aws.ToString(r.CidrIpv4),
+		CidrIpv6:     aws.ToString(r.CidrIpv6),
+		PrefixListID: aws.ToString(r.PrefixListId),
+		Description:  aws.ToString(r.Description),
+		Tags:         convertTags(r.Tags),
+	}
+	if r.ReferencedGroupInfo != nil {
+		rule.ReferencedSecurityGroupID = aws.ToString(r.ReferencedGroupInfo.GroupId)
+	}
+	return rule
+}
+
+// ListVpcEndpoints はVPCエンドポイントのリストを取得し、ドメインオブジェクトに変換します。
+func (s *EC2Service) ListVpcEndpoints(ctx context.Context, resourceName string) ([]VpcEndpoint, error) {
+	awsEndpoints, err := s.repo.DescribeVpcEndpoints(ctx, nameFilters(resourceName))
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []VpcEndpoint
+	for _, e := range awsEndpoints {
+		if e.State == types.StateDeleted || e.State == types.StateDeleting {
+			continue
+		}
+		var sgIDs []string
+		for _, g := range e.Groups {
+			sgIDs = append(sgIDs, aws.ToString(g.GroupId))
+		}
+		endpoints = append(endpoints, VpcEndpoint{
+			ID:                *e.VpcEndpointId,
+			VpcID:             aws.ToString(e.VpcId),
+			ServiceName:       aws.ToString(e.ServiceName),
+			Type:              string(e.VpcEndpointType),
+			PolicyDocument:    aws.ToString(e.PolicyDocument),
+			PrivateDnsEnabled: aws.ToBool(e.PrivateDnsEnabled),
+			RouteTableIDs:     e.RouteTableIds,
+			SubnetIDs:         e.SubnetIds,
+			SecurityGroupIDs:  sgIDs,
+			Tags:              convertTags(e.Tags),
+		})
+	}
+	return endpoints, nil
+}
+
+// ListVpcPeeringConnections は有効なVPCピアリング接続のリストを取得し、ドメインオブジェクトに変換します。
+func (s *EC2Service) ListVpcPeeringConnections(ctx context.Context, resourceName string) ([]VpcPeeringConnection, error) {
+	filters := append(nameFilters(resourceName), types.Filter{
+		Name:   aws.String("status-code"),
+		Values: []string{string(types.VpcPeeringConnectionStateReasonCodeActive)},
+	})
+	awsPeerings, err := s.repo.DescribeVpcPeeringConnections(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	if len(awsPeerings) == 0 {
+		return nil, nil
+	}
+	// どちら側のリソースとして管理するかは、実行中のアカウント・リージョンがリクエスタかどうかで決まります。
+	account, err := s.repo.GetCallerAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	region := s.repo.Region()
+
+	var peerings []VpcPeeringConnection
+	for _, p := range awsPeerings {
+		peering := VpcPeeringConnection{
+			ID:   *p.VpcPeeringConnectionId,
+			Tags: convertTags(p.Tags),
+		}
+		if p.RequesterVpcInfo != nil {
+			peering.RequesterVpcID = aws.ToString(p.RequesterVpcInfo.VpcId)
+			peering.RequesterOwnerID = aws.ToString(p.RequesterVpcInfo.OwnerId)
+			peering.RequesterRegion = aws.ToString(p.RequesterVpcInfo.Region)
+		}
+		if p.AccepterVpcInfo != nil {
+			peering.AccepterVpcID = aws.ToString(p.AccepterVpcInfo.VpcId)
+			peering.AccepterOwnerID = aws.ToString(p.AccepterVpcInfo.OwnerId)
+			peering.AccepterRegion = aws.ToString(p.AccepterVpcInfo.Region)
+		}
+		peering.Accepter = peering.RequesterOwnerID != account || peering.RequesterRegion != region
+		peerings = append(peerings, peering)
+	}
+	return peerings, nil
+}
+
+// ListTransitGateways はTransit Gatewayと、そのVPCアタッチメント、ルートテーブルを取得します。
+func (s *EC2Service) ListTransitGateways(ctx context.Context, resourceName string) ([]TransitGateway, error) {
+	filters := append(nameFilters(resourceName), types.Filter{
+		Name:   aws.String("state"),
+		Values: []string{string(types.TransitGatewayStateAvailable)},
+	})
+	awsTgws, err := s.repo.DescribeTransitGateways(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	tgws := make([]TransitGateway, len(awsTgws))
+	var eg errgroup.Group
+	for i, t := range awsTgws {
+		i, t := i, t
+		eg.Go(func() error {
+			tgw, err := s.buildTransitGateway(ctx, t)
+			if err != nil {
+				return err
+			}
+			tgws[i] = *tgw
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return tgws, nil
+}
+
+func (s *EC2Service) buildTransitGateway(ctx context.Context, t types.TransitGateway) (*TransitGateway, error) {
+	tgw := &TransitGateway{
+		ID:          *t.TransitGatewayId,
+		Description: aws.ToString(t.Description),
+		Tags:        convertTags(t.Tags),
+	}
+	if o := t.Options; o != nil {
+		tgw.AmazonSideAsn = aws.ToInt64(o.AmazonSideAsn)
+		tgw.AutoAcceptSharedAttachments = string(o.AutoAcceptSharedAttachments)
+		tgw.DefaultRouteTableAssociation = string(o.DefaultRouteTableAssociation)
+		tgw.DefaultRouteTablePropagation = string(o.DefaultRouteTablePropagation)
+		tgw.DnsSupport = string(o.DnsSupport)
+		tgw.VpnEcmpSupport = string(o.VpnEcmpSupport)
+		tgw.AssociationDefaultRouteTableID = aws.ToString(o.AssociationDefaultRouteTableId)
+		tgw.PropagationDefaultRouteTableID = aws.ToString(o.PropagationDefaultRouteTableId)
+	}
+
+	tgwFilters := []types.Filter{
+		{Name: aws.String("transit-gateway-id"), Values: []string{tgw.ID}},
+	}
+
+	awsAttachments, err := s.repo.DescribeTransitGatewayVpcAttachments(ctx, append(tgwFilters, types.Filter{
+		Name:   aws.String("state"),
+		Values: []string{string(types.TransitGatewayAttachmentStateAvailable)},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range awsAttachments {
+		attachment := TransitGatewayVpcAttachment{
+			ID:        *a.TransitGatewayAttachmentId,
+			VpcID:     aws.ToString(a.VpcId),
+			SubnetIDs: a.SubnetIds,
+			Tags:      convertTags(a.Tags),
+		}
+		if a.Options != nil {
+			attachment.DnsSupport = string(a.Options.DnsSupport)
+			attachment.Ipv6Support = string(a.Options.Ipv6Support)
+			attachment.ApplianceModeSupport = string(a.Options.ApplianceModeSupport)
+		}
+		tgw.VpcAttachments = append(tgw.VpcAttachments, attachment)
+	}
+
+	awsRouteTables, err := s.repo.DescribeTransitGatewayRouteTables(ctx, tgwFilters)
+	if err != nil {
+		return nil, err
+	}
+	for _, rt := range awsRouteTables {
+		routeTable, err := s.buildTransitGatewayRouteTable(ctx, rt)
+		if err != nil {
+			return nil, err
+		}
+		tgw.RouteTables = append(tgw.RouteTables, *routeTable)
+	}
+
+	return tgw, nil
+}
+
+func (s *EC2Service) buildTransitGatewayRouteTable(ctx context.Context, rt types.TransitGatewayRouteTable) (*TransitGatewayRouteTable, error) {
+	routeTable := &TransitGatewayRouteTable{
+		ID:                 *rt.TransitGatewayRouteTableId,
+		DefaultAssociation: aws.ToBool(rt.DefaultAssociationRouteTable),
+		DefaultPropagation: aws.ToBool(rt.DefaultPropagationRouteTable),
+		Tags:               convertTags(rt.Tags),
+	}
+
+	associations, err := s.repo.GetTransitGatewayRouteTableAssociations(ctx, routeTable.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range associations {
+		if a.State == types.TransitGatewayAssociationStateAssociated {
+			routeTable.AssociationIDs = append(routeTable.AssociationIDs, aws.ToString(a.TransitGatewayAttachmentId))
+		}
+	}
+
+	propagations, err := s.repo.GetTransitGatewayRouteTablePropagations(ctx, routeTable.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range propagations {
+		if p.State == types.TransitGatewayPropagationStateEnabled {
+			routeTable.PropagationIDs = append(routeTable.PropagationIDs, aws.ToString(p.TransitGatewayAttachmentId))
+		}
+	}
+
+	routes, err := s.repo.SearchTransitGatewayStaticRoutes(ctx, routeTable.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range routes {
+		route := TransitGatewayRoute{
+			DestinationCidrBlock: aws.ToString(r.DestinationCidrBlock),
+			Blackhole:            r.State == types.TransitGatewayRouteStateBlackhole,
+		}
+		if len(r.TransitGatewayAttachments) > 0 {
+			route.AttachmentID = aws.ToString(r.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+		}
+		routeTable.Routes = append(routeTable.Routes, route)
+	}
+
+	return routeTable, nil
+}
+
+// ListNetworkAcls はデフォルト以外のネットワークACLのリストを取得し、ドメインオブジェクトに変換します。
+func (s *EC2Service) ListNetworkAcls(ctx context.Context, resourceName string) ([]NetworkAcl, error) {
+	filters := append(nameFilters(resourceName), types.Filter{
+		Name:   aws.String("default"),
+		Values: []string{"false"},
+	})
+	awsAcls, err := s.repo.DescribeNetworkAcls(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var acls []NetworkAcl
+	for _, a := range awsAcls {
+		acls = append(acls, convertNetworkAcl(a))
+	}
+	return acls, nil
+}
+
+// ListDhcpOptions はDHCPオプションセットと、それが関連付けられたVPCを取得します。
+func (s *EC2Service) ListDhcpOptions(ctx context.Context, resourceName string) ([]DhcpOptions, error) {
+	awsDhcpOptions, err := s.repo.DescribeDhcpOptions(ctx, nameFilters(resourceName))
+	if err != nil {
+		return nil, err
+	}
+
+	dhcpOptions := make([]DhcpOptions, len(awsDhcpOptions))
+	var eg errgroup.Group
+	for i, o := range awsDhcpOptions {
+		i, o := i, o
+		eg.Go(func() error {
+			opts := convertDhcpOptions(o)
+			awsVpcs, err := s.repo.DescribeVpcs(ctx, []types.Filter{
+				{Name: aws.String("dhcp-options-id"), Values: []string{opts.ID}},
+			})
+			if err != nil {
+				return err
+			}
+			for _, v := range awsVpcs {
+				opts.VpcIDs = append(opts.VpcIDs, *v.VpcId)
+			}
+			dhcpOptions[i] = opts
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return dhcpOptions, nil
+}
+
+// ListFlowLogs はVPC、サブネット、ENIに設定されたフローログのリストを取得します。
+func (s *EC2Service) ListFlowLogs(ctx context.Context, resourceName string) ([]FlowLog, error) {
+	awsFlowLogs, err := s.repo.DescribeFlowLogs(ctx, nameFilters(resourceName))
+	if err != nil {
+		return nil, err
+	}
+
+	var flowLogs []FlowLog
+	for _, fl := range awsFlowLogs {
+		flowLogs = append(flowLogs, convertFlowLog(fl))
+	}
+	return flowLogs, nil
+}
+
+// ListInstances はEC2インスタンスを、ID、Nameタグ、VPCのいずれかで絞り込んで取得します。
+// 終了済みのインスタンスは対象外です。
+func (s *EC2Service) ListInstances(ctx context.Context, instanceIDs []string, resourceName, vpcID string) ([]Instance, error) {
+	filters := append(nameFilters(resourceName), types.Filter{
+		Name:   aws.String("instance-state-name"),
+		Values: []string{"pending", "running", "stopping", "stopped"},
+	})
+	if vpcID != "" {
+		filters = append(filters, types.Filter{Name: aws.String("vpc-id"), Values: []string{vpcID}})
+	}
+
+	awsInstances, err := s.repo.DescribeInstances(ctx, instanceIDs, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]Instance, len(awsInstances))
+	var eg errgroup.Group
+	for i, inst := range awsInstances {
+		i, inst := i, inst
+		eg.Go(func() error {
+			instance, err := s.buildInstance(ctx, inst)
+			if err != nil {
+				return err
+			}
+			instances[i] = *instance
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+func (s *EC2Service) buildInstance(ctx context.Context, inst types.Instance) (*Instance, error) {
+	instance := &Instance{
+		ID:              *inst.InstanceId,
+		AmiID:           aws.ToString(inst.ImageId),
+		InstanceType:    string(inst.InstanceType),
+		SubnetID:        aws.ToString(inst.SubnetId),
+		PrivateIp:       aws.ToString(inst.PrivateIpAddress),
+		KeyName:         aws.ToString(inst.KeyName),
+		EbsOptimized:    aws.ToBool(inst.EbsOptimized),
+		SourceDestCheck: aws.ToBool(inst.SourceDestCheck),
+		Tags:            convertTags(inst.Tags),
+	}
+	for _, g := range inst.SecurityGroups {
+		instance.SecurityGroupIDs = append(instance.SecurityGroupIDs, aws.ToString(g.GroupId))
+	}
+	if inst.IamInstanceProfile != nil {
+		// ARNの末尾(instance-profile/<name>)からインスタンスプロファイル名を取り出します。
+		arn := aws.ToString(inst.IamInstanceProfile.Arn)
+		instance.IamInstanceProfile = arn[strings.LastIndex(arn, "/")+1:]
+	}
+	if inst.Monitoring != nil {
+		instance.Monitoring = inst.Monitoring.State == types.MonitoringStateEnabled || inst.Monitoring.State == types.MonitoringStatePending
+	}
+	if o := inst.MetadataOptions; o != nil {
+		instance.MetadataOptions = &InstanceMetadataOptions{
+			HttpEndpoint:            string(o.HttpEndpoint),
+			HttpTokens:              string(o.HttpTokens),
+			HttpPutResponseHopLimit: aws.ToInt32(o.HttpPutResponseHopLimit),
+			InstanceMetadataTags:    string(o.InstanceMetadataTags),
+		}
+	}
+
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		return s.attachVolumes(ctx, instance, inst)
+	})
+
+	eg.Go(func() error {
+		awsEnis, err := s.repo.DescribeNetworkInterfaces(ctx, []types.Filter{
+			{Name: aws.String("attachment.instance-id"), Values: []string{instance.ID}},
+		})
+		if err != nil {
+			return err
+		}
+		for _, eni := range awsEnis {
+			// デバイスインデックス0のプライマリENIはインスタンス自体で管理します。
+			if eni.Attachment == nil || aws.ToInt32(eni.Attachment.DeviceIndex) == 0 {
+				continue
+			}
+			networkInterface := NetworkInterface{
+				ID:              *eni.NetworkInterfaceId,
+				SubnetID:        aws.ToString(eni.SubnetId),
+				Description:     aws.ToString(eni.Description),
+				DeviceIndex:     aws.ToInt32(eni.Attachment.DeviceIndex),
+				SourceDestCheck: aws.ToBool(eni.SourceDestCheck),
+				Tags:            convertTags(eni.TagSet),
+			}
+			for _, ip := range eni.PrivateIpAddresses {
+				networkInterface.PrivateIps = append(networkInterface.PrivateIps, aws.ToString(ip.PrivateIpAddress))
+			}
+			for _, g := range eni.Groups {
+				networkInterface.SecurityGroupIDs = append(networkInterface.SecurityGroupIDs, aws.ToString(g.GroupId))
+			}
+			instance.NetworkInterfaces = append(instance.NetworkInterfaces, networkInterface)
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		awsAddresses, err := s.repo.DescribeAddressesByFilters(ctx, []types.Filter{
+			{Name: aws.String("instance-id"), Values: []string{instance.ID}},
+		})
+		if err != nil {
+			return err
+		}
+		for _, addr := range awsAddresses {
+			instance.Eips = append(instance.Eips, Eip{
+				AllocationID:       aws.ToString(addr.AllocationId),
+				PublicIp:           aws.ToString(addr.PublicIp),
+				AssociationID:      aws.ToString(addr.AssociationId),
+				NetworkInterfaceID: aws.ToString(addr.NetworkInterfaceId),
+				PrivateIpAddress:   aws.ToString(addr.PrivateIpAddress),
+				Tags:               convertTags(addr.Tags),
+			})
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		encoded, err := s.repo.DescribeInstanceUserData(ctx, instance.ID)
+		if err != nil {
+			return err
+		}
+		if encoded == "" {
+			return nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to decode user data of %s: %w", instance.ID, err)
+		}
+		instance.UserData = string(decoded)
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// attachVolumes はインスタンスのブロックデバイスマッピングからEBSボリュームの詳細を取得し、
+// ルートデバイスとそれ以外のボリュームに振り分けます。
+func (s *EC2Service) attachVolumes(ctx context.Context, instance *Instance, inst types.Instance) error {
+	deviceNames := make(map[string]string)
+	deleteOnTermination := make(map[string]bool)
+	var volumeIDs []string
+	for _, bdm := range inst.BlockDeviceMappings {
+		if bdm.Ebs == nil || bdm.Ebs.VolumeId == nil {
+			continue
+		}
+		volumeIDs = append(volumeIDs, *bdm.Ebs.VolumeId)
+		deviceNames[*bdm.Ebs.VolumeId] = aws.ToString(bdm.DeviceName)
+		deleteOnTermination[*bdm.Ebs.VolumeId] = aws.ToBool(bdm.Ebs.DeleteOnTermination)
+	}
+	if len(volumeIDs) == 0 {
+		return nil
+	}
+
+	awsVolumes, err := s.repo.DescribeVolumes(ctx, volumeIDs)
+	if err != nil {
+		return err
+	}
+	rootDeviceName := aws.ToString(inst.RootDeviceName)
+	for _, v := range awsVolumes {
+		volume := EbsVolume{
+			ID:                  *v.VolumeId,
+			DeviceName:          deviceNames[*v.VolumeId],
+			AvailabilityZone:    aws.ToString(v.AvailabilityZone),
+			Size:                aws.ToInt32(v.Size),
+			Type:                string(v.VolumeType),
+			Iops:                aws.ToInt32(v.Iops),
+			Throughput:          aws.ToInt32(v.Throughput),
+			Encrypted:           aws.ToBool(v.Encrypted),
+			KmsKeyID:            aws.ToString(v.KmsKeyId),
+			SnapshotID:          aws.ToString(v.SnapshotId),
+			DeleteOnTermination: deleteOnTermination[*v.VolumeId],
+			Tags:                convertTags(v.Tags),
+		}
+		if volume.DeviceName == rootDeviceName {
+			instance.RootBlockDevice = &volume
+			continue
+		}
+		instance.EbsVolumes = append(instance.EbsVolumes, volume)
+	}
+	return nil
+}
+
+// ListLaunchTemplates は起動テンプレートを取得し、最新バージョン(useDefaultVersionがtrueの場合は
+// デフォルトバージョン)の設定をドメインオブジェクトに変換します。
+func (s *EC2Service) ListLaunchTemplates(ctx context.Context, launchTemplateIDs []string, resourceName string, useDefaultVersion bool) ([]LaunchTemplate, error) {
+	var filters []types.Filter
+	if resourceName != "" {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("launch-template-name"),
+			Values: []string{resourceName + "*"},
+		})
+	}
+	awsTemplates, err := s.repo.DescribeLaunchTemplates(ctx, launchTemplateIDs, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]LaunchTemplate, len(awsTemplates))
+	var eg errgroup.Group
+	for i, lt := range awsTemplates {
+		i, lt := i, lt
+		eg.Go(func() error {
+			template := LaunchTemplate{
+				ID:             *lt.LaunchTemplateId,
+				Name:           aws.ToString(lt.LaunchTemplateName),
+				DefaultVersion: aws.ToInt64(lt.DefaultVersionNumber),
+				LatestVersion:  aws.ToInt64(lt.LatestVersionNumber),
+				Tags:           convertTags(lt.Tags),
+			}
+			version := "$Latest"
+			if useDefaultVersion {
+				version = "$Default"
+			}
+			ltv, err := s.repo.DescribeLaunchTemplateVersion(ctx, template.ID, version)
+			if err != nil {
+				return err
+			}
+			if ltv != nil {
+				template.Description = aws.ToString(ltv.VersionDescription)
+				if err := applyLaunchTemplateData(&template, ltv.LaunchTemplateData); err != nil {
+					return err
+				}
+			}
+			templates[i] = template
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func applyLaunchTemplateData(template *LaunchTemplate, data *types.ResponseLaunchTemplateData) error {
+	if data == nil {
+		return nil
+	}
+	template.ImageID = aws.ToString(data.ImageId)
+	template.InstanceType = string(data.InstanceType)
+	template.KeyName = aws.ToString(data.KeyName)
+	template.SecurityGroupIDs = data.SecurityGroupIds
+	template.EbsOptimized = data.EbsOptimized
+	if data.IamInstanceProfile != nil {
+		template.IamInstanceProfile = aws.ToString(data.IamInstanceProfile.Name)
+		if template.IamInstanceProfile == "" {
+			arn := aws.ToString(data.IamInstanceProfile.Arn)
+			template.IamInstanceProfile = arn[strings.LastIndex(arn, "/")+1:]
+		}
+	}
+	if data.Monitoring != nil {
+		template.Monitoring = aws.ToBool(data.Monitoring.Enabled)
+	}
+	if data.UserData != nil {
+		decoded, err := base64.StdEncoding.DecodeString(*data.UserData)
+		if err != nil {
+			return fmt.Errorf("failed to decode user data of %s: %w", template.ID, err)
+		}
+		template.UserData = string(decoded)
+	}
+	for _, bdm := range data.BlockDeviceMappings {
+		device := LaunchTemplateBlockDevice{DeviceName: aws.ToString(bdm.DeviceName)}
+		if ebs := bdm.Ebs; ebs != nil {
+			device.VolumeSize = aws.ToInt32(ebs.VolumeSize)
+			device.VolumeType = string(ebs.VolumeType)
+			device.Iops = aws.ToInt32(ebs.Iops)
+			device.Throughput = aws.ToInt32(ebs.Throughput)
+			device.Encrypted = ebs.Encrypted
+			device.KmsKeyID = aws.ToString(ebs.KmsKeyId)
+			device.SnapshotID = aws.ToString(ebs.SnapshotId)
+			device.DeleteOnTermination = ebs.DeleteOnTermination
+		}
+		template.BlockDeviceMappings = append(template.BlockDeviceMappings, device)
+	}
+	for _, ni := range data.NetworkInterfaces {
+		template.NetworkInterfaces = append(template.NetworkInterfaces, LaunchTemplateNetworkInterface{
+			DeviceIndex:              aws.ToInt32(ni.DeviceIndex),
+			SubnetID:                 aws.ToString(ni.SubnetId),
+			Description:              aws.ToString(ni.Description),
+			SecurityGroupIDs:         ni.Groups,
+			AssociatePublicIpAddress: ni.AssociatePublicIpAddress,
+			DeleteOnTermination:      ni.DeleteOnTermination,
+		})
+	}
+	if o := data.MetadataOptions; o != nil {
+		template.MetadataOptions = &InstanceMetadataOptions{
+			HttpEndpoint:            string(o.HttpEndpoint),
+			HttpTokens:              string(o.HttpTokens),
+			HttpPutResponseHopLimit: aws.ToInt32(o.HttpPutResponseHopLimit),
+			InstanceMetadataTags:    string(o.InstanceMetadataTags),
+		}
+	}
+	for _, ts := range data.TagSpecifications {
+		template.TagSpecifications = append(template.TagSpecifications, LaunchTemplateTagSpecification{
+			ResourceType: string(ts.ResourceType),
+			Tags:         convertTags(ts.Tags),
+		})
+	}
+	return nil
+}
+
+// nameFilters はNameタグの前方一致で絞り込むフィルタを返します。resourceNameが空の場合はnilを返します。
+func nameFilters(resourceName string) []types.Filter {
+	if resourceName == "" {
+		return nil
+	}
+	return []types.Filter{
+		{
+			Name:   aws.String("tag:Name"),
+			Values: []string{resourceName + "*"},
+		},
+	}
+}
+
+func convertSecurityGroup(sg types.SecurityGroup) SecurityGroup {
+	return SecurityGroup{
+		ID:          *sg.GroupId,
+		Name:        *sg.GroupName,
+		Description: aws.ToString(sg.Description),
+		VpcID:       aws.ToString(sg.VpcId),
+		Ingress:     convertIpPermissions(sg.IpPermissions, *sg.GroupId),
+		Egress:      convertIpPermissions(sg.IpPermissionsEgress, *sg.GroupId),
+		Tags:        convertTags(sg.Tags),
+	}
+}
+
+// convertIpPermissions はIpPermissionを送信元/送信先ごとに分割します。
+// Terraformはdescriptionをルール単位で保持するため、範囲ごとに1件のルールとして扱います。
+func convertIpPermissions(perms []types.IpPermission, groupID string) []IpPermission {
+	var result []IpPermission
+	for _, p := range perms {
+		base := IpPermission{
+			Protocol: aws.ToString(p.IpProtocol),
+			FromPort: aws.ToInt32(p.FromPort),
+			ToPort:   aws.ToInt32(p.ToPort),
+		}
+		for _, r := range p.IpRanges {
+			perm := base
+			perm.CidrBlocks = []string{aws.ToString(r.CidrIp)}
+			perm.Description = aws.ToString(r.Description)
+			result = append(result, perm)
+		}
+		for _, r := range p.Ipv6Ranges {
+			perm := base
+			perm.Ipv6CidrBlocks = []string{aws.ToString(r.CidrIpv6)}
+			perm.Description = aws.ToString(r.Description)
+			result = append(result, perm)
+		}
+		for _, pl := range p.PrefixListIds {
+			perm := base
+			perm.PrefixListIDs = []string{aws.ToString(pl.PrefixListId)}
+			perm.Description = aws.ToString(pl.Description)
+			result = append(result, perm)
+		}
+		for _, pair := range p.UserIdGroupPairs {
+			perm := base
+			if aws.ToString(pair.GroupId) == groupID {
+				perm.Self = true
+			} else {
+				perm.SecurityGroups = []string{aws.ToString(pair.GroupId)}
+			}
+			perm.Description = aws.ToString(pair.Description)
+			result = append(result, perm)
+		}
+	}
+	return result
+}
+
+func convertRouteTable(rt types.RouteTable) RouteTable {
+	routeTable := RouteTable{
+		ID:   *rt.RouteTableId,
+		Tags: convertTags(rt.Tags),
+	}
+	for _, assoc := range rt.Associations {
+		if aws.ToBool(assoc.Main) {
+			routeTable.Main = true
+			continue
+		}
+		if assoc.AssociationState != nil && assoc.AssociationState.State != types.RouteTableAssociationStateCodeAssociated {
+			continue
+		}
+		routeTable.Associations = append(routeTable.Associations, RouteTableAssociation{
+			ID:        aws.ToString(assoc.RouteTableAssociationId),
+			SubnetID:  aws.ToString(assoc.SubnetId),
+			GatewayID: aws.ToString(assoc.GatewayId),
+		})
+	}
+	for _, r := range rt.Routes {
+		// VPC内のlocalルートと伝播されたルートはTerraformで管理しません。
+		if r.Origin != types.RouteOriginCreateRoute || aws.ToString(r.GatewayId) == "local" {
+			continue
+		}
+		routeTable.Routes = append(routeTable.Routes, Route{
+			DestinationCidrBlock:     aws.ToString(r.DestinationCidrBlock),
+			DestinationIpv6CidrBlock: aws.ToString(r.DestinationIpv6CidrBlock),
+			DestinationPrefixListID:  aws.ToString(r.DestinationPrefixListId),
+			GatewayID:                aws.ToString(r.GatewayId),
+			NatGatewayID:             aws.ToString(r.NatGatewayId),
+			TransitGatewayID:         aws.ToString(r.TransitGatewayId),
+			VpcPeeringConnectionID:   aws.ToString(r.VpcPeeringConnectionId),
+			NetworkInterfaceID:       aws.ToString(r.NetworkInterfaceId),
+		})
+	}
+	return routeTable
+}
+
+func convertDhcpOptions(o types.DhcpOptions) DhcpOptions {
+	dhcpOptions := DhcpOptions{
+		ID:   *o.DhcpOptionsId,
+		Tags: convertTags(o.Tags),
+	}
+	for _, c := range o.DhcpConfigurations {
+		var values []string
+		for _, v := range c.Values {
+			values = append(values, aws.ToString(v.Value))
+		}
+		switch aws.ToString(c.Key) {
+		case "domain-name":
+			if len(values) > 0 {
+				dhcpOptions.DomainName = values[0]
+			}
+		case "domain-name-servers":
+			dhcpOptions.DomainNameServers = values
+		case "ntp-servers":
+			dhcpOptions.NtpServers = values
+		case "netbios-name-servers":
+			dhcpOptions.NetbiosNameServers = values
+		case "netbios-node-type":
+			if len(values) > 0 {
+				dhcpOptions.NetbiosNodeType = values[0]
+			}
+		}
+	}
+	return dhcpOptions
+}
+
+func convertFlowLog(fl types.FlowLog) FlowLog {
+	return FlowLog{
+		ID:                     *fl.FlowLogId,
+		ResourceID:             aws.ToString(fl.ResourceId),
+		TrafficType:            string(fl.TrafficType),
+		LogDestinationType:     string(fl.LogDestinationType),
+		LogDestination:         aws.ToString(fl.LogDestination),
+		DeliverLogsPermission:  aws.ToString(fl.DeliverLogsPermissionArn),
+		LogFormat:              aws.ToString(fl.LogFormat),
+		MaxAggregationInterval: aws.ToInt32(fl.MaxAggregationInterval),
+		Tags:                   convertTags(fl.Tags),
+	}
+}
+
+func convertNetworkAcl(acl types.NetworkAcl) NetworkAcl {
+	networkAcl := NetworkAcl{
+		ID:        *acl.NetworkAclId,
+		VpcID:     aws.ToString(acl.VpcId),
+		IsDefault: aws.ToBool(acl.IsDefault),
+		Tags:      convertTags(acl.Tags),
+	}
+	for _, assoc := range acl.Associations {
+		networkAcl.SubnetIDs = append(networkAcl.SubnetIDs, aws.ToString(assoc.SubnetId))
+	}
+	for _, e := range acl.Entries {
+		// 32767番は削除できない暗黙のdenyルールです。
+		if aws.ToInt32(e.RuleNumber) == 32767 {
+			continue
+		}
+		entry := NetworkAclEntry{
+			RuleNumber:    aws.ToInt32(e.RuleNumber),
+			Protocol:      aws.ToString(e.Protocol),
+			Action:        string(e.RuleAction),
+			CidrBlock:     aws.ToString(e.CidrBlock),
+			Ipv6CidrBlock: aws.ToString(e.Ipv6CidrBlock),
+		}
+		if e.PortRange != nil {
+			entry.FromPort = aws.ToInt32(e.PortRange.From)
+			entry.ToPort = aws.ToInt32(e.PortRange.To)
+		}
+		if e.IcmpTypeCode != nil {
+			entry.IcmpType = aws.ToInt32(e.IcmpTypeCode.Type)
+			entry.IcmpCode = aws.ToInt32(e.IcmpTypeCode.Code)
+		}
+		if aws.ToBool(e.Egress) {
+			networkAcl.Egress = append(networkAcl.Egress, entry)
+		} else {
+			networkAcl.Ingress = append(networkAcl.Ingress, entry)
+		}
+	}
+	return networkAcl
+}
+
 func convertTags(tags []types.Tag) map[string]string {
 	m := make(map[string]string)
 	for _, t := range tags {
 		m[*t.Key] = *t.Value
 	}
 	return m
-} 
+}
@@ -15,6 +15,8 @@ type ECSRepositoryInterface interface {
 	ListServices(ctx context.Context, clusterArn string) ([]string, error)
 	DescribeServices(ctx context.Context, clusterArn string, serviceArns []string) ([]types.Service, error)
 	DescribeCluster(ctx context.Context, clusterName string) (*types.Cluster, error)
+	DescribeCapacityProviders(ctx context.Context, names []string) ([]types.CapacityProvider, error)
+	DescribeTaskDefinition(ctx context.Context, taskDefinition string) (*types.TaskDefinition, []types.Tag, error)
 }
 
 // ECSRepository はECSRepositoryInterfaceを実装します。
@@ -28,55 +30,92 @@ func NewECSRepository(client *ecs.Client) *ECSRepository {
 }
 
 func (r *ECSRepository) ListClusters(ctx context.Context) ([]string, error) {
-	input := &ecs.ListClustersInput{}
-	result, err := r.client.ListClusters(ctx, input)
-	if err != nil {
-		return nil, err
+	var clusterArns []string
+	paginator := ecs.NewListClustersPaginator(r.client, &ecs.ListClustersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusterArns = append(clusterArns, output.ClusterArns...)
 	}
-	return result.ClusterArns, nil
+	return clusterArns, nil
 }
 
+// DescribeClusters はクラスターを設定・構成・タグ付きで取得します。APIの上限に合わせて100件ずつ問い合わせます。
 func (r *ECSRepository) DescribeClusters(ctx context.Context, clusterNames []string) ([]types.Cluster, error) {
-	if len(clusterNames) == 0 {
-		return nil, nil
+	var clusters []types.Cluster
+	for start := 0; start < len(clusterNames); start += 100 {
+		end := min(start+100, len(clusterNames))
+		input := &ecs.DescribeClustersInput{
+			Clusters: clusterNames[start:end],
+			Include: []types.ClusterField{
+				types.ClusterFieldTags,
+				types.ClusterFieldSettings,
+				types.ClusterFieldConfigurations,
+			},
+		}
+		result, err := r.client.DescribeClusters(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, result.Clusters...)
 	}
-	input := &ecs.DescribeClustersInput{
-		Clusters: clusterNames,
-		Include: []types.ClusterField{
-			types.ClusterFieldTags,
-		},
-	}
-	result, err := r.client.DescribeClusters(ctx, input)
-	if err != nil {
-		return nil, err
-	}
-	return result.Clusters, nil
+	return clusters, nil
 }
 
 func (r *ECSRepository) ListServices(ctx context.Context, clusterArn string) ([]string, error) {
-	input := &ecs.ListServicesInput{
+	var serviceArns []string
+	paginator := ecs.NewListServicesPaginator(r.client, &ecs.ListServicesInput{
 		Cluster: &clusterArn,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		serviceArns = append(serviceArns, output.ServiceArns...)
 	}
-	result, err := r.client.ListServices(ctx, input)
-	if err != nil {
-		return nil, err
-	}
-	return result.ServiceArns, nil
+	return serviceArns, nil
 }
 
+// DescribeServices はサービスをタグ付きで取得します。APIの上限に合わせて10件ずつ問い合わせます。
 func (r *ECSRepository) DescribeServices(ctx context.Context, clusterArn string, serviceArns []string) ([]types.Service, error) {
-	if len(serviceArns) == 0 {
+	var services []types.Service
+	for start := 0; start < len(serviceArns); start += 10 {
+		end := min(start+10, len(serviceArns))
+		input := &ecs.DescribeServicesInput{
+			Cluster:  &clusterArn,
+			Services: serviceArns[start:end],
+			Include: []types.ServiceField{
+				types.ServiceFieldTags,
+			},
+		}
+		result, err := r.client.DescribeServices(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, result.Services...)
+	}
+	return services, nil
+}
+
+// DescribeCapacityProviders はキャパシティプロバイダーをタグ付きで取得します。
+func (r *ECSRepository) DescribeCapacityProviders(ctx context.Context, names []string) ([]types.CapacityProvider, error) {
+	if len(names) == 0 {
 		return nil, nil
 	}
-	input := &ecs.DescribeServicesInput{
-		Cluster:  &clusterArn,
-		Services: serviceArns,
+	input := &ecs.DescribeCapacityProvidersInput{
+		CapacityProviders: names,
+		Include: []types.CapacityProviderField{
+			types.CapacityProviderFieldTags,
+		},
 	}
-	result, err := r.client.DescribeServices(ctx, input)
+	result, err := r.client.DescribeCapacityProviders(ctx, input)
 	if err != nil {
 		return nil, err
 	}
-	return result.Services, nil
+	return result.CapacityProviders, nil
 }
 
 func (r *ECSRepository) DescribeCluster(ctx context.Context, clusterName string) (*types.Cluster, error) {
@@ -94,4 +133,19 @@ func (r *ECSRepository) DescribeCluster(ctx context.Context, clusterName string)
 		return nil, nil
 	}
 	return &result.Clusters[0], nil
-} 
+}
+
+// DescribeTaskDefinition はタスク定義とそのタグを取得します。
+func (r *ECSRepository) DescribeTaskDefinition(ctx context.Context, taskDefinition string) (*types.TaskDefinition, []types.Tag, error) {
+	input := &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: &taskDefinition,
+		Include: []types.TaskDefinitionField{
+			types.TaskDefinitionFieldTags,
+		},
+	}
+	result, err := r.client.DescribeTaskDefinition(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.TaskDefinition, result.Tags, nil
+}
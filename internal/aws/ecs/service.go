@@ -3,8 +3,14 @@ package ecs
 
 import (
 	"context"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"golang.org/x/sync/errgroup"
 )
 
 // --- Domain Models ---
@@ -26,28 +32,176 @@ type NetworkConfiguration struct {
 	AssignPublicIp bool
 }
 
+type EfsVolumeConfiguration struct {
+	FileSystemID          string
+	RootDirectory         string
+	TransitEncryption     string
+	TransitEncryptionPort int32
+	AccessPointID         string
+	Iam                   string
+}
+
+type DockerVolumeConfiguration struct {
+	Scope         string
+	Autoprovision *bool
+	Driver        string
+	DriverOpts    map[string]string
+	Labels        map[string]string
+}
+
+type TaskDefinitionVolume struct {
+	Name                      string
+	HostPath                  string
+	EfsVolumeConfiguration    *EfsVolumeConfiguration
+	DockerVolumeConfiguration *DockerVolumeConfiguration
+}
+
+// TaskDefinition はタスク定義の1リビジョンを保持します。
+// ContainerDefinitionsはECSのJSON表現と同じキー名を持つmap/sliceのツリーです。
+type TaskDefinition struct {
+	Arn                     string
+	Family                  string
+	Revision                int32
+	ContainerDefinitions    []interface{}
+	Cpu                     string
+	Memory                  string
+	NetworkMode             string
+	RequiresCompatibilities []string
+	ExecutionRoleArn        string
+	TaskRoleArn             string
+	PidMode                 string
+	IpcMode                 string
+	OperatingSystemFamily   string
+	CpuArchitecture         string
+	EphemeralStorageGiB     int32
+	Volumes                 []TaskDefinitionVolume
+	Tags                    map[string]string
+}
+
 type ServiceDetail struct {
-	Arn                              string
-	Name                             string
-	DesiredCount                     int32
-	Tags                             map[string]string
-	LoadBalancers                    []LoadBalancer
-	TaskDefinitionArn                string
-	EnableEcsManagedTags             bool
-	EnableExecuteCommand             bool
-	HealthCheckGracePeriodSeconds    int32
-	DeploymentCircuitBreaker         *DeploymentCircuitBreaker
-	NetworkConfiguration             *NetworkConfiguration
-	PropagateTags                    string
-	PlatformVersion                  string
-	SchedulingStrategy               string
+	Arn                           string
+	Name                          string
+	DesiredCount                  int32
+	Tags                          map[string]string
+	LoadBalancers                 []LoadBalancer
+	TaskDefinitionArn             string
+	TaskDefinition                *TaskDefinition
+	EnableEcsManagedTags          bool
+	EnableExecuteCommand          bool
+	HealthCheckGracePeriodSeconds int32
+	DeploymentCircuitBreaker      *DeploymentCircuitBreaker
+	NetworkConfiguration          *NetworkConfiguration
+	PropagateTags                 string
+	PlatformVersion               string
+	SchedulingStrategy            string
+	CapacityProviderStrategy      []CapacityProviderStrategyItem
+	OrderedPlacementStrategy      []PlacementStrategy
+	PlacementConstraints          []PlacementConstraint
+	DeploymentController          string
+	ServiceRegistries             []ServiceRegistry
+	ServiceConnectConfiguration   *ServiceConnectConfiguration
+}
+
+type ServiceConnectClientAlias struct {
+	Port    int32
+	DnsName string
+}
+
+type ServiceConnectService struct {
+	PortName                 string
+	DiscoveryName            string
+	IngressPortOverride      int32
+	ClientAliases            []ServiceConnectClientAlias
+	IdleTimeoutSeconds       *int32
+	PerRequestTimeoutSeconds *int32
+	TlsAwsPcaAuthorityArn    string
+	TlsKmsKey                string
+	TlsRoleArn               string
+}
+
+// ServiceConnectConfiguration はサービスのプライマリデプロイメントのService Connect設定を保持します。
+type ServiceConnectConfiguration struct {
+	Enabled    bool
+	Namespace  string
+	LogDriver  string
+	LogOptions map[string]string
+	Services   []ServiceConnectService
+}
+
+type CapacityProviderStrategyItem struct {
+	CapacityProvider string
+	Weight           int32
+	Base             int32
+}
+
+type PlacementStrategy struct {
+	Type  string
+	Field string
+}
+
+type PlacementConstraint struct {
+	Type       string
+	Expression string
+}
+
+type ServiceRegistry struct {
+	RegistryArn   string
+	Port          int32
+	ContainerName string
+	ContainerPort int32
+}
+
+type ExecuteCommandLogConfiguration struct {
+	CloudWatchLogGroupName      string
+	CloudWatchEncryptionEnabled bool
+	S3BucketName                string
+	S3EncryptionEnabled         bool
+	S3KeyPrefix                 string
+}
+
+type ExecuteCommandConfiguration struct {
+	KmsKeyID         string
+	Logging          string
+	LogConfiguration *ExecuteCommandLogConfiguration
+}
+
+type ManagedScaling struct {
+	Status                 string
+	TargetCapacity         int32
+	MinimumScalingStepSize int32
+	MaximumScalingStepSize int32
+	InstanceWarmupPeriod   int32
+}
+
+// CapacityProvider はAuto Scalingグループを使うキャパシティプロバイダーを保持します。
+type CapacityProvider struct {
+	Arn                          string
+	Name                         string
+	AutoScalingGroupArn          string
+	AutoScalingGroupName         string
+	ManagedTerminationProtection string
+	ManagedDraining              string
+	ManagedScaling               *ManagedScaling
+	Tags                         map[string]string
 }
 
 type Cluster struct {
-	Arn      string
-	Name     string
-	Services []ServiceDetail
-	Tags     map[string]string
+	Arn                             string
+	Name                            string
+	Services                        []ServiceDetail
+	Tags                            map[string]string
+	ContainerInsights               string
+	ExecuteCommandConfiguration     *ExecuteCommandConfiguration
+	ServiceConnectNamespace         string
+	CapacityProviders               []string
+	DefaultCapacityProviderStrategy []CapacityProviderStrategyItem
+	// CapacityProviderDetails はFARGATE/FARGATE_SPOT以外のキャパシティプロバイダーの詳細です。
+	CapacityProviderDetails []CapacityProvider
+}
+
+// ServiceResourceID はApplication Auto ScalingでECSサービスを表すリソースIDを返します。
+func ServiceResourceID(clusterName, serviceName string) string {
+	return "service/" + clusterName + "/" + serviceName
 }
 
 // --- Service Interface and Implementation ---
@@ -65,22 +219,114 @@ func NewECSService(repo ECSRepositoryInterface) *ECSService {
 }
 
 // GetClustersは指定されたECSクラスターとそのサービスを取得します。
+// clusterNameが空の場合はアカウント内のすべてのクラスターを対象とします。
 func (s *ECSService) GetClusters(ctx context.Context, clusterName, serviceName string) ([]Cluster, error) {
-	awsClusters, err := s.repo.DescribeClusters(ctx, []string{clusterName})
+	var clusterNames []string
+	if clusterName != "" {
+		clusterNames = []string{clusterName}
+	} else {
+		var err error
+		clusterNames, err = s.repo.ListClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	awsClusters, err := s.repo.DescribeClusters(ctx, clusterNames)
 	if err != nil {
 		return nil, err
 	}
 	if len(awsClusters) == 0 {
 		return nil, nil // No cluster found
 	}
-	targetCluster := awsClusters[0]
-	clusterArn := *targetCluster.ClusterArn
 
-	// サービスを取得
+	clusters := make([]Cluster, len(awsClusters))
+	var eg errgroup.Group
+	for i, c := range awsClusters {
+		i, c := i, c
+		eg.Go(func() error {
+			cluster, err := s.buildCluster(ctx, c, serviceName)
+			if err != nil {
+				return err
+			}
+			clusters[i] = cluster
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+func (s *ECSService) buildCluster(ctx context.Context, awsCluster types.Cluster, serviceName string) (Cluster, error) {
+	cluster := Cluster{
+		Arn:                             *awsCluster.ClusterArn,
+		Name:                            *awsCluster.ClusterName,
+		Tags:                            make(map[string]string),
+		CapacityProviders:               awsCluster.CapacityProviders,
+		DefaultCapacityProviderStrategy: convertCapacityProviderStrategy(awsCluster.DefaultCapacityProviderStrategy),
+	}
+	for _, tag := range awsCluster.Tags {
+		cluster.Tags[*tag.Key] = *tag.Value
+	}
+	for _, setting := range awsCluster.Settings {
+		if setting.Name == types.ClusterSettingNameContainerInsights {
+			cluster.ContainerInsights = aws.ToString(setting.Value)
+		}
+	}
+	if awsCluster.Configuration != nil && awsCluster.Configuration.ExecuteCommandConfiguration != nil {
+		ecc := awsCluster.Configuration.ExecuteCommandConfiguration
+		cluster.ExecuteCommandConfiguration = &ExecuteCommandConfiguration{
+			KmsKeyID: aws.ToString(ecc.KmsKeyId),
+			Logging:  string(ecc.Logging),
+		}
+		if lc := ecc.LogConfiguration; lc != nil {
+			cluster.ExecuteCommandConfiguration.LogConfiguration = &ExecuteCommandLogConfiguration{
+				CloudWatchLogGroupName:      aws.ToString(lc.CloudWatchLogGroupName),
+				CloudWatchEncryptionEnabled: lc.CloudWatchEncryptionEnabled,
+				S3BucketName:                aws.ToString(lc.S3BucketName),
+				S3EncryptionEnabled:         lc.S3EncryptionEnabled,
+				S3KeyPrefix:                 aws.ToString(lc.S3KeyPrefix),
+			}
+		}
+	}
+	if awsCluster.ServiceConnectDefaults != nil {
+		cluster.ServiceConnectNamespace = aws.ToString(awsCluster.ServiceConnectDefaults.Namespace)
+	}
+
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		services, err := s.getServices(ctx, cluster.Arn, serviceName)
+		if err != nil {
+			return err
+		}
+		cluster.Services = services
+		return nil
+	})
+
+	eg.Go(func() error {
+		providers, err := s.getCapacityProviders(ctx, cluster.CapacityProviders)
+		if err != nil {
+			return err
+		}
+		cluster.CapacityProviderDetails = providers
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		return Cluster{}, err
+	}
+	return cluster, nil
+}
+
+func (s *ECSService) getServices(ctx context.Context, clusterArn, serviceName string) ([]ServiceDetail, error) {
 	var serviceArns []string
 	if serviceName != "" {
 		serviceArns = []string{serviceName}
 	} else {
+		var err error
 		serviceArns, err = s.repo.ListServices(ctx, clusterArn)
 		if err != nil {
 			return nil, err
@@ -104,9 +350,9 @@ func (s *ECSService) GetClusters(ctx context.Context, clusterName, serviceName s
 		var lbs []LoadBalancer
 		for _, lb := range awsService.LoadBalancers {
 			lbs = append(lbs, LoadBalancer{
-				TargetGroupArn: *lb.TargetGroupArn,
-				ContainerName:  *lb.ContainerName,
-				ContainerPort:  *lb.ContainerPort,
+				TargetGroupArn: aws.ToString(lb.TargetGroupArn),
+				ContainerName:  aws.ToString(lb.ContainerName),
+				ContainerPort:  aws.ToInt32(lb.ContainerPort),
 			})
 		}
 
@@ -131,10 +377,44 @@ func (s *ECSService) GetClusters(ctx context.Context, clusterName, serviceName s
 			}
 		}
 
-		// Simple boolean and integer values
-		healthCheckGracePeriodSeconds := int32(0)
-		if awsService.HealthCheckGracePeriodSeconds != nil {
-			healthCheckGracePeriodSeconds = *awsService.HealthCheckGracePeriodSeconds
+		// Placement
+		var placementStrategies []PlacementStrategy
+		for _, ps := range awsService.PlacementStrategy {
+			placementStrategies = append(placementStrategies, PlacementStrategy{
+				Type:  string(ps.Type),
+				Field: aws.ToString(ps.Field),
+			})
+		}
+		var placementConstraints []PlacementConstraint
+		for _, pc := range awsService.PlacementConstraints {
+			placementConstraints = append(placementConstraints, PlacementConstraint{
+				Type:       string(pc.Type),
+				Expression: aws.ToString(pc.Expression),
+			})
+		}
+
+		// Service discovery
+		var registries []ServiceRegistry
+		for _, sr := range awsService.ServiceRegistries {
+			registries = append(registries, ServiceRegistry{
+				RegistryArn:   aws.ToString(sr.RegistryArn),
+				Port:          aws.ToInt32(sr.Port),
+				ContainerName: aws.ToString(sr.ContainerName),
+				ContainerPort: aws.ToInt32(sr.ContainerPort),
+			})
+		}
+
+		// Service Connect (設定はプライマリデプロイメントにのみ含まれます)
+		var serviceConnect *ServiceConnectConfiguration
+		for _, d := range awsService.Deployments {
+			if aws.ToString(d.Status) == "PRIMARY" && d.ServiceConnectConfiguration != nil {
+				serviceConnect = convertServiceConnectConfiguration(d.ServiceConnectConfiguration)
+			}
+		}
+
+		var deploymentController string
+		if awsService.DeploymentController != nil {
+			deploymentController = string(awsService.DeploymentController.Type)
 		}
 
 		service := ServiceDetail{
@@ -146,31 +426,299 @@ func (s *ECSService) GetClusters(ctx context.Context, clusterName, serviceName s
 			TaskDefinitionArn:             *awsService.TaskDefinition,
 			EnableEcsManagedTags:          awsService.EnableECSManagedTags,
 			EnableExecuteCommand:          awsService.EnableExecuteCommand,
-			HealthCheckGracePeriodSeconds: healthCheckGracePeriodSeconds,
+			HealthCheckGracePeriodSeconds: aws.ToInt32(awsService.HealthCheckGracePeriodSeconds),
 			DeploymentCircuitBreaker:      circuitBreaker,
 			NetworkConfiguration:          networkConfig,
 			PropagateTags:                 string(awsService.PropagateTags),
-			PlatformVersion:               *awsService.PlatformVersion,
+			PlatformVersion:               aws.ToString(awsService.PlatformVersion),
 			SchedulingStrategy:            string(awsService.SchedulingStrategy),
+			CapacityProviderStrategy:      convertCapacityProviderStrategy(awsService.CapacityProviderStrategy),
+			OrderedPlacementStrategy:      placementStrategies,
+			PlacementConstraints:          placementConstraints,
+			DeploymentController:          deploymentController,
+			ServiceRegistries:             registries,
+			ServiceConnectConfiguration:   serviceConnect,
 		}
 
-		for i, lb := range lbs {
-			service.LoadBalancers[i] = lb
+		services = append(services, service)
+	}
+
+	if err := s.attachTaskDefinitions(ctx, services); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// getCapacityProviders はクラスターに関連付けられたキャパシティプロバイダーのうち、
+// Auto Scalingグループを使うもの(FARGATE/FARGATE_SPOT以外)の詳細を取得します。
+func (s *ECSService) getCapacityProviders(ctx context.Context, names []string) ([]CapacityProvider, error) {
+	var customNames []string
+	for _, name := range names {
+		if name != "FARGATE" && name != "FARGATE_SPOT" {
+			customNames = append(customNames, name)
 		}
+	}
+	awsProviders, err := s.repo.DescribeCapacityProviders(ctx, customNames)
+	if err != nil {
+		return nil, err
+	}
 
-		services = append(services, service)
+	var providers []CapacityProvider
+	for _, p := range awsProviders {
+		provider := CapacityProvider{
+			Arn:  aws.ToString(p.CapacityProviderArn),
+			Name: aws.ToString(p.Name),
+			Tags: make(map[string]string),
+		}
+		if asgp := p.AutoScalingGroupProvider; asgp != nil {
+			provider.AutoScalingGroupArn = aws.ToString(asgp.AutoScalingGroupArn)
+			// arn:aws:autoscaling:...:autoScalingGroup:<uuid>:autoScalingGroupName/<name>
+			if i := strings.Index(provider.AutoScalingGroupArn, "autoScalingGroupName/"); i >= 0 {
+				provider.AutoScalingGroupName = provider.AutoScalingGroupArn[i+len("autoScalingGroupName/"):]
+			}
+			provider.ManagedTerminationProtection = string(asgp.ManagedTerminationProtection)
+			provider.ManagedDraining = string(asgp.ManagedDraining)
+			if ms := asgp.ManagedScaling; ms != nil {
+				provider.ManagedScaling = &ManagedScaling{
+					Status:                 string(ms.Status),
+					TargetCapacity:         aws.ToInt32(ms.TargetCapacity),
+					MinimumScalingStepSize: aws.ToInt32(ms.MinimumScalingStepSize),
+					MaximumScalingStepSize: aws.ToInt32(ms.MaximumScalingStepSize),
+					InstanceWarmupPeriod:   aws.ToInt32(ms.InstanceWarmupPeriod),
+				}
+			}
+		}
+		for _, tag := range p.Tags {
+			provider.Tags[*tag.Key] = *tag.Value
+		}
+		providers = append(providers, provider)
 	}
+	return providers, nil
+}
 
-	cluster := Cluster{
-		Arn:      clusterArn,
-		Name:     *targetCluster.ClusterName,
-		Services: services,
-		Tags:     make(map[string]string),
+func convertServiceConnectConfiguration(sc *types.ServiceConnectConfiguration) *ServiceConnectConfiguration {
+	config := &ServiceConnectConfiguration{
+		Enabled:   sc.Enabled,
+		Namespace: aws.ToString(sc.Namespace),
 	}
+	if lc := sc.LogConfiguration; lc != nil {
+		config.LogDriver = string(lc.LogDriver)
+		config.LogOptions = lc.Options
+	}
+	for _, svc := range sc.Services {
+		service := ServiceConnectService{
+			PortName:            aws.ToString(svc.PortName),
+			DiscoveryName:       aws.ToString(svc.DiscoveryName),
+			IngressPortOverride: aws.ToInt32(svc.IngressPortOverride),
+		}
+		for _, alias := range svc.ClientAliases {
+			service.ClientAliases = append(service.ClientAliases, ServiceConnectClientAlias{
+				Port:    aws.ToInt32(alias.Port),
+				DnsName: aws.ToString(alias.DnsName),
+			})
+		}
+		if t := svc.Timeout; t != nil {
+			service.IdleTimeoutSeconds = t.IdleTimeoutSeconds
+			service.PerRequestTimeoutSeconds = t.PerRequestTimeoutSeconds
+		}
+		if tls := svc.Tls; tls != nil {
+			service.TlsKmsKey = aws.ToString(tls.KmsKey)
+			service.TlsRoleArn = aws.ToString(tls.RoleArn)
+			if tls.IssuerCertificateAuthority != nil {
+				service.TlsAwsPcaAuthorityArn = aws.ToString(tls.IssuerCertificateAuthority.AwsPcaAuthorityArn)
+			}
+		}
+		config.Services = append(config.Services, service)
+	}
+	return config
+}
 
-	for _, tag := range targetCluster.Tags {
-		cluster.Tags[*tag.Key] = *tag.Value
+func convertCapacityProviderStrategy(items []types.CapacityProviderStrategyItem) []CapacityProviderStrategyItem {
+	var strategy []CapacityProviderStrategyItem
+	for _, item := range items {
+		strategy = append(strategy, CapacityProviderStrategyItem{
+			CapacityProvider: aws.ToString(item.CapacityProvider),
+			Weight:           item.Weight,
+			Base:             item.Base,
+		})
+	}
+	return strategy
+}
+
+// attachTaskDefinitions は各サービスが使用するタスク定義を取得して設定します。
+// 同じタスク定義を使うサービスが複数ある場合も取得は1回だけ行います。
+func (s *ECSService) attachTaskDefinitions(ctx context.Context, services []ServiceDetail) error {
+	taskDefinitions := make(map[string]*TaskDefinition)
+	for _, service := range services {
+		taskDefinitions[service.TaskDefinitionArn] = nil
+	}
+
+	var eg errgroup.Group
+	results := make(chan *TaskDefinition, len(taskDefinitions))
+	for arn := range taskDefinitions {
+		arn := arn
+		eg.Go(func() error {
+			awsTaskDef, tags, err := s.repo.DescribeTaskDefinition(ctx, arn)
+			if err != nil {
+				return err
+			}
+			if awsTaskDef == nil {
+				return nil
+			}
+			results <- convertTaskDefinition(awsTaskDef, tags)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	close(results)
+
+	for td := range results {
+		taskDefinitions[td.Arn] = td
+	}
+	for i := range services {
+		services[i].TaskDefinition = taskDefinitions[services[i].TaskDefinitionArn]
+	}
+	return nil
+}
+
+func convertTaskDefinition(td *types.TaskDefinition, tags []types.Tag) *TaskDefinition {
+	taskDef := &TaskDefinition{
+		Arn:              aws.ToString(td.TaskDefinitionArn),
+		Family:           aws.ToString(td.Family),
+		Revision:         td.Revision,
+		Cpu:              aws.ToString(td.Cpu),
+		Memory:           aws.ToString(td.Memory),
+		NetworkMode:      string(td.NetworkMode),
+		ExecutionRoleArn: aws.ToString(td.ExecutionRoleArn),
+		TaskRoleArn:      aws.ToString(td.TaskRoleArn),
+		PidMode:          string(td.PidMode),
+		IpcMode:          string(td.IpcMode),
+		Tags:             make(map[string]string),
+	}
+	for _, c := range td.RequiresCompatibilities {
+		taskDef.RequiresCompatibilities = append(taskDef.RequiresCompatibilities, string(c))
+	}
+	if td.RuntimePlatform != nil {
+		taskDef.OperatingSystemFamily = string(td.RuntimePlatform.OperatingSystemFamily)
+		taskDef.CpuArchitecture = string(td.RuntimePlatform.CpuArchitecture)
+	}
+	if td.EphemeralStorage != nil {
+		taskDef.EphemeralStorageGiB = td.EphemeralStorage.SizeInGiB
+	}
+	for _, cd := range td.ContainerDefinitions {
+		if doc, ok := toDocument(reflect.ValueOf(cd), false); ok {
+			taskDef.ContainerDefinitions = append(taskDef.ContainerDefinitions, doc)
+		}
+	}
+	for _, v := range td.Volumes {
+		volume := TaskDefinitionVolume{Name: aws.ToString(v.Name)}
+		if v.Host != nil {
+			volume.HostPath = aws.ToString(v.Host.SourcePath)
+		}
+		if efs := v.EfsVolumeConfiguration; efs != nil {
+			volume.EfsVolumeConfiguration = &EfsVolumeConfiguration{
+				FileSystemID:          aws.ToString(efs.FileSystemId),
+				RootDirectory:         aws.ToString(efs.RootDirectory),
+				TransitEncryption:     string(efs.TransitEncryption),
+				TransitEncryptionPort: aws.ToInt32(efs.TransitEncryptionPort),
+			}
+			if efs.AuthorizationConfig != nil {
+				volume.EfsVolumeConfiguration.AccessPointID = aws.ToString(efs.AuthorizationConfig.AccessPointId)
+				volume.EfsVolumeConfiguration.Iam = string(efs.AuthorizationConfig.Iam)
+			}
+		}
+		if docker := v.DockerVolumeConfiguration; docker != nil {
+			volume.DockerVolumeConfiguration = &DockerVolumeConfiguration{
+				Scope:         string(docker.Scope),
+				Autoprovision: docker.Autoprovision,
+				Driver:        aws.ToString(docker.Driver),
+				DriverOpts:    docker.DriverOpts,
+				Labels:        docker.Labels,
+			}
+		}
+		taskDef.Volumes = append(taskDef.Volumes, volume)
+	}
+	for _, tag := range tags {
+		taskDef.Tags[*tag.Key] = *tag.Value
+	}
+	return taskDef
+}
+
+// toDocument はSDKの構造体を、ECSのJSON表現と同じキー名(先頭小文字)を持つmap/sliceのツリーに変換します。
+// 未設定の値は省略します。ポインタで明示的に設定された値はゼロ値でも残します。
+func toDocument(v reflect.Value, explicit bool) (interface{}, bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, false
+		}
+		return toDocument(v.Elem(), true)
+	case reflect.Struct:
+		doc := make(map[string]interface{})
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if value, ok := toDocument(v.Field(i), false); ok {
+				doc[lowerFirst(field.Name)] = value
+			}
+		}
+		if len(doc) == 0 && !explicit {
+			return nil, false
+		}
+		return doc, true
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return nil, false
+		}
+		var list []interface{}
+		for i := 0; i < v.Len(); i++ {
+			if value, ok := toDocument(v.Index(i), true); ok {
+				list = append(list, value)
+			}
+		}
+		return list, true
+	case reflect.Map:
+		if v.Len() == 0 {
+			return nil, false
+		}
+		doc := make(map[string]interface{})
+		iter := v.MapRange()
+		for iter.Next() {
+			if value, ok := toDocument(iter.Value(), true); ok {
+				doc[iter.Key().String()] = value
+			}
+		}
+		return doc, true
+	case reflect.String:
+		if v.String() == "" && !explicit {
+			return nil, false
+		}
+		return v.String(), true
+	case reflect.Bool:
+		if !v.Bool() && !explicit {
+			return nil, false
+		}
+		return v.Bool(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() == 0 && !explicit {
+			return nil, false
+		}
+		return v.Int(), true
+	case reflect.Float32, reflect.Float64:
+		if v.Float() == 0 && !explicit {
+			return nil, false
+		}
+		return v.Float(), true
 	}
+	return nil, false
+}
 
-	return []Cluster{cluster}, nil
-} 
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
@@ -0,0 +1,83 @@
+package elbv2
+
+import (
+	"context"
+
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+)
+
+// ClassicELBRepositoryInterface は Classic Load Balancer (elasticloadbalancing API) へのアクセスを抽象化します。
+type ClassicELBRepositoryInterface interface {
+	DescribeLoadBalancers(ctx context.Context, names []string) ([]elbtypes.LoadBalancerDescription, error)
+	DescribeLoadBalancerAttributes(ctx context.Context, name string) (*elbtypes.LoadBalancerAttributes, error)
+	DescribeLoadBalancerPolicies(ctx context.Context, name string) ([]elbtypes.PolicyDescription, error)
+	DescribeTags(ctx context.Context, names []string) (map[string][]elbtypes.Tag, error)
+}
+
+// ClassicELBRepository は ClassicELBRepositoryInterface を実装します。
+type ClassicELBRepository struct {
+	client *elb.Client
+}
+
+// NewClassicELBRepository は新しい ClassicELBRepository を生成します。
+func NewClassicELBRepository(client *elb.Client) *ClassicELBRepository {
+	return &ClassicELBRepository{client: client}
+}
+
+func (r *ClassicELBRepository) DescribeLoadBalancers(ctx context.Context, names []string) ([]elbtypes.LoadBalancerDescription, error) {
+	input := &elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: names,
+	}
+
+	var lbs []elbtypes.LoadBalancerDescription
+	paginator := elb.NewDescribeLoadBalancersPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lbs = append(lbs, output.LoadBalancerDescriptions...)
+	}
+	return lbs, nil
+}
+
+func (r *ClassicELBRepository) DescribeLoadBalancerAttributes(ctx context.Context, name string) (*elbtypes.LoadBalancerAttributes, error) {
+	input := &elb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: &name,
+	}
+	result, err := r.client.DescribeLoadBalancerAttributes(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.LoadBalancerAttributes, nil
+}
+
+func (r *ClassicELBRepository) DescribeLoadBalancerPolicies(ctx context.Context, name string) ([]elbtypes.PolicyDescription, error) {
+	input := &elb.DescribeLoadBalancerPoliciesInput{
+		LoadBalancerName: &name,
+	}
+	result, err := r.client.DescribeLoadBalancerPolicies(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.PolicyDescriptions, nil
+}
+
+// DescribeTags はロードバランサー名ごとのタグを取得します。APIの上限に合わせて20件ずつ問い合わせます。
+func (r *ClassicELBRepository) DescribeTags(ctx context.Context, names []string) (map[string][]elbtypes.Tag, error) {
+	tags := make(map[string][]elbtypes.Tag)
+	for i := 0; i < len(names); i += 20 {
+		input := &elb.DescribeTagsInput{
+			LoadBalancerNames: names[i:min(i+20, len(names))],
+		}
+		result, err := r.client.DescribeTags(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range result.TagDescriptions {
+			tags[*d.LoadBalancerName] = d.Tags
+		}
+	}
+	return tags, nil
+}
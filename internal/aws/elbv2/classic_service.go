@@ -0,0 +1,301 @@
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// --- Classic Load Balancer Domain Models ---
+
+type ClassicListener struct {
+	InstancePort     int32
+	InstanceProtocol string
+	LBPort           int32
+	LBProtocol       string
+	SSLCertificateID string
+	// PolicyNames はスティッキーセッションポリシーを除いたリスナーポリシーです。
+	PolicyNames []string
+}
+
+type ClassicHealthCheck struct {
+	Target             string
+	Interval           int32
+	Timeout            int32
+	HealthyThreshold   int32
+	UnhealthyThreshold int32
+}
+
+type ClassicAccessLogs struct {
+	Enabled  bool
+	Bucket   string
+	Prefix   string
+	Interval int32
+}
+
+type ClassicBackendServerPolicy struct {
+	InstancePort int32
+	PolicyNames  []string
+}
+
+// ClassicLBCookieStickinessPolicy と ClassicAppCookieStickinessPolicy は
+// そのポリシーが設定されたリスナーのポートを LBPort に持ちます。
+type ClassicLBCookieStickinessPolicy struct {
+	Name                   string
+	LBPort                 int32
+	CookieExpirationPeriod *int64
+}
+
+type ClassicAppCookieStickinessPolicy struct {
+	Name       string
+	LBPort     int32
+	CookieName string
+}
+
+type ClassicPolicyAttribute struct {
+	Name  string
+	Value string
+}
+
+// ClassicPolicy はスティッキーセッション以外のポリシー (aws_load_balancer_policy) です。
+type ClassicPolicy struct {
+	Name       string
+	TypeName   string
+	Attributes []ClassicPolicyAttribute
+}
+
+type ClassicLoadBalancer struct {
+	Name                        string
+	Internal                    bool
+	Subnets                     []string
+	AvailabilityZones           []string
+	SecurityGroups              []string
+	Listeners                   []ClassicListener
+	HealthCheck                 *ClassicHealthCheck
+	Instances                   []string
+	CrossZoneLoadBalancing      bool
+	IdleTimeout                 *int32
+	ConnectionDraining          bool
+	ConnectionDrainingTimeout   *int32
+	AccessLogs                  *ClassicAccessLogs
+	BackendServerPolicies       []ClassicBackendServerPolicy
+	LBCookieStickinessPolicies  []ClassicLBCookieStickinessPolicy
+	AppCookieStickinessPolicies []ClassicAppCookieStickinessPolicy
+	Policies                    []ClassicPolicy
+	Tags                        map[string]string
+}
+
+// ListClassicLoadBalancers は Classic Load Balancer を取得します。name を指定した場合はその名前のみを対象にします。
+func (s *ELBV2Service) ListClassicLoadBalancers(ctx context.Context, name string) ([]*ClassicLoadBalancer, error) {
+	var names []string
+	if name != "" {
+		names = append(names, name)
+	}
+
+	awsLbs, err := s.classicRepo.DescribeLoadBalancers(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	if len(awsLbs) == 0 {
+		return nil, nil
+	}
+
+	var lbNames []string
+	for _, lb := range awsLbs {
+		lbNames = append(lbNames, *lb.LoadBalancerName)
+	}
+	awsTags, err := s.classicRepo.DescribeTags(ctx, lbNames)
+	if err != nil {
+		return nil, err
+	}
+
+	lbs := make([]*ClassicLoadBalancer, len(awsLbs))
+	var eg errgroup.Group
+	for i, awsLb := range awsLbs {
+		i, awsLb := i, awsLb
+		eg.Go(func() error {
+			lb, err := s.buildClassicLoadBalancer(ctx, awsLb)
+			if err != nil {
+				return err
+			}
+			lb.Tags = make(map[string]string)
+			for _, t := range awsTags[lb.Name] {
+				lb.Tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+			}
+			lbs[i] = lb
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return lbs, nil
+}
+
+func (s *ELBV2Service) buildClassicLoadBalancer(ctx context.Context, awsLb elbtypes.LoadBalancerDescription) (*ClassicLoadBalancer, error) {
+	lb := &ClassicLoadBalancer{
+		Name:           *awsLb.LoadBalancerName,
+		Internal:       aws.ToString(awsLb.Scheme) == "internal",
+		Subnets:        awsLb.Subnets,
+		SecurityGroups: awsLb.SecurityGroups,
+	}
+	// VPC 外 (EC2-Classic) のロードバランサーのみ availability_zones で表現します。
+	if len(awsLb.Subnets) == 0 {
+		lb.AvailabilityZones = awsLb.AvailabilityZones
+	}
+	for _, instance := range awsLb.Instances {
+		lb.Instances = append(lb.Instances, aws.ToString(instance.InstanceId))
+	}
+	if hc := awsLb.HealthCheck; hc != nil {
+		lb.HealthCheck = &ClassicHealthCheck{
+			Target:             aws.ToString(hc.Target),
+			Interval:           aws.ToInt32(hc.Interval),
+			Timeout:            aws.ToInt32(hc.Timeout),
+			HealthyThreshold:   aws.ToInt32(hc.HealthyThreshold),
+			UnhealthyThreshold: aws.ToInt32(hc.UnhealthyThreshold),
+		}
+	}
+	for _, backend := range awsLb.BackendServerDescriptions {
+		lb.BackendServerPolicies = append(lb.BackendServerPolicies, ClassicBackendServerPolicy{
+			InstancePort: aws.ToInt32(backend.InstancePort),
+			PolicyNames:  backend.PolicyNames,
+		})
+	}
+
+	// スティッキーセッションポリシーはリスナーのポート単位で別リソースとして扱います。
+	stickinessPorts := make(map[string]int32)
+	for _, ld := range awsLb.ListenerDescriptions {
+		if ld.Listener == nil {
+			continue
+		}
+		for _, policyName := range ld.PolicyNames {
+			stickinessPorts[policyName] = ld.Listener.LoadBalancerPort
+		}
+	}
+	stickinessNames := make(map[string]bool)
+	if awsLb.Policies != nil {
+		for _, p := range awsLb.Policies.LBCookieStickinessPolicies {
+			stickinessNames[aws.ToString(p.PolicyName)] = true
+			port, ok := stickinessPorts[aws.ToString(p.PolicyName)]
+			if !ok {
+				continue
+			}
+			lb.LBCookieStickinessPolicies = append(lb.LBCookieStickinessPolicies, ClassicLBCookieStickinessPolicy{
+				Name:                   aws.ToString(p.PolicyName),
+				LBPort:                 port,
+				CookieExpirationPeriod: p.CookieExpirationPeriod,
+			})
+		}
+		for _, p := range awsLb.Policies.AppCookieStickinessPolicies {
+			stickinessNames[aws.ToString(p.PolicyName)] = true
+			port, ok := stickinessPorts[aws.ToString(p.PolicyName)]
+			if !ok {
+				continue
+			}
+			lb.AppCookieStickinessPolicies = append(lb.AppCookieStickinessPolicies, ClassicAppCookieStickinessPolicy{
+				Name:       aws.ToString(p.PolicyName),
+				LBPort:     port,
+				CookieName: aws.ToString(p.CookieName),
+			})
+		}
+	}
+
+	for _, ld := range awsLb.ListenerDescriptions {
+		if ld.Listener == nil {
+			continue
+		}
+		listener := ClassicListener{
+			InstancePort:     aws.ToInt32(ld.Listener.InstancePort),
+			InstanceProtocol: aws.ToString(ld.Listener.InstanceProtocol),
+			LBPort:           ld.Listener.LoadBalancerPort,
+			LBProtocol:       aws.ToString(ld.Listener.Protocol),
+			SSLCertificateID: aws.ToString(ld.Listener.SSLCertificateId),
+		}
+		for _, policyName := range ld.PolicyNames {
+			if !stickinessNames[policyName] {
+				listener.PolicyNames = append(listener.PolicyNames, policyName)
+			}
+		}
+		lb.Listeners = append(lb.Listeners, listener)
+	}
+
+	var eg errgroup.Group
+	eg.Go(func() error {
+		attrs, err := s.classicRepo.DescribeLoadBalancerAttributes(ctx, lb.Name)
+		if err != nil {
+			return err
+		}
+		applyClassicAttributes(lb, attrs)
+		return nil
+	})
+	eg.Go(func() error {
+		policies, err := s.classicRepo.DescribeLoadBalancerPolicies(ctx, lb.Name)
+		if err != nil {
+			return err
+		}
+		lb.Policies = convertClassicPolicies(policies, stickinessNames)
+		return nil
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return lb, nil
+}
+
+func applyClassicAttributes(lb *ClassicLoadBalancer, attrs *elbtypes.LoadBalancerAttributes) {
+	if attrs == nil {
+		return
+	}
+	if attrs.CrossZoneLoadBalancing != nil {
+		lb.CrossZoneLoadBalancing = attrs.CrossZoneLoadBalancing.Enabled
+	}
+	if attrs.ConnectionSettings != nil {
+		lb.IdleTimeout = attrs.ConnectionSettings.IdleTimeout
+	}
+	if attrs.ConnectionDraining != nil {
+		lb.ConnectionDraining = attrs.ConnectionDraining.Enabled
+		lb.ConnectionDrainingTimeout = attrs.ConnectionDraining.Timeout
+	}
+	if al := attrs.AccessLog; al != nil && aws.ToString(al.S3BucketName) != "" {
+		lb.AccessLogs = &ClassicAccessLogs{
+			Enabled:  al.Enabled,
+			Bucket:   aws.ToString(al.S3BucketName),
+			Prefix:   aws.ToString(al.S3BucketPrefix),
+			Interval: aws.ToInt32(al.EmitInterval),
+		}
+	}
+}
+
+// convertClassicPolicies はスティッキーセッション以外のポリシーを変換します。
+// 定義済みセキュリティポリシーを参照する SSL ネゴシエーションポリシーは、
+// 展開された暗号スイートではなく Reference-Security-Policy 属性のみを保持します。
+func convertClassicPolicies(policies []elbtypes.PolicyDescription, stickinessNames map[string]bool) []ClassicPolicy {
+	var result []ClassicPolicy
+	for _, p := range policies {
+		name := aws.ToString(p.PolicyName)
+		if stickinessNames[name] {
+			continue
+		}
+		policy := ClassicPolicy{
+			Name:     name,
+			TypeName: aws.ToString(p.PolicyTypeName),
+		}
+		for _, a := range p.PolicyAttributeDescriptions {
+			if aws.ToString(a.AttributeName) == "Reference-Security-Policy" {
+				policy.Attributes = []ClassicPolicyAttribute{{
+					Name:  aws.ToString(a.AttributeName),
+					Value: aws.ToString(a.AttributeValue),
+				}}
+				break
+			}
+			policy.Attributes = append(policy.Attributes, ClassicPolicyAttribute{
+				Name:  aws.ToString(a.AttributeName),
+				Value: aws.ToString(a.AttributeValue),
+			})
+		}
+		result = append(result, policy)
+	}
+	return result
+}
@@ -12,7 +12,13 @@ type ELBV2RepositoryInterface interface {
 	DescribeLoadBalancers(ctx context.Context, names []string) ([]types.LoadBalancer, error)
 	DescribeListeners(ctx context.Context, loadBalancerArn string) ([]types.Listener, error)
 	DescribeRules(ctx context.Context, listenerArn string) ([]types.Rule, error)
+	DescribeListenerCertificates(ctx context.Context, listenerArn string) ([]types.Certificate, error)
 	DescribeTargetGroups(ctx context.Context, loadBalancerArn string) ([]types.TargetGroup, error)
+	DescribeAllTargetGroups(ctx context.Context) ([]types.TargetGroup, error)
+	DescribeTargetHealth(ctx context.Context, targetGroupArn string) ([]types.TargetHealthDescription, error)
+	DescribeTags(ctx context.Context, resourceArns []string) (map[string][]types.Tag, error)
+	DescribeLoadBalancerAttributes(ctx context.Context, loadBalancerArn string) ([]types.LoadBalancerAttribute, error)
+	DescribeTargetGroupAttributes(ctx context.Context, targetGroupArn string) ([]types.TargetGroupAttribute, error)
 }
 
 // ELBV2Repository はELBV2RepositoryInterfaceを実装します。
@@ -64,6 +70,24 @@ func (r *ELBV2Repository) DescribeRules(ctx context.Context, listenerArn string)
 	return rules, nil
 }
 
+// DescribeListenerCertificates はリスナーに設定された証明書をデフォルト証明書も含めて取得します。
+func (r *ELBV2Repository) DescribeListenerCertificates(ctx context.Context, listenerArn string) ([]types.Certificate, error) {
+	input := &elbv2.DescribeListenerCertificatesInput{
+		ListenerArn: &listenerArn,
+	}
+
+	var certificates []types.Certificate
+	paginator := elbv2.NewDescribeListenerCertificatesPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, output.Certificates...)
+	}
+	return certificates, nil
+}
+
 func (r *ELBV2Repository) DescribeTargetGroups(ctx context.Context, loadBalancerArn string) ([]types.TargetGroup, error) {
 	input := &elbv2.DescribeTargetGroupsInput{
 		LoadBalancerArn: &loadBalancerArn,
@@ -74,3 +98,71 @@ func (r *ELBV2Repository) DescribeTargetGroups(ctx context.Context, loadBalancer
 	}
 	return result.TargetGroups, nil
 } 
+
+// DescribeLoadBalancerAttributes はロードバランサーの属性 (idle timeout, access_logs など) を取得します。
+func (r *ELBV2Repository) DescribeLoadBalancerAttributes(ctx context.Context, loadBalancerArn string) ([]types.LoadBalancerAttribute, error) {
+	input := &elbv2.DescribeLoadBalancerAttributesInput{
+		LoadBalancerArn: &loadBalancerArn,
+	}
+	result, err := r.client.DescribeLoadBalancerAttributes(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.Attributes, nil
+}
+
+// DescribeTargetGroupAttributes はターゲットグループの属性 (deregistration delay, stickiness など) を取得します。
+func (r *ELBV2Repository) DescribeTargetGroupAttributes(ctx context.Context, targetGroupArn string) ([]types.TargetGroupAttribute, error) {
+	input := &elbv2.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: &targetGroupArn,
+	}
+	result, err := r.client.DescribeTargetGroupAttributes(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.Attributes, nil
+}
+
+// DescribeAllTargetGroups はロードバランサーに関連付けられていないものも含め、すべてのターゲットグループを取得します。
+func (r *ELBV2Repository) DescribeAllTargetGroups(ctx context.Context) ([]types.TargetGroup, error) {
+	var targetGroups []types.TargetGroup
+	paginator := elbv2.NewDescribeTargetGroupsPaginator(r.client, &elbv2.DescribeTargetGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		targetGroups = append(targetGroups, output.TargetGroups...)
+	}
+	return targetGroups, nil
+}
+
+// DescribeTargetHealth はターゲットグループに登録されているターゲットを取得します。
+func (r *ELBV2Repository) DescribeTargetHealth(ctx context.Context, targetGroupArn string) ([]types.TargetHealthDescription, error) {
+	input := &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: &targetGroupArn,
+	}
+	result, err := r.client.DescribeTargetHealth(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return result.TargetHealthDescriptions, nil
+}
+
+// DescribeTags はリソースARNごとのタグを取得します。APIの上限に合わせて20件ずつ問い合わせます。
+func (r *ELBV2Repository) DescribeTags(ctx context.Context, resourceArns []string) (map[string][]types.Tag, error) {
+	tags := make(map[string][]types.Tag)
+	for i := 0; i < len(resourceArns); i += 20 {
+		input := &elbv2.DescribeTagsInput{
+			ResourceArns: resourceArns[i:min(i+20, len(resourceArns))],
+		}
+		result, err := r.client.DescribeTags(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range result.TagDescriptions {
+			tags[*d.ResourceArn] = d.Tags
+		}
+	}
+	return tags, nil
+}
@@ -2,7 +2,10 @@ package elbv2
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"golang.org/x/sync/errgroup"
 )
@@ -17,6 +20,31 @@ type TargetGroup struct {
 	VpcId    string
 	TargetType string
 	HealthCheck *HealthCheck
+	Attributes  TargetGroupAttributes
+	Tags        map[string]string
+	Targets     []TargetGroupTarget
+}
+
+// TargetGroupTarget は DescribeTargetHealth で取得した登録済みターゲットです。
+type TargetGroupTarget struct {
+	ID               string
+	Port             *int32
+	AvailabilityZone string
+}
+
+// TargetGroupAttributes は DescribeTargetGroupAttributes から取得する属性です。
+// 取得できなかった属性は nil のままになります。
+type TargetGroupAttributes struct {
+	DeregistrationDelay *int32
+	SlowStart           *int32
+	Stickiness          *TargetGroupStickinessAttribute
+}
+
+type TargetGroupStickinessAttribute struct {
+	Enabled        bool
+	Type           string
+	CookieDuration *int32
+	CookieName     string
 }
 
 type HealthCheck struct {
@@ -47,35 +75,71 @@ type DefaultActionForward struct {
 }
 
 type DefaultActionRedirect struct {
+	Host       string
+	Path       string
 	Port       string
 	Protocol   string
+	Query      string
 	StatusCode string
 }
 
-type DefaultAction struct {
-	Type     types.ActionTypeEnum
-	Forward  *DefaultActionForward
-	Redirect *DefaultActionRedirect
+type DefaultActionFixedResponse struct {
+	ContentType string
+	MessageBody string
+	StatusCode  string
 }
 
-type ListenerRuleActionForward struct {
-	TargetGroupArn string
+// DefaultActionAuthenticate は authenticate-oidc と authenticate-cognito の共通項目を持ちます。
+// ClientSecret は API から返されないため保持しません。
+type DefaultActionAuthenticate struct {
+	// OIDC
+	AuthorizationEndpoint string
+	ClientID              string
+	Issuer                string
+	TokenEndpoint         string
+	UserInfoEndpoint      string
+	// Cognito
+	UserPoolArn      string
+	UserPoolClientID string
+	UserPoolDomain   string
+
+	AuthenticationRequestExtraParams map[string]string
+	OnUnauthenticatedRequest         string
+	Scope                            string
+	SessionCookieName                string
+	SessionTimeout                   *int64
+}
+
+// DefaultAction はリスナーのデフォルトアクションとリスナールールのアクションの両方を表します。
+type DefaultAction struct {
+	Type                types.ActionTypeEnum
+	Order               *int32
+	TargetGroupArn      string
+	Forward             *DefaultActionForward
+	Redirect            *DefaultActionRedirect
+	FixedResponse       *DefaultActionFixedResponse
+	AuthenticateOidc    *DefaultActionAuthenticate
+	AuthenticateCognito *DefaultActionAuthenticate
 }
 
-type ListenerRuleAction struct {
-	Type    types.ActionTypeEnum
-	Forward *ListenerRuleActionForward
+type QueryStringCondition struct {
+	Key   string
+	Value string
 }
 
+// ListenerRuleCondition は Field (host-header, path-pattern, http-header,
+// http-request-method, query-string, source-ip) ごとの条件を表します。
 type ListenerRuleCondition struct {
-	Field  string
-	Values []string
+	Field          string
+	Values         []string
+	HttpHeaderName string
+	QueryStrings   []QueryStringCondition
 }
 
 type ListenerRule struct {
 	Arn        string
 	Priority   string
-	Actions    []ListenerRuleAction
+	Actions    []DefaultAction
 	Conditions []ListenerRuleCondition
 }
 
@@ -85,17 +149,46 @@ type Listener struct {
 	Protocol       types.ProtocolEnum
 	DefaultActions []DefaultAction
 	CertificateArn *string
-	Rules          []ListenerRule
+	// AdditionalCertificateArns はデフォルト以外の証明書 (aws_lb_listener_certificate) です。
+	AdditionalCertificateArns []string
+	Rules                     []ListenerRule
+}
+
+type SubnetMapping struct {
+	SubnetID           string
+	AllocationID       string
+	PrivateIPv4Address string
+	IPv6Address        string
+}
+
+type AccessLogs struct {
+	Enabled bool
+	Bucket  string
+	Prefix  string
+}
+
+// LoadBalancerAttributes は DescribeLoadBalancerAttributes から取得する属性です。
+// ロードバランサーの種類によって存在しない属性は nil のままになります。
+type LoadBalancerAttributes struct {
+	IdleTimeout             *int32
+	DeletionProtection      *bool
+	DropInvalidHeaderFields *bool
+	AccessLogs              *AccessLogs
 }
 
 type LoadBalancer struct {
-	Arn          string
-	Name         string
-	Type         types.LoadBalancerTypeEnum
-	Listeners    []Listener
-	TargetGroups []TargetGroup
-	Subnets      []string
-	VpcId        string
+	Arn            string
+	Name           string
+	Type           types.LoadBalancerTypeEnum
+	Internal       bool
+	IpAddressType  string
+	SecurityGroups []string
+	Listeners      []Listener
+	TargetGroups   []TargetGroup
+	Subnets        []string
+	SubnetMappings []SubnetMapping
+	VpcId          string
+	Attributes     LoadBalancerAttributes
 }
 
 // --- Service Interface and Implementation ---
@@ -103,14 +196,18 @@ type LoadBalancer struct {
 type Service interface {
 	GetLoadBalancer(ctx context.Context, name string) (*LoadBalancer, error)
 	ListLoadBalancers(ctx context.Context, name string) ([]*LoadBalancer, error)
+	ListTargetGroups(ctx context.Context, name string, includeTargets bool) ([]TargetGroup, error)
+	ListClassicLoadBalancers(ctx context.Context, name string) ([]*ClassicLoadBalancer, error)
 }
 
+// ELBV2Service は ALB/NLB (elasticloadbalancingv2) と Classic Load Balancer (elasticloadbalancing) の両方を扱います。
 type ELBV2Service struct {
-	repo ELBV2RepositoryInterface
+	repo        ELBV2RepositoryInterface
+	classicRepo ClassicELBRepositoryInterface
 }
 
-func NewELBV2Service(repo ELBV2RepositoryInterface) *ELBV2Service {
-	return &ELBV2Service{repo: repo}
+func NewELBV2Service(repo ELBV2RepositoryInterface, classicRepo ClassicELBRepositoryInterface) *ELBV2Service {
+	return &ELBV2Service{repo: repo, classicRepo: classicRepo}
 }
 
 func (s *ELBV2Service) ListLoadBalancers(ctx context.Context, name string) ([]*LoadBalancer, error) {
@@ -146,14 +243,28 @@ func (s *ELBV2Service) GetLoadBalancer(ctx context.Context, name string) (*LoadB
 
 func (s *ELBV2Service) buildLoadBalancer(ctx context.Context, awsLb types.LoadBalancer) (*LoadBalancer, error) {
 	lb := &LoadBalancer{
-		Name:         *awsLb.LoadBalancerName,
-		Arn:          *awsLb.LoadBalancerArn,
-		Subnets:      getSubnetIDs(awsLb.AvailabilityZones),
-		VpcId:        *awsLb.VpcId,
+		Name:           *awsLb.LoadBalancerName,
+		Arn:            *awsLb.LoadBalancerArn,
+		Type:           awsLb.Type,
+		Internal:       awsLb.Scheme == types.LoadBalancerSchemeEnumInternal,
+		IpAddressType:  string(awsLb.IpAddressType),
+		SecurityGroups: awsLb.SecurityGroups,
+		Subnets:        getSubnetIDs(awsLb.AvailabilityZones),
+		SubnetMappings: getSubnetMappings(awsLb.AvailabilityZones),
+		VpcId:          *awsLb.VpcId,
 	}
 
 	var eg errgroup.Group
 
+	eg.Go(func() error {
+		attrs, err := s.repo.DescribeLoadBalancerAttributes(ctx, lb.Arn)
+		if err != nil {
+			return err
+		}
+		lb.Attributes = convertLoadBalancerAttributes(attrs)
+		return nil
+	})
+
 	eg.Go(func() error {
 		listeners, err := s.getListenersWithRules(ctx, lb.Arn)
 		if err != nil {
@@ -197,13 +308,23 @@ func (s *ELBV2Service) getListenersWithRules(ctx context.Context, lbArn string)
 				return err
 			}
 
+			var additionalCertificateArns []string
+			if listener.Protocol == types.ProtocolEnumHttps || listener.Protocol == types.ProtocolEnumTls {
+				certificates, err := s.repo.DescribeListenerCertificates(ctx, *listener.ListenerArn)
+				if err != nil {
+					return err
+				}
+				additionalCertificateArns = getAdditionalCertificateArns(certificates)
+			}
+
 			listenerChan <- Listener{
-				Arn:            *listener.ListenerArn,
-				Port:           *listener.Port,
-				Protocol:       listener.Protocol,
-				CertificateArn: getCertificateArn(listener.Certificates),
-				DefaultActions: convertActions(listener.DefaultActions),
-				Rules:          convertRules(rules),
+				Arn:                       *listener.ListenerArn,
+				Port:                      *listener.Port,
+				Protocol:                  listener.Protocol,
+				CertificateArn:            getCertificateArn(listener.Certificates),
+				AdditionalCertificateArns: additionalCertificateArns,
+				DefaultActions:            convertActions(listener.DefaultActions),
+				Rules:                     convertRules(rules),
 			}
 			return nil
 		})
@@ -226,21 +347,119 @@ func (s *ELBV2Service) getTargetGroups(ctx context.Context, lbArn string) ([]Tar
 	if err != nil {
 		return nil, err
 	}
-	var tgs []TargetGroup
+	return s.buildTargetGroups(ctx, awsTgs)
+}
+
+// ListTargetGroups はロードバランサーへの関連付けの有無に関わらずターゲットグループを取得します。
+// name を指定した場合、ターゲットグループ名または Name タグがその値で始まるものに絞り込みます。
+// includeTargets が true の場合、instance / ip タイプの登録済みターゲットも取得します。
+func (s *ELBV2Service) ListTargetGroups(ctx context.Context, name string, includeTargets bool) ([]TargetGroup, error) {
+	awsTgs, err := s.repo.DescribeAllTargetGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var arns []string
+	for _, tg := range awsTgs {
+		arns = append(arns, *tg.TargetGroupArn)
+	}
+	awsTags, err := s.repo.DescribeTags(ctx, arns)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []types.TargetGroup
 	for _, tg := range awsTgs {
-		tgs = append(tgs, TargetGroup{
-			Name:        *tg.TargetGroupName,
-			Arn:         *tg.TargetGroupArn,
-			Port:        *tg.Port,
-			Protocol:    tg.Protocol,
-			TargetType:  string(tg.TargetType),
-			VpcId:       *tg.VpcId,
-			HealthCheck: convertHealthCheck(tg),
+		tags := convertTags(awsTags[*tg.TargetGroupArn])
+		if name != "" && !strings.HasPrefix(*tg.TargetGroupName, name) && !strings.HasPrefix(tags["Name"], name) {
+			continue
+		}
+		filtered = append(filtered, tg)
+	}
+
+	tgs, err := s.buildTargetGroups(ctx, filtered)
+	if err != nil {
+		return nil, err
+	}
+
+	var eg errgroup.Group
+	for i := range tgs {
+		i := i
+		tgs[i].Tags = convertTags(awsTags[tgs[i].Arn])
+		if !includeTargets || (tgs[i].TargetType != string(types.TargetTypeEnumInstance) && tgs[i].TargetType != string(types.TargetTypeEnumIp)) {
+			continue
+		}
+		eg.Go(func() error {
+			descriptions, err := s.repo.DescribeTargetHealth(ctx, tgs[i].Arn)
+			if err != nil {
+				return err
+			}
+			tgs[i].Targets = convertTargets(descriptions)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return tgs, nil
+}
+
+func (s *ELBV2Service) buildTargetGroups(ctx context.Context, awsTgs []types.TargetGroup) ([]TargetGroup, error) {
+	tgs := make([]TargetGroup, len(awsTgs))
+	var eg errgroup.Group
+	for i, tg := range awsTgs {
+		i, tg := i, tg
+		eg.Go(func() error {
+			attrs, err := s.repo.DescribeTargetGroupAttributes(ctx, *tg.TargetGroupArn)
+			if err != nil {
+				return err
+			}
+			tgs[i] = TargetGroup{
+				Name:        *tg.TargetGroupName,
+				Arn:         *tg.TargetGroupArn,
+				Port:        aws.ToInt32(tg.Port),
+				Protocol:    tg.Protocol,
+				TargetType:  string(tg.TargetType),
+				VpcId:       aws.ToString(tg.VpcId),
+				HealthCheck: convertHealthCheck(tg),
+				Attributes:  convertTargetGroupAttributes(attrs),
+			}
+			return nil
 		})
 	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
 	return tgs, nil
 }
 
+// convertTargets は登録解除中 (draining) のターゲットを除いてターゲットを変換します。
+func convertTargets(descriptions []types.TargetHealthDescription) []TargetGroupTarget {
+	var targets []TargetGroupTarget
+	for _, d := range descriptions {
+		if d.Target == nil {
+			continue
+		}
+		if d.TargetHealth != nil && d.TargetHealth.State == types.TargetHealthStateEnumDraining {
+			continue
+		}
+		targets = append(targets, TargetGroupTarget{
+			ID:               aws.ToString(d.Target.Id),
+			Port:             d.Target.Port,
+			AvailabilityZone: aws.ToString(d.Target.AvailabilityZone),
+		})
+	}
+	return targets
+}
+
+func convertTags(tags []types.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		result[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return result
+}
+
 func getSubnetIDs(zones []types.AvailabilityZone) []string {
 	var subnetIDs []string
 	for _, z := range zones {
@@ -249,6 +468,105 @@ func getSubnetIDs(zones []types.AvailabilityZone) []string {
 	return subnetIDs
 }
 
+// getSubnetMappings は EIP やプライベートIPが割り当てられたサブネット (NLB) のみ
+// subnet_mapping として扱うため、該当するアドレスがない場合は nil を返します。
+func getSubnetMappings(zones []types.AvailabilityZone) []SubnetMapping {
+	var mappings []SubnetMapping
+	hasAddress := false
+	for _, z := range zones {
+		mapping := SubnetMapping{SubnetID: aws.ToString(z.SubnetId)}
+		for _, addr := range z.LoadBalancerAddresses {
+			if addr.AllocationId != nil {
+				mapping.AllocationID = *addr.AllocationId
+				hasAddress = true
+			}
+			if addr.PrivateIPv4Address != nil {
+				mapping.PrivateIPv4Address = *addr.PrivateIPv4Address
+				hasAddress = true
+			}
+			if addr.IPv6Address != nil {
+				mapping.IPv6Address = *addr.IPv6Address
+				hasAddress = true
+			}
+		}
+		mappings = append(mappings, mapping)
+	}
+	if !hasAddress {
+		return nil
+	}
+	return mappings
+}
+
+func convertLoadBalancerAttributes(attrs []types.LoadBalancerAttribute) LoadBalancerAttributes {
+	values := make(map[string]string)
+	for _, a := range attrs {
+		values[aws.ToString(a.Key)] = aws.ToString(a.Value)
+	}
+
+	var result LoadBalancerAttributes
+	result.IdleTimeout = parseInt32Attribute(values, "idle_timeout.timeout_seconds")
+	result.DeletionProtection = parseBoolAttribute(values, "deletion_protection.enabled")
+	result.DropInvalidHeaderFields = parseBoolAttribute(values, "routing.http.drop_invalid_header_fields.enabled")
+	if enabled := parseBoolAttribute(values, "access_logs.s3.enabled"); enabled != nil {
+		result.AccessLogs = &AccessLogs{
+			Enabled: *enabled,
+			Bucket:  values["access_logs.s3.bucket"],
+			Prefix:  values["access_logs.s3.prefix"],
+		}
+	}
+	return result
+}
+
+func convertTargetGroupAttributes(attrs []types.TargetGroupAttribute) TargetGroupAttributes {
+	values := make(map[string]string)
+	for _, a := range attrs {
+		values[aws.ToString(a.Key)] = aws.ToString(a.Value)
+	}
+
+	var result TargetGroupAttributes
+	result.DeregistrationDelay = parseInt32Attribute(values, "deregistration_delay.timeout_seconds")
+	result.SlowStart = parseInt32Attribute(values, "slow_start.duration_seconds")
+	if enabled := parseBoolAttribute(values, "stickiness.enabled"); enabled != nil {
+		stickiness := &TargetGroupStickinessAttribute{
+			Enabled: *enabled,
+			Type:    values["stickiness.type"],
+		}
+		// lb_cookie と app_cookie で属性キーが異なるため、種類に応じて読み分けます。
+		if stickiness.Type == "app_cookie" {
+			stickiness.CookieName = values["stickiness.app_cookie.cookie_name"]
+			stickiness.CookieDuration = parseInt32Attribute(values, "stickiness.app_cookie.duration_seconds")
+		} else {
+			stickiness.CookieDuration = parseInt32Attribute(values, "stickiness.lb_cookie.duration_seconds")
+		}
+		result.Stickiness = stickiness
+	}
+	return result
+}
+
+func parseInt32Attribute(values map[string]string, key string) *int32 {
+	v, ok := values[key]
+	if !ok {
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return nil
+	}
+	return aws.Int32(int32(n))
+}
+
+func parseBoolAttribute(values map[string]string, key string) *bool {
+	v, ok := values[key]
+	if !ok {
+		return nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil
+	}
+	return aws.Bool(b)
+}
+
 func getCertificateArn(certificates []types.Certificate) *string {
 	if len(certificates) > 0 {
 		return certificates[0].CertificateArn
@@ -256,35 +574,94 @@ func getCertificateArn(certificates []types.Certificate) *string {
 	return nil
 }
 
+func getAdditionalCertificateArns(certificates []types.Certificate) []string {
+	var arns []string
+	for _, c := range certificates {
+		if aws.ToBool(c.IsDefault) {
+			continue
+		}
+		arns = append(arns, aws.ToString(c.CertificateArn))
+	}
+	return arns
+}
+
 func convertActions(actions []types.Action) []DefaultAction {
 	var defaultActions []DefaultAction
 	for _, da := range actions {
-		action := DefaultAction{Type: da.Type}
-		if da.Type == types.ActionTypeEnumForward && da.ForwardConfig != nil {
-			forward := DefaultActionForward{}
-			for _, tg := range da.ForwardConfig.TargetGroups {
-				var weight *int64
-				if tg.Weight != nil {
-					w := int64(*tg.Weight)
-					weight = &w
+		action := DefaultAction{
+			Type:           da.Type,
+			Order:          da.Order,
+			TargetGroupArn: aws.ToString(da.TargetGroupArn),
+		}
+		switch da.Type {
+		case types.ActionTypeEnumForward:
+			if da.ForwardConfig != nil {
+				forward := DefaultActionForward{}
+				for _, tg := range da.ForwardConfig.TargetGroups {
+					var weight *int64
+					if tg.Weight != nil {
+						w := int64(*tg.Weight)
+						weight = &w
+					}
+					forward.TargetGroups = append(forward.TargetGroups, DefaultActionForwardTargetGroup{
+						Arn:    *tg.TargetGroupArn,
+						Weight: weight,
+					})
+				}
+				if sc := da.ForwardConfig.TargetGroupStickinessConfig; sc != nil {
+					forward.Stickiness = &TargetGroupStickiness{
+						Enabled:  aws.ToBool(sc.Enabled),
+						Duration: aws.ToInt32(sc.DurationSeconds),
+					}
+				}
+				action.Forward = &forward
+			}
+		case types.ActionTypeEnumRedirect:
+			if rc := da.RedirectConfig; rc != nil {
+				action.Redirect = &DefaultActionRedirect{
+					Host:       aws.ToString(rc.Host),
+					Path:       aws.ToString(rc.Path),
+					Port:       aws.ToString(rc.Port),
+					Protocol:   aws.ToString(rc.Protocol),
+					Query:      aws.ToString(rc.Query),
+					StatusCode: string(rc.StatusCode),
 				}
-				forward.TargetGroups = append(forward.TargetGroups, DefaultActionForwardTargetGroup{
-					Arn:    *tg.TargetGroupArn,
-					Weight: weight,
-				})
 			}
-			if da.ForwardConfig.TargetGroupStickinessConfig != nil {
-				forward.Stickiness = &TargetGroupStickiness{
-					Enabled:  *da.ForwardConfig.TargetGroupStickinessConfig.Enabled,
-					Duration: *da.ForwardConfig.TargetGroupStickinessConfig.DurationSeconds,
+		case types.ActionTypeEnumFixedResponse:
+			if fc := da.FixedResponseConfig; fc != nil {
+				action.FixedResponse = &DefaultActionFixedResponse{
+					ContentType: aws.ToString(fc.ContentType),
+					MessageBody: aws.ToString(fc.MessageBody),
+					StatusCode:  aws.ToString(fc.StatusCode),
 				}
 			}
-			action.Forward = &forward
-		} else if da.Type == types.ActionTypeEnumRedirect && da.RedirectConfig != nil {
-			action.Redirect = &DefaultActionRedirect{
-				Port:       *da.RedirectConfig.Port,
-				Protocol:   *da.RedirectConfig.Protocol,
-				StatusCode: string(da.RedirectConfig.StatusCode),
+		case types.ActionTypeEnumAuthenticateOidc:
+			if oc := da.AuthenticateOidcConfig; oc != nil {
+				action.AuthenticateOidc = &DefaultActionAuthenticate{
+					AuthorizationEndpoint:            aws.ToString(oc.AuthorizationEndpoint),
+					ClientID:                         aws.ToString(oc.ClientId),
+					Issuer:                           aws.ToString(oc.Issuer),
+					TokenEndpoint:                    aws.ToString(oc.TokenEndpoint),
+					UserInfoEndpoint:                 aws.ToString(oc.UserInfoEndpoint),
+					AuthenticationRequestExtraParams: oc.AuthenticationRequestExtraParams,
+					OnUnauthenticatedRequest:         string(oc.OnUnauthenticatedRequest),
+					Scope:                            aws.ToString(oc.Scope),
+					SessionCookieName:                aws.ToString(oc.SessionCookieName),
+					SessionTimeout:                   oc.SessionTimeout,
+				}
+			}
+		case types.ActionTypeEnumAuthenticateCognito:
+			if cc := da.AuthenticateCognitoConfig; cc != nil {
+				action.AuthenticateCognito = &DefaultActionAuthenticate{
+					UserPoolArn:                      aws.ToString(cc.UserPoolArn),
+					UserPoolClientID:                 aws.ToString(cc.UserPoolClientId),
+					UserPoolDomain:                   aws.ToString(cc.UserPoolDomain),
+					AuthenticationRequestExtraParams: cc.AuthenticationRequestExtraParams,
+					OnUnauthenticatedRequest:         string(cc.OnUnauthenticatedRequest),
+					Scope:                            aws.ToString(cc.Scope),
+					SessionCookieName:                aws.ToString(cc.SessionCookieName),
+					SessionTimeout:                   cc.SessionTimeout,
+				}
 			}
 		}
 		defaultActions = append(defaultActions, action)
@@ -299,44 +676,63 @@ func convertRules(rules []types.Rule) []ListenerRule {
 			continue
 		}
 
-		var actions []ListenerRuleAction
-		for _, a := range r.Actions {
-			if a.Type == types.ActionTypeEnumForward && a.ForwardConfig != nil && len(a.ForwardConfig.TargetGroups) > 0 {
-				actions = append(actions, ListenerRuleAction{
-					Type: a.Type,
-					Forward: &ListenerRuleActionForward{
-						TargetGroupArn: *a.ForwardConfig.TargetGroups[0].TargetGroupArn,
-					},
-				})
-			}
-		}
-
-		var conditions []ListenerRuleCondition
-		for _, c := range r.Conditions {
-			if c.HostHeaderConfig != nil {
-				conditions = append(conditions, ListenerRuleCondition{
-					Field:  "host-header",
-					Values: c.HostHeaderConfig.Values,
-				})
-			}
-			if c.PathPatternConfig != nil {
-				conditions = append(conditions, ListenerRuleCondition{
-					Field:  "path-pattern",
-					Values: c.PathPatternConfig.Values,
-				})
-			}
-		}
-
 		listenerRules = append(listenerRules, ListenerRule{
 			Arn:        *r.RuleArn,
 			Priority:   *r.Priority,
-			Actions:    actions,
-			Conditions: conditions,
+			Actions:    convertActions(r.Actions),
+			Conditions: convertConditions(r.Conditions),
 		})
 	}
 	return listenerRules
 }
 
+func convertConditions(conditions []types.RuleCondition) []ListenerRuleCondition {
+	var result []ListenerRuleCondition
+	for _, c := range conditions {
+		switch {
+		case c.HostHeaderConfig != nil:
+			result = append(result, ListenerRuleCondition{
+				Field:  "host-header",
+				Values: c.HostHeaderConfig.Values,
+			})
+		case c.PathPatternConfig != nil:
+			result = append(result, ListenerRuleCondition{
+				Field:  "path-pattern",
+				Values: c.PathPatternConfig.Values,
+			})
+		case c.HttpHeaderConfig != nil:
+			result = append(result, ListenerRuleCondition{
+				Field:          "http-header",
+				HttpHeaderName: aws.ToString(c.HttpHeaderConfig.HttpHeaderName),
+				Values:         c.HttpHeaderConfig.Values,
+			})
+		case c.HttpRequestMethodConfig != nil:
+			result = append(result, ListenerRuleCondition{
+				Field:  "http-request-method",
+				Values: c.HttpRequestMethodConfig.Values,
+			})
+		case c.QueryStringConfig != nil:
+			var queryStrings []QueryStringCondition
+			for _, kv := range c.QueryStringConfig.Values {
+				queryStrings = append(queryStrings, QueryStringCondition{
+					Key:   aws.ToString(kv.Key),
+					Value: aws.ToString(kv.Value),
+				})
+			}
+			result = append(result, ListenerRuleCondition{
+				Field:        "query-string",
+				QueryStrings: queryStrings,
+			})
+		case c.SourceIpConfig != nil:
+			result = append(result, ListenerRuleCondition{
+				Field:  "source-ip",
+				Values: c.SourceIpConfig.Values,
+			})
+		}
+	}
+	return result
+}
+
 func convertHealthCheck(tg types.TargetGroup) *HealthCheck {
 	if tg.HealthCheckEnabled != nil && *tg.HealthCheckEnabled {
 		return &HealthCheck{
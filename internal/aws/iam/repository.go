@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"errors"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -14,6 +15,25 @@ type IAMClientInterface interface {
 	ListAttachedRolePolicies(ctx context.Context, params *iam.ListAttachedRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
 	GetPolicy(ctx context.Context, params *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
 	GetPolicyVersion(ctx context.Context, params *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
+	ListUsers(ctx context.Context, params *iam.ListUsersInput, optFns ...func(*iam.Options)) (*iam.ListUsersOutput, error)
+	GetUser(ctx context.Context, params *iam.GetUserInput, optFns ...func(*iam.Options)) (*iam.GetUserOutput, error)
+	ListGroups(ctx context.Context, params *iam.ListGroupsInput, optFns ...func(*iam.Options)) (*iam.ListGroupsOutput, error)
+	GetGroup(ctx context.Context, params *iam.GetGroupInput, optFns ...func(*iam.Options)) (*iam.GetGroupOutput, error)
+	ListGroupsForUser(ctx context.Context, params *iam.ListGroupsForUserInput, optFns ...func(*iam.Options)) (*iam.ListGroupsForUserOutput, error)
+	ListAttachedUserPolicies(ctx context.Context, params *iam.ListAttachedUserPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedUserPoliciesOutput, error)
+	ListAttachedGroupPolicies(ctx context.Context, params *iam.ListAttachedGroupPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedGroupPoliciesOutput, error)
+	GetLoginProfile(ctx context.Context, params *iam.GetLoginProfileInput, optFns ...func(*iam.Options)) (*iam.GetLoginProfileOutput, error)
+	ListAccessKeys(ctx context.Context, params *iam.ListAccessKeysInput, optFns ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
+	GetAccessKeyLastUsed(ctx context.Context, params *iam.GetAccessKeyLastUsedInput, optFns ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error)
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	ListRolePolicies(ctx context.Context, params *iam.ListRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	GetRolePolicy(ctx context.Context, params *iam.GetRolePolicyInput, optFns ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	ListInstanceProfilesForRole(ctx context.Context, params *iam.ListInstanceProfilesForRoleInput, optFns ...func(*iam.Options)) (*iam.ListInstanceProfilesForRoleOutput, error)
+	ListOpenIDConnectProviders(ctx context.Context, params *iam.ListOpenIDConnectProvidersInput, optFns ...func(*iam.Options)) (*iam.ListOpenIDConnectProvidersOutput, error)
+	GetOpenIDConnectProvider(ctx context.Context, params *iam.GetOpenIDConnectProviderInput, optFns ...func(*iam.Options)) (*iam.GetOpenIDConnectProviderOutput, error)
+	ListSAMLProviders(ctx context.Context, params *iam.ListSAMLProvidersInput, optFns ...func(*iam.Options)) (*iam.ListSAMLProvidersOutput, error)
+	GetSAMLProvider(ctx context.Context, params *iam.GetSAMLProviderInput, optFns ...func(*iam.Options)) (*iam.GetSAMLProviderOutput, error)
+	GetAccountAuthorizationDetails(ctx context.Context, params *iam.GetAccountAuthorizationDetailsInput, optFns ...func(*iam.Options)) (*iam.GetAccountAuthorizationDetailsOutput, error)
 }
 
 type IAMRepositoryInterface interface {
@@ -22,6 +42,31 @@ type IAMRepositoryInterface interface {
 	ListAttachedRolePolicies(ctx context.Context, roleName string) ([]types.AttachedPolicy, error)
 	GetPolicy(ctx context.Context, policyArn string) (*types.Policy, error)
 	GetPolicyVersion(ctx context.Context, policyArn string, versionId string) (*types.PolicyVersion, error)
+	ListUsers(ctx context.Context) ([]types.User, error)
+	GetUser(ctx context.Context, userName string) (*types.User, error)
+	ListGroups(ctx context.Context) ([]types.Group, error)
+	GetGroupUsers(ctx context.Context, groupName string) ([]types.User, error)
+	ListGroupsForUser(ctx context.Context, userName string) ([]types.Group, error)
+	ListAttachedUserPolicies(ctx context.Context, userName string) ([]types.AttachedPolicy, error)
+	ListAttachedGroupPolicies(ctx context.Context, groupName string) ([]types.AttachedPolicy, error)
+	GetLoginProfile(ctx context.Context, userName string) (*types.LoginProfile, error)
+	ListAccessKeys(ctx context.Context, userName string) ([]types.AccessKeyMetadata, error)
+	GetAccessKeyLastUsed(ctx context.Context, accessKeyId string) (*types.AccessKeyLastUsed, error)
+	GetRole(ctx context.Context, roleName string) (*types.Role, error)
+	ListRolePolicies(ctx context.Context, roleName string) ([]string, error)
+	GetRolePolicy(ctx context.Context, roleName string, policyName string) (string, error)
+	ListInstanceProfilesForRole(ctx context.Context, roleName string) ([]types.InstanceProfile, error)
+	ListOpenIDConnectProviderArns(ctx context.Context) ([]string, error)
+	GetOpenIDConnectProvider(ctx context.Context, arn string) (*iam.GetOpenIDConnectProviderOutput, error)
+	ListSAMLProviderArns(ctx context.Context) ([]string, error)
+	GetSAMLProvider(ctx context.Context, arn string) (*iam.GetSAMLProviderOutput, error)
+	GetAccountAuthorizationDetails(ctx context.Context, filter []types.EntityType) (*AuthorizationDetails, error)
+}
+
+// AuthorizationDetails は GetAccountAuthorizationDetails の全ページをまとめた結果です。
+type AuthorizationDetails struct {
+	Roles    []types.RoleDetail
+	Policies []types.ManagedPolicyDetail
 }
 
 type IAMRepository struct {
@@ -100,4 +145,386 @@ func (r *IAMRepository) GetPolicyVersion(ctx context.Context, policyArn string,
 		return nil, err
 	}
 	return output.PolicyVersion, nil
-} 
+}
+
+func (r *IAMRepository) ListUsers(ctx context.Context) ([]types.User, error) {
+	var users []types.User
+	paginator := iam.NewListUsersPaginator(r.client, &iam.ListUsersInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, output.Users...)
+	}
+
+	return users, nil
+}
+
+// GetUser は ListUsers では返されないタグと permissions boundary を含めてユーザーを取得します。
+func (r *IAMRepository) GetUser(ctx context.Context, userName string) (*types.User, error) {
+	output, err := r.client.GetUser(ctx, &iam.GetUserInput{
+		UserName: aws.String(userName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.User, nil
+}
+
+func (r *IAMRepository) ListGroups(ctx context.Context) ([]types.Group, error) {
+	var groups []types.Group
+	paginator := iam.NewListGroupsPaginator(r.client, &iam.ListGroupsInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, output.Groups...)
+	}
+
+	return groups, nil
+}
+
+// GetGroupUsers はグループに所属するユーザーを取得します。
+func (r *IAMRepository) GetGroupUsers(ctx context.Context, groupName string) ([]types.User, error) {
+	var users []types.User
+	paginator := iam.NewGetGroupPaginator(r.client, &iam.GetGroupInput{
+		GroupName: aws.String(groupName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, output.Users...)
+	}
+
+	return users, nil
+}
+
+func (r *IAMRepository) ListGroupsForUser(ctx context.Context, userName string) ([]types.Group, error) {
+	var groups []types.Group
+	paginator := iam.NewListGroupsForUserPaginator(r.client, &iam.ListGroupsForUserInput{
+		UserName: aws.String(userName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, output.Groups...)
+	}
+
+	return groups, nil
+}
+
+func (r *IAMRepository) ListAttachedUserPolicies(ctx context.Context, userName string) ([]types.AttachedPolicy, error) {
+	var attachedPolicies []types.AttachedPolicy
+	paginator := iam.NewListAttachedUserPoliciesPaginator(r.client, &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attachedPolicies = append(attachedPolicies, output.AttachedPolicies...)
+	}
+
+	return attachedPolicies, nil
+}
+
+func (r *IAMRepository) ListAttachedGroupPolicies(ctx context.Context, groupName string) ([]types.AttachedPolicy, error) {
+	var attachedPolicies []types.AttachedPolicy
+	paginator := iam.NewListAttachedGroupPoliciesPaginator(r.client, &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attachedPolicies = append(attachedPolicies, output.AttachedPolicies...)
+	}
+
+	return attachedPolicies, nil
+}
+
+// GetLoginProfile はユーザーのログインプロファイルを取得します。コンソールパスワードが無い場合は nil を返します。
+func (r *IAMRepository) GetLoginProfile(ctx context.Context, userName string) (*types.LoginProfile, error) {
+	output, err := r.client.GetLoginProfile(ctx, &iam.GetLoginProfileInput{
+		UserName: aws.String(userName),
+	})
+	if err != nil {
+		var notFound *types.NoSuchEntityException
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return output.LoginProfile, nil
+}
+
+func (r *IAMRepository) ListAccessKeys(ctx context.Context, userName string) ([]types.AccessKeyMetadata, error) {
+	var accessKeys []types.AccessKeyMetadata
+	paginator := iam.NewListAccessKeysPaginator(r.client, &iam.ListAccessKeysInput{
+		UserName: aws.String(userName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessKeys = append(accessKeys, output.AccessKeyMetadata...)
+	}
+
+	return accessKeys, nil
+}
+
+func (r *IAMRepository) GetAccessKeyLastUsed(ctx context.Context, accessKeyId string) (*types.AccessKeyLastUsed, error) {
+	output, err := r.client.GetAccessKeyLastUsed(ctx, &iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(accessKeyId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.AccessKeyLastUsed, nil
+}
+
+// GetRole は ListRoles では返されないタグと permissions boundary を含めてロールを取得します。
+func (r *IAMRepository) GetRole(ctx context.Context, roleName string) (*types.Role, error) {
+	output, err := r.client.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String(roleName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Role, nil
+}
+
+// ListRolePolicies はロールのインラインポリシー名を取得します。
+func (r *IAMRepository) ListRolePolicies(ctx context.Context, roleName string) ([]string, error) {
+	var policyNames []string
+	paginator := iam.NewListRolePoliciesPaginator(r.client, &iam.ListRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policyNames = append(policyNames, output.PolicyNames...)
+	}
+
+	return policyNames, nil
+}
+
+// GetRolePolicy はインラインポリシーのドキュメントを取得します。ドキュメントはURLエンコードされたままです。
+func (r *IAMRepository) GetRolePolicy(ctx context.Context, roleName string, policyName string) (string, error) {
+	output, err := r.client.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(policyName),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(output.PolicyDocument), nil
+}
+
+func (r *IAMRepository) ListInstanceProfilesForRole(ctx context.Context, roleName string) ([]types.InstanceProfile, error) {
+	var instanceProfiles []types.InstanceProfile
+	paginator := iam.NewListInstanceProfilesForRolePaginator(r.client, &iam.ListInstanceProfilesForRoleInput{
+		RoleName: aws.String(roleName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		instanceProfiles = append(instanceProfiles, output.InstanceProfiles...)
+	}
+
+	return instanceProfiles, nil
+}
+
+func (r *IAMRepository) ListOpenIDConnectProviderArns(ctx context.Context) ([]string, error) {
+	output, err := r.client.ListOpenIDConnectProviders(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return nil, err
+	}
+	var arns []string
+	for _, p := range output.OpenIDConnectProviderList {
+		arns = append(arns, *p.Arn)
+	}
+	return arns, nil
+}
+
+func (r *IAMRepository) GetOpenIDConnectProvider(ctx context.Context, arn string) (*iam.GetOpenIDConnectProviderOutput, error) {
+	return r.client.GetOpenIDConnectProvider(ctx, &iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(arn),
+	})
+}
+
+func (r *IAMRepository) ListSAMLProviderArns(ctx context.Context) ([]string, error) {
+	output, err := r.client.ListSAMLProviders(ctx, &iam.ListSAMLProvidersInput{})
+	if err != nil {
+		return nil, err
+	}
+	var arns []string
+	for _, p := range output.SAMLProviderList {
+		arns = append(arns, *p.Arn)
+	}
+	return arns, nil
+}
+
+func (r *IAMRepository) GetSAMLProvider(ctx context.Context, arn string) (*iam.GetSAMLProviderOutput, error) {
+	return r.client.GetSAMLProvider(ctx, &iam.GetSAMLProviderInput{
+		SAMLProviderArn: aws.String(arn),
+	})
+}
+
+// GetAccountAuthorizationDetails は filter で指定したエンティティの詳細
+// (アタッチされたポリシー、インラインポリシー、ポリシーバージョン等) をまとめて取得します。
+// ドキュメントはURLエンコードされたままです。
+func (r *IAMRepository) GetAccountAuthorizationDetails(ctx context.Context, filter []types.EntityType) (*AuthorizationDetails, error) {
+	details := &AuthorizationDetails{}
+	paginator := iam.NewGetAccountAuthorizationDetailsPaginator(r.client, &iam.GetAccountAuthorizationDetailsInput{
+		Filter: filter,
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		details.Roles = append(details.Roles, output.RoleDetailList...)
+		details.Policies = append(details.Policies, output.Policies...)
+	}
+
+	return details, nil
+}
+
+// limitedIAMRepository は IAMRepositoryInterface の呼び出しを、共有するセマフォで同時実行数を制限して委譲します。
+// 同じ IAMService から並列に呼び出される ListRoles や ListPolicies 等の間でも上限を共有します。
+type limitedIAMRepository struct {
+	repo IAMRepositoryInterface
+	sem  chan struct{}
+}
+
+// limitCall はセマフォを取得してから call を呼び出します。取得を待つ間に ctx が終了した場合はそのエラーを返します。
+func limitCall[T any](ctx context.Context, sem chan struct{}, call func() (T, error)) (T, error) {
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+	defer func() { <-sem }()
+	return call()
+}
+
+func (r *limitedIAMRepository) ListRoles(ctx context.Context) ([]types.Role, error) {
+	return limitCall(ctx, r.sem, func() ([]types.Role, error) { return r.repo.ListRoles(ctx) })
+}
+
+func (r *limitedIAMRepository) ListPolicies(ctx context.Context, scope types.PolicyScopeType) ([]types.Policy, error) {
+	return limitCall(ctx, r.sem, func() ([]types.Policy, error) { return r.repo.ListPolicies(ctx, scope) })
+}
+
+func (r *limitedIAMRepository) ListAttachedRolePolicies(ctx context.Context, roleName string) ([]types.AttachedPolicy, error) {
+	return limitCall(ctx, r.sem, func() ([]types.AttachedPolicy, error) { return r.repo.ListAttachedRolePolicies(ctx, roleName) })
+}
+
+func (r *limitedIAMRepository) GetPolicy(ctx context.Context, policyArn string) (*types.Policy, error) {
+	return limitCall(ctx, r.sem, func() (*types.Policy, error) { return r.repo.GetPolicy(ctx, policyArn) })
+}
+
+func (r *limitedIAMRepository) GetPolicyVersion(ctx context.Context, policyArn string, versionId string) (*types.PolicyVersion, error) {
+	return limitCall(ctx, r.sem, func() (*types.PolicyVersion, error) { return r.repo.GetPolicyVersion(ctx, policyArn, versionId) })
+}
+
+func (r *limitedIAMRepository) ListUsers(ctx context.Context) ([]types.User, error) {
+	return limitCall(ctx, r.sem, func() ([]types.User, error) { return r.repo.ListUsers(ctx) })
+}
+
+func (r *limitedIAMRepository) GetUser(ctx context.Context, userName string) (*types.User, error) {
+	return limitCall(ctx, r.sem, func() (*types.User, error) { return r.repo.GetUser(ctx, userName) })
+}
+
+func (r *limitedIAMRepository) ListGroups(ctx context.Context) ([]types.Group, error) {
+	return limitCall(ctx, r.sem, func() ([]types.Group, error) { return r.repo.ListGroups(ctx) })
+}
+
+func (r *limitedIAMRepository) GetGroupUsers(ctx context.Context, groupName string) ([]types.User, error) {
+	return limitCall(ctx, r.sem, func() ([]types.User, error) { return r.repo.GetGroupUsers(ctx, groupName) })
+}
+
+func (r *limitedIAMRepository) ListGroupsForUser(ctx context.Context, userName string) ([]types.Group, error) {
+	return limitCall(ctx, r.sem, func() ([]types.Group, error) { return r.repo.ListGroupsForUser(ctx, userName) })
+}
+
+func (r *limitedIAMRepository) ListAttachedUserPolicies(ctx context.Context, userName string) ([]types.AttachedPolicy, error) {
+	return limitCall(ctx, r.sem, func() ([]types.AttachedPolicy, error) { return r.repo.ListAttachedUserPolicies(ctx, userName) })
+}
+
+func (r *limitedIAMRepository) ListAttachedGroupPolicies(ctx context.Context, groupName string) ([]types.AttachedPolicy, error) {
+	return limitCall(ctx, r.sem, func() ([]types.AttachedPolicy, error) { return r.repo.ListAttachedGroupPolicies(ctx, groupName) })
+}
+
+func (r *limitedIAMRepository) GetLoginProfile(ctx context.Context, userName string) (*types.LoginProfile, error) {
+	return limitCall(ctx, r.sem, func() (*types.LoginProfile, error) { return r.repo.GetLoginProfile(ctx, userName) })
+}
+
+func (r *limitedIAMRepository) ListAccessKeys(ctx context.Context, userName string) ([]types.AccessKeyMetadata, error) {
+	return limitCall(ctx, r.sem, func() ([]types.AccessKeyMetadata, error) { return r.repo.ListAccessKeys(ctx, userName) })
+}
+
+func (r *limitedIAMRepository) GetAccessKeyLastUsed(ctx context.Context, accessKeyId string) (*types.AccessKeyLastUsed, error) {
+	return limitCall(ctx, r.sem, func() (*types.AccessKeyLastUsed, error) { return r.repo.GetAccessKeyLastUsed(ctx, accessKeyId) })
+}
+
+func (r *limitedIAMRepository) GetRole(ctx context.Context, roleName string) (*types.Role, error) {
+	return limitCall(ctx, r.sem, func() (*types.Role, error) { return r.repo.GetRole(ctx, roleName) })
+}
+
+func (r *limitedIAMRepository) ListRolePolicies(ctx context.Context, roleName string) ([]string, error) {
+	return limitCall(ctx, r.sem, func() ([]string, error) { return r.repo.ListRolePolicies(ctx, roleName) })
+}
+
+func (r *limitedIAMRepository) GetRolePolicy(ctx context.Context, roleName string, policyName string) (string, error) {
+	return limitCall(ctx, r.sem, func() (string, error) { return r.repo.GetRolePolicy(ctx, roleName, policyName) })
+}
+
+func (r *limitedIAMRepository) ListInstanceProfilesForRole(ctx context.Context, roleName string) ([]types.InstanceProfile, error) {
+	return limitCall(ctx, r.sem, func() ([]types.InstanceProfile, error) { return r.repo.ListInstanceProfilesForRole(ctx, roleName) })
+}
+
+func (r *limitedIAMRepository) ListOpenIDConnectProviderArns(ctx context.Context) ([]string, error) {
+	return limitCall(ctx, r.sem, func() ([]string, error) { return r.repo.ListOpenIDConnectProviderArns(ctx) })
+}
+
+func (r *limitedIAMRepository) GetOpenIDConnectProvider(ctx context.Context, arn string) (*iam.GetOpenIDConnectProviderOutput, error) {
+	return limitCall(ctx, r.sem, func() (*iam.GetOpenIDConnectProviderOutput, error) { return r.repo.GetOpenIDConnectProvider(ctx, arn) })
+}
+
+func (r *limitedIAMRepository) ListSAMLProviderArns(ctx context.Context) ([]string, error) {
+	return limitCall(ctx, r.sem, func() ([]string, error) { return r.repo.ListSAMLProviderArns(ctx) })
+}
+
+func (r *limitedIAMRepository) GetSAMLProvider(ctx context.Context, arn string) (*iam.GetSAMLProviderOutput, error) {
+	return limitCall(ctx, r.sem, func() (*iam.GetSAMLProviderOutput, error) { return r.repo.GetSAMLProvider(ctx, arn) })
+}
+
+func (r *limitedIAMRepository) GetAccountAuthorizationDetails(ctx context.Context, filter []types.EntityType) (*AuthorizationDetails, error) {
+	return limitCall(ctx, r.sem, func() (*AuthorizationDetails, error) { return r.repo.GetAccountAuthorizationDetails(ctx, filter) })
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"golang.org/x/sync/errgroup"
 )
@@ -16,23 +18,141 @@ type Policy struct {
 }
 
 type Role struct {
-	Name                 string
+	Name                string
+	Arn                 string
+	Path                string
+	Description         string
+	MaxSessionDuration  int32
+	PermissionsBoundary string
+	Tags                map[string]string
+	AssumeRolePolicy    string
+	AttachedPolicyArns  []string
+	InlinePolicies      []InlinePolicy
+	InstanceProfiles    []InstanceProfile
+	// ServiceLinked はパスが /aws-service-role/ のサービスにリンクされたロールであることを示します。
+	ServiceLinked bool
+	// AWSServiceName はサービスにリンクされたロールのサービス名 (例: ecs.amazonaws.com) です。
+	AWSServiceName string
+}
+
+type InlinePolicy struct {
+	Name           string
+	PolicyDocument string
+}
+
+type InstanceProfile struct {
+	Name string
+	Path string
+	Tags map[string]string
+}
+
+type OpenIDConnectProvider struct {
+	Arn            string
+	Url            string
+	ClientIDList   []string
+	ThumbprintList []string
+	Tags           map[string]string
+}
+
+type SAMLProvider struct {
 	Arn                  string
-	AssumeRolePolicy     string
-	AttachedPolicyArns   []string
+	Name                 string
+	SAMLMetadataDocument string
+	Tags                 map[string]string
+}
+
+type LoginProfile struct {
+	PasswordResetRequired bool
 }
 
+// AccessKey はレポート用のアクセスキー情報です。Terraform のリソースとしては出力しません。
+type AccessKey struct {
+	ID              string
+	Status          string
+	CreateDate      time.Time
+	LastUsedDate    *time.Time
+	LastUsedService string
+	LastUsedRegion  string
+}
+
+type User struct {
+	Name                string
+	Arn                 string
+	Path                string
+	PermissionsBoundary string
+	Tags                map[string]string
+	GroupNames          []string
+	AttachedPolicyArns  []string
+	LoginProfile        *LoginProfile
+	AccessKeys          []AccessKey
+}
+
+type Group struct {
+	Name               string
+	Arn                string
+	Path               string
+	MemberNames        []string
+	AttachedPolicyArns []string
+}
+
+const serviceLinkedRolePathPrefix = "/aws-service-role/"
+
 type Service interface {
 	ListRoles(ctx context.Context, nameContains string) ([]Role, error)
 	ListPolicies(ctx context.Context, nameContains string) ([]Policy, error)
+	ListUsers(ctx context.Context, nameContains string) ([]User, error)
+	ListGroups(ctx context.Context, nameContains string) ([]Group, error)
+	ListOpenIDConnectProviders(ctx context.Context, urlContains string) ([]OpenIDConnectProvider, error)
+	ListSAMLProviders(ctx context.Context, nameContains string) ([]SAMLProvider, error)
 }
 
+// DefaultConcurrency は IAM API を並列に呼び出すワーカー数の既定値です。
+const DefaultConcurrency = 8
+
+// Options は IAM リソースを取得する際の並列度と取得方法を指定します。
+type Options struct {
+	// Concurrency は IAM API を同時に呼び出すワーカー数の上限です。0 以下の場合は DefaultConcurrency を使います。
+	Concurrency int
+	// UseAuthorizationDetails が true の場合、ロールとポリシーの詳細を
+	// GetAccountAuthorizationDetails でまとめて取得し、リソースごとの API 呼び出しを省略します。
+	UseAuthorizationDetails bool
+}
+
+// IAMService は Service を実装します。
+// IAM API の呼び出しはすべて、サービスが保持する1つのセマフォで Concurrency 以下に制限します。
 type IAMService struct {
+	repo    IAMRepositoryInterface
 	iamRepo IAMRepositoryInterface
+	options Options
 }
 
 func NewIAMService(repo IAMRepositoryInterface) *IAMService {
-	return &IAMService{iamRepo: repo}
+	return newIAMService(repo, Options{})
+}
+
+// WithOptions は options を適用した IAMService を返します。
+// 返す IAMService は新しいセマフォを持つため、同じ実行の中では1つの IAMService を使い回してください。
+func (s *IAMService) WithOptions(options Options) *IAMService {
+	return newIAMService(s.repo, options)
+}
+
+func newIAMService(repo IAMRepositoryInterface, options Options) *IAMService {
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultConcurrency
+	}
+	return &IAMService{
+		repo:    repo,
+		iamRepo: &limitedIAMRepository{repo: repo, sem: make(chan struct{}, options.Concurrency)},
+		options: options,
+	}
+}
+
+// workerGroup は同時に起動するゴルーチンの数を Concurrency に制限した errgroup を返します。
+// API の呼び出し数の上限はセマフォで別に制限されるため、ここではゴルーチンの数だけを抑えます。
+func (s *IAMService) workerGroup() *errgroup.Group {
+	eg := &errgroup.Group{}
+	eg.SetLimit(s.options.Concurrency)
+	return eg
 }
 
 func (s *IAMService) ListRoles(ctx context.Context, nameContains string) ([]Role, error) {
@@ -52,35 +172,181 @@ func (s *IAMService) ListRoles(ctx context.Context, nameContains string) ([]Role
 		filteredRoles = awsRoles
 	}
 
+	if s.options.UseAuthorizationDetails {
+		return s.listRolesFromAuthorizationDetails(ctx, filteredRoles)
+	}
+
+	roles := make([]Role, len(filteredRoles))
+	eg := s.workerGroup()
+	for i, r := range filteredRoles {
+		i, r := i, r
+		eg.Go(func() error {
+			role, err := newRole(r)
+			if err != nil {
+				return err
+			}
+			// サービスにリンクされたロールのポリシーやインスタンスプロファイルは AWS が管理するため取得しません。
+			if role.ServiceLinked {
+				roles[i] = *role
+				return nil
+			}
+
+			attachedPolicies, err := s.iamRepo.ListAttachedRolePolicies(ctx, role.Name)
+			if err != nil {
+				return err
+			}
+			for _, p := range attachedPolicies {
+				role.AttachedPolicyArns = append(role.AttachedPolicyArns, *p.PolicyArn)
+			}
+
+			if err := s.addRoleDetails(ctx, role); err != nil {
+				return err
+			}
+			roles[i] = *role
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// newRole は ListRoles の結果から Role を生成します。
+func newRole(r types.Role) (*Role, error) {
+	assumeRolePolicy, err := url.QueryUnescape(*r.AssumeRolePolicyDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &Role{
+		Name:               *r.RoleName,
+		Arn:                *r.Arn,
+		Path:               aws.ToString(r.Path),
+		Description:        aws.ToString(r.Description),
+		MaxSessionDuration: aws.ToInt32(r.MaxSessionDuration),
+		AssumeRolePolicy:   assumeRolePolicy,
+	}
+	if strings.HasPrefix(role.Path, serviceLinkedRolePathPrefix) {
+		role.ServiceLinked = true
+		role.AWSServiceName = strings.Trim(strings.TrimPrefix(role.Path, serviceLinkedRolePathPrefix), "/")
+	}
+	return role, nil
+}
+
+// listRolesFromAuthorizationDetails は GetAccountAuthorizationDetails の結果からロールの詳細を補完します。
+// RoleDetail には description と max_session_duration が含まれないため、それらは ListRoles の結果を使います。
+func (s *IAMService) listRolesFromAuthorizationDetails(ctx context.Context, awsRoles []types.Role) ([]Role, error) {
+	details, err := s.iamRepo.GetAccountAuthorizationDetails(ctx, []types.EntityType{types.EntityTypeRole})
+	if err != nil {
+		return nil, err
+	}
+	roleDetails := make(map[string]types.RoleDetail)
+	for _, d := range details.Roles {
+		roleDetails[aws.ToString(d.RoleName)] = d
+	}
+
 	var roles []Role
-	for _, r := range filteredRoles {
-		assumeRolePolicy, err := url.QueryUnescape(*r.AssumeRolePolicyDocument)
+	for _, r := range awsRoles {
+		role, err := newRole(r)
 		if err != nil {
 			return nil, err
 		}
+		detail, ok := roleDetails[role.Name]
+		if !ok || role.ServiceLinked {
+			roles = append(roles, *role)
+			continue
+		}
 
-		attachedPolicies, err := s.iamRepo.ListAttachedRolePolicies(ctx, *r.RoleName)
-		if err != nil {
-			return nil, err
+		for _, p := range detail.AttachedManagedPolicies {
+			role.AttachedPolicyArns = append(role.AttachedPolicyArns, *p.PolicyArn)
+		}
+		if detail.PermissionsBoundary != nil {
+			role.PermissionsBoundary = aws.ToString(detail.PermissionsBoundary.PermissionsBoundaryArn)
+		}
+		role.Tags = convertTags(detail.Tags)
+		for _, p := range detail.RolePolicyList {
+			policyDocument, err := url.QueryUnescape(aws.ToString(p.PolicyDocument))
+			if err != nil {
+				return nil, err
+			}
+			role.InlinePolicies = append(role.InlinePolicies, InlinePolicy{
+				Name:           *p.PolicyName,
+				PolicyDocument: policyDocument,
+			})
 		}
+		for _, ip := range detail.InstanceProfileList {
+			role.InstanceProfiles = append(role.InstanceProfiles, InstanceProfile{
+				Name: *ip.InstanceProfileName,
+				Path: aws.ToString(ip.Path),
+				Tags: convertTags(ip.Tags),
+			})
+		}
+		roles = append(roles, *role)
+	}
 
-		var attachedPolicyArns []string
-		for _, p := range attachedPolicies {
-			attachedPolicyArns = append(attachedPolicyArns, *p.PolicyArn)
+	return roles, nil
+}
+
+// addRoleDetails は ListRoles では取得できない permissions boundary、タグ、
+// インラインポリシー、インスタンスプロファイルをロールに追加します。
+func (s *IAMService) addRoleDetails(ctx context.Context, role *Role) error {
+	awsRole, err := s.iamRepo.GetRole(ctx, role.Name)
+	if err != nil {
+		return err
+	}
+	if awsRole.PermissionsBoundary != nil {
+		role.PermissionsBoundary = aws.ToString(awsRole.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+	role.Tags = convertTags(awsRole.Tags)
+
+	policyNames, err := s.iamRepo.ListRolePolicies(ctx, role.Name)
+	if err != nil {
+		return err
+	}
+	for _, policyName := range policyNames {
+		document, err := s.iamRepo.GetRolePolicy(ctx, role.Name, policyName)
+		if err != nil {
+			return err
+		}
+		policyDocument, err := url.QueryUnescape(document)
+		if err != nil {
+			return err
 		}
+		role.InlinePolicies = append(role.InlinePolicies, InlinePolicy{
+			Name:           policyName,
+			PolicyDocument: policyDocument,
+		})
+	}
 
-		roles = append(roles, Role{
-			Name:                 *r.RoleName,
-			Arn:                  *r.Arn,
-			AssumeRolePolicy:     assumeRolePolicy,
-			AttachedPolicyArns:   attachedPolicyArns,
+	instanceProfiles, err := s.iamRepo.ListInstanceProfilesForRole(ctx, role.Name)
+	if err != nil {
+		return err
+	}
+	for _, ip := range instanceProfiles {
+		role.InstanceProfiles = append(role.InstanceProfiles, InstanceProfile{
+			Name: *ip.InstanceProfileName,
+			Path: aws.ToString(ip.Path),
+			Tags: convertTags(ip.Tags),
 		})
 	}
+	return nil
+}
 
-	return roles, nil
+func convertTags(tags []types.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		result[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return result
 }
 
 func (s *IAMService) ListPolicies(ctx context.Context, nameContains string) ([]Policy, error) {
+	if s.options.UseAuthorizationDetails {
+		return s.listPoliciesFromAuthorizationDetails(ctx, nameContains)
+	}
+
 	awsPolicies, err := s.iamRepo.ListPolicies(ctx, types.PolicyScopeTypeLocal)
 	if err != nil {
 		return nil, err
@@ -97,12 +363,10 @@ func (s *IAMService) ListPolicies(ctx context.Context, nameContains string) ([]P
 		filteredPolicies = awsPolicies
 	}
 
-	var policies []Policy
-	var eg errgroup.Group
-	policyChan := make(chan Policy, len(filteredPolicies))
-
-	for _, p := range filteredPolicies {
-		policy := p
+	policies := make([]Policy, len(filteredPolicies))
+	eg := s.workerGroup()
+	for i, p := range filteredPolicies {
+		i, policy := i, p
 		eg.Go(func() error {
 			policyVersion, err := s.iamRepo.GetPolicyVersion(ctx, *policy.Arn, *policy.DefaultVersionId)
 			if err != nil {
@@ -112,7 +376,7 @@ func (s *IAMService) ListPolicies(ctx context.Context, nameContains string) ([]P
 			if err != nil {
 				return err
 			}
-			policyChan <- Policy{
+			policies[i] = Policy{
 				Name:           *policy.PolicyName,
 				Arn:            *policy.Arn,
 				PolicyDocument: policyDocument,
@@ -120,15 +384,248 @@ func (s *IAMService) ListPolicies(ctx context.Context, nameContains string) ([]P
 			return nil
 		})
 	}
-
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
-	close(policyChan)
 
-	for p := range policyChan {
-		policies = append(policies, p)
+	return policies, nil
+}
+
+// listPoliciesFromAuthorizationDetails はカスタマー管理ポリシーとそのデフォルトバージョンを
+// GetAccountAuthorizationDetails でまとめて取得します。
+func (s *IAMService) listPoliciesFromAuthorizationDetails(ctx context.Context, nameContains string) ([]Policy, error) {
+	details, err := s.iamRepo.GetAccountAuthorizationDetails(ctx, []types.EntityType{types.EntityTypeLocalManagedPolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []Policy
+	for _, p := range details.Policies {
+		if nameContains != "" && !strings.Contains(*p.PolicyName, nameContains) {
+			continue
+		}
+		for _, v := range p.PolicyVersionList {
+			if !v.IsDefaultVersion {
+				continue
+			}
+			policyDocument, err := url.QueryUnescape(aws.ToString(v.Document))
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, Policy{
+				Name:           *p.PolicyName,
+				Arn:            *p.Arn,
+				PolicyDocument: policyDocument,
+			})
+		}
 	}
 
 	return policies, nil
-} 
+}
+
+// ListUsers は名前に nameContains を含む IAM ユーザーを、所属グループ、アタッチされたポリシー、
+// ログインプロファイル、アクセスキーとあわせて取得します。
+func (s *IAMService) ListUsers(ctx context.Context, nameContains string) ([]User, error) {
+	awsUsers, err := s.iamRepo.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredUsers []types.User
+	for _, u := range awsUsers {
+		if nameContains == "" || strings.Contains(*u.UserName, nameContains) {
+			filteredUsers = append(filteredUsers, u)
+		}
+	}
+
+	users := make([]User, len(filteredUsers))
+	eg := s.workerGroup()
+	for i, u := range filteredUsers {
+		i, userName := i, *u.UserName
+		eg.Go(func() error {
+			user, err := s.buildUser(ctx, userName)
+			if err != nil {
+				return err
+			}
+			users[i] = *user
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (s *IAMService) buildUser(ctx context.Context, userName string) (*User, error) {
+	awsUser, err := s.iamRepo.GetUser(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &User{
+		Name: *awsUser.UserName,
+		Arn:  *awsUser.Arn,
+		Path: aws.ToString(awsUser.Path),
+		Tags: convertTags(awsUser.Tags),
+	}
+	if awsUser.PermissionsBoundary != nil {
+		user.PermissionsBoundary = aws.ToString(awsUser.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+
+	groups, err := s.iamRepo.ListGroupsForUser(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		user.GroupNames = append(user.GroupNames, *g.GroupName)
+	}
+
+	attachedPolicies, err := s.iamRepo.ListAttachedUserPolicies(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range attachedPolicies {
+		user.AttachedPolicyArns = append(user.AttachedPolicyArns, *p.PolicyArn)
+	}
+
+	loginProfile, err := s.iamRepo.GetLoginProfile(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	if loginProfile != nil {
+		user.LoginProfile = &LoginProfile{PasswordResetRequired: loginProfile.PasswordResetRequired}
+	}
+
+	accessKeys, err := s.iamRepo.ListAccessKeys(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range accessKeys {
+		accessKey := AccessKey{
+			ID:         *k.AccessKeyId,
+			Status:     string(k.Status),
+			CreateDate: aws.ToTime(k.CreateDate),
+		}
+		lastUsed, err := s.iamRepo.GetAccessKeyLastUsed(ctx, accessKey.ID)
+		if err != nil {
+			return nil, err
+		}
+		if lastUsed != nil {
+			accessKey.LastUsedDate = lastUsed.LastUsedDate
+			accessKey.LastUsedService = aws.ToString(lastUsed.ServiceName)
+			accessKey.LastUsedRegion = aws.ToString(lastUsed.Region)
+		}
+		user.AccessKeys = append(user.AccessKeys, accessKey)
+	}
+
+	return user, nil
+}
+
+// ListGroups は名前に nameContains を含む IAM グループを、メンバーとアタッチされたポリシーとあわせて取得します。
+func (s *IAMService) ListGroups(ctx context.Context, nameContains string) ([]Group, error) {
+	awsGroups, err := s.iamRepo.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredGroups []types.Group
+	for _, g := range awsGroups {
+		if nameContains == "" || strings.Contains(*g.GroupName, nameContains) {
+			filteredGroups = append(filteredGroups, g)
+		}
+	}
+
+	groups := make([]Group, len(filteredGroups))
+	eg := s.workerGroup()
+	for i, g := range filteredGroups {
+		i, g := i, g
+		eg.Go(func() error {
+			group := Group{
+				Name: *g.GroupName,
+				Arn:  *g.Arn,
+				Path: aws.ToString(g.Path),
+			}
+
+			members, err := s.iamRepo.GetGroupUsers(ctx, group.Name)
+			if err != nil {
+				return err
+			}
+			for _, m := range members {
+				group.MemberNames = append(group.MemberNames, *m.UserName)
+			}
+
+			attachedPolicies, err := s.iamRepo.ListAttachedGroupPolicies(ctx, group.Name)
+			if err != nil {
+				return err
+			}
+			for _, p := range attachedPolicies {
+				group.AttachedPolicyArns = append(group.AttachedPolicyArns, *p.PolicyArn)
+			}
+
+			groups[i] = group
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// ListOpenIDConnectProviders は URL に urlContains を含む OIDC プロバイダーを取得します。
+func (s *IAMService) ListOpenIDConnectProviders(ctx context.Context, urlContains string) ([]OpenIDConnectProvider, error) {
+	arns, err := s.iamRepo.ListOpenIDConnectProviderArns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var providers []OpenIDConnectProvider
+	for _, arn := range arns {
+		output, err := s.iamRepo.GetOpenIDConnectProvider(ctx, arn)
+		if err != nil {
+			return nil, err
+		}
+		url := aws.ToString(output.Url)
+		if urlContains != "" && !strings.Contains(url, urlContains) {
+			continue
+		}
+		providers = append(providers, OpenIDConnectProvider{
+			Arn:            arn,
+			Url:            url,
+			ClientIDList:   output.ClientIDList,
+			ThumbprintList: output.ThumbprintList,
+			Tags:           convertTags(output.Tags),
+		})
+	}
+	return providers, nil
+}
+
+// ListSAMLProviders は名前に nameContains を含む SAML プロバイダーを取得します。
+func (s *IAMService) ListSAMLProviders(ctx context.Context, nameContains string) ([]SAMLProvider, error) {
+	arns, err := s.iamRepo.ListSAMLProviderArns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var providers []SAMLProvider
+	for _, arn := range arns {
+		name := arn[strings.LastIndex(arn, "/")+1:]
+		if nameContains != "" && !strings.Contains(name, nameContains) {
+			continue
+		}
+		output, err := s.iamRepo.GetSAMLProvider(ctx, arn)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, SAMLProvider{
+			Arn:                  arn,
+			Name:                 name,
+			SAMLMetadataDocument: aws.ToString(output.SAMLMetadataDocument),
+			Tags:                 convertTags(output.Tags),
+		})
+	}
+	return providers, nil
+}
@@ -0,0 +1,155 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+// LambdaRepositoryInterface はLambdaリソースへのアクセスを抽象化します。
+type LambdaRepositoryInterface interface {
+	ListFunctions(ctx context.Context) ([]types.FunctionConfiguration, error)
+	GetFunction(ctx context.Context, functionName string) (*lambda.GetFunctionOutput, error)
+	GetFunctionConcurrency(ctx context.Context, functionName string) (*int32, error)
+	ListAliases(ctx context.Context, functionName string) ([]types.AliasConfiguration, error)
+	GetPolicy(ctx context.Context, functionName string, qualifier string) (string, error)
+	ListEventSourceMappings(ctx context.Context, functionName string) ([]types.EventSourceMappingConfiguration, error)
+	ListFunctionUrlConfigs(ctx context.Context, functionName string) ([]types.FunctionUrlConfig, error)
+	DescribeLogGroup(ctx context.Context, logGroupName string) (*logstypes.LogGroup, error)
+}
+
+// LambdaRepository はLambdaRepositoryInterfaceを実装します。
+// 関数のロググループも出力するため、CloudWatch Logsクライアントも保持します。
+type LambdaRepository struct {
+	client     *lambda.Client
+	logsClient *cloudwatchlogs.Client
+}
+
+// NewLambdaRepository は新しいLambdaRepositoryを生成します。
+func NewLambdaRepository(client *lambda.Client, logsClient *cloudwatchlogs.Client) *LambdaRepository {
+	return &LambdaRepository{client: client, logsClient: logsClient}
+}
+
+// ListFunctions はAWSからLambda関数のリストを取得します。
+func (r *LambdaRepository) ListFunctions(ctx context.Context) ([]types.FunctionConfiguration, error) {
+	var functions []types.FunctionConfiguration
+	paginator := lambda.NewListFunctionsPaginator(r.client, &lambda.ListFunctionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		functions = append(functions, output.Functions...)
+	}
+	return functions, nil
+}
+
+// GetFunction は関数の設定、コードの格納場所、タグを取得します。
+func (r *LambdaRepository) GetFunction(ctx context.Context, functionName string) (*lambda.GetFunctionOutput, error) {
+	return r.client.GetFunction(ctx, &lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+	})
+}
+
+// GetFunctionConcurrency は予約済み同時実行数を取得します。設定されていない場合は nil を返します。
+func (r *LambdaRepository) GetFunctionConcurrency(ctx context.Context, functionName string) (*int32, error) {
+	output, err := r.client.GetFunctionConcurrency(ctx, &lambda.GetFunctionConcurrencyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.ReservedConcurrentExecutions, nil
+}
+
+// ListAliases は関数のエイリアスを取得します。
+func (r *LambdaRepository) ListAliases(ctx context.Context, functionName string) ([]types.AliasConfiguration, error) {
+	var aliases []types.AliasConfiguration
+	paginator := lambda.NewListAliasesPaginator(r.client, &lambda.ListAliasesInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, output.Aliases...)
+	}
+	return aliases, nil
+}
+
+// GetPolicy は関数 (qualifier を指定した場合はそのエイリアスまたはバージョン) のリソースベースポリシーを取得します。
+// ポリシーが無い場合は空文字を返します。
+func (r *LambdaRepository) GetPolicy(ctx context.Context, functionName string, qualifier string) (string, error) {
+	input := &lambda.GetPolicyInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+	output, err := r.client.GetPolicy(ctx, input)
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return aws.ToString(output.Policy), nil
+}
+
+// ListEventSourceMappings は関数のイベントソースマッピングを取得します。
+func (r *LambdaRepository) ListEventSourceMappings(ctx context.Context, functionName string) ([]types.EventSourceMappingConfiguration, error) {
+	var mappings []types.EventSourceMappingConfiguration
+	paginator := lambda.NewListEventSourceMappingsPaginator(r.client, &lambda.ListEventSourceMappingsInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, output.EventSourceMappings...)
+	}
+	return mappings, nil
+}
+
+// ListFunctionUrlConfigs は関数とそのエイリアスに設定された関数URLを取得します。
+func (r *LambdaRepository) ListFunctionUrlConfigs(ctx context.Context, functionName string) ([]types.FunctionUrlConfig, error) {
+	var configs []types.FunctionUrlConfig
+	paginator := lambda.NewListFunctionUrlConfigsPaginator(r.client, &lambda.ListFunctionUrlConfigsInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, output.FunctionUrlConfigs...)
+	}
+	return configs, nil
+}
+
+// DescribeLogGroup は名前が一致するロググループを取得します。存在しない場合は nil を返します。
+func (r *LambdaRepository) DescribeLogGroup(ctx context.Context, logGroupName string) (*logstypes.LogGroup, error) {
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(r.logsClient, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroupName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lg := range output.LogGroups {
+			if aws.ToString(lg.LogGroupName) == logGroupName {
+				return &lg, nil
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,487 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// --- Domain Models ---
+
+// VpcConfig は関数を配置するサブネットとセキュリティグループです。
+type VpcConfig struct {
+	SubnetIDs        []string
+	SecurityGroupIDs []string
+}
+
+// Alias は関数のエイリアスです。AdditionalVersionWeights は加重ルーティング先のバージョンと比率です。
+type Alias struct {
+	Name                     string
+	FunctionVersion          string
+	Description              string
+	AdditionalVersionWeights map[string]float64
+}
+
+// Permission は関数のリソースベースポリシーの1ステートメントです。
+type Permission struct {
+	StatementID         string
+	Action              string
+	Principal           string
+	SourceArn           string
+	SourceAccount       string
+	PrincipalOrgID      string
+	EventSourceToken    string
+	FunctionURLAuthType string
+	// Qualifier はエイリアスまたはバージョンに対するパーミッションの場合に設定されます。
+	Qualifier string
+}
+
+// SkippedPermission は aws_lambda_permission で表現できないため出力しないステートメントです。
+type SkippedPermission struct {
+	StatementID string
+	Qualifier   string
+	Reason      string
+}
+
+// EventSourceMapping は関数のイベントソースマッピングです。
+type EventSourceMapping struct {
+	UUID                           string
+	EventSourceArn                 string
+	BatchSize                      int32
+	Enabled                        bool
+	StartingPosition               string
+	MaximumBatchingWindowInSeconds int32
+	MaximumRetryAttempts           *int32
+	MaximumRecordAgeInSeconds      *int32
+	BisectBatchOnFunctionError     bool
+	ParallelizationFactor          int32
+	FunctionResponseTypes          []string
+	FilterPatterns                 []string
+}
+
+// FunctionURL は関数URLの設定です。
+type FunctionURL struct {
+	// Qualifier はエイリアスに設定された関数URLの場合に設定されます。
+	Qualifier  string
+	AuthType   string
+	InvokeMode string
+	Cors       *Cors
+}
+
+// Cors は関数URLのCORS設定です。
+type Cors struct {
+	AllowCredentials bool
+	AllowHeaders     []string
+	AllowMethods     []string
+	AllowOrigins     []string
+	ExposeHeaders    []string
+	MaxAge           int32
+}
+
+// LogGroup は関数のログを出力するCloudWatch Logsのロググループです。
+type LogGroup struct {
+	Name            string
+	RetentionInDays int32
+	KmsKeyID        string
+}
+
+// Function はHCL生成に必要なLambda関数の情報を保持します。
+type Function struct {
+	Name        string
+	Arn         string
+	Description string
+	// PackageType は Zip または Image です。
+	PackageType          string
+	Runtime              string
+	Handler              string
+	ImageURI             string
+	RoleArn              string
+	MemorySize           int32
+	Timeout              int32
+	EphemeralStorageSize int32
+	Architectures        []string
+	Layers               []string
+	Environment          map[string]string
+	VpcConfig            *VpcConfig
+	TracingMode          string
+	DeadLetterTargetArn  string
+	KmsKeyArn            string
+	ReservedConcurrency  *int32
+	Tags                 map[string]string
+	Aliases              []Alias
+	Permissions          []Permission
+	SkippedPermissions   []SkippedPermission
+	EventSourceMappings  []EventSourceMapping
+	URLs                 []FunctionURL
+	LogGroup             *LogGroup
+}
+
+// --- Service ---
+
+// DefaultConcurrency は関数ごとの情報を並列に取得するワーカー数の既定値です。
+// 1関数あたり複数の Lambda API を順に呼び出すため、スロットリングを避けるよう上限を設けます。
+const DefaultConcurrency = 8
+
+// Service はLambda関連のビジネスロジックを定義します。
+type Service interface {
+	ListFunctions(ctx context.Context, nameContains string) ([]Function, error)
+}
+
+// LambdaService はServiceを実装します。
+type LambdaService struct {
+	repo LambdaRepositoryInterface
+}
+
+// NewLambdaService は新しいLambdaServiceを生成します。
+func NewLambdaService(repo LambdaRepositoryInterface) *LambdaService {
+	return &LambdaService{repo: repo}
+}
+
+// ListFunctions は名前に nameContains を含むLambda関数を、エイリアス、パーミッション、
+// イベントソースマッピング、関数URL、ロググループとあわせて取得します。
+func (s *LambdaService) ListFunctions(ctx context.Context, nameContains string) ([]Function, error) {
+	awsFunctions, err := s.repo.ListFunctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, f := range awsFunctions {
+		if nameContains == "" || strings.Contains(*f.FunctionName, nameContains) {
+			names = append(names, *f.FunctionName)
+		}
+	}
+
+	functions := make([]Function, len(names))
+	var eg errgroup.Group
+	eg.SetLimit(DefaultConcurrency)
+	for i, name := range names {
+		i, name := i, name
+		eg.Go(func() error {
+			function, err := s.buildFunction(ctx, name)
+			if err != nil {
+				return err
+			}
+			functions[i] = *function
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return functions, nil
+}
+
+func (s *LambdaService) buildFunction(ctx context.Context, name string) (*Function, error) {
+	output, err := s.repo.GetFunction(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	c := output.Configuration
+
+	function := &Function{
+		Name:        name,
+		Arn:         aws.ToString(c.FunctionArn),
+		Description: aws.ToString(c.Description),
+		PackageType: string(c.PackageType),
+		Runtime:     string(c.Runtime),
+		Handler:     aws.ToString(c.Handler),
+		RoleArn:     aws.ToString(c.Role),
+		MemorySize:  aws.ToInt32(c.MemorySize),
+		Timeout:     aws.ToInt32(c.Timeout),
+		KmsKeyArn:   aws.ToString(c.KMSKeyArn),
+		Tags:        output.Tags,
+	}
+	if output.Code != nil {
+		function.ImageURI = aws.ToString(output.Code.ImageUri)
+	}
+	if c.EphemeralStorage != nil {
+		function.EphemeralStorageSize = aws.ToInt32(c.EphemeralStorage.Size)
+	}
+	for _, a := range c.Architectures {
+		function.Architectures = append(function.Architectures, string(a))
+	}
+	for _, l := range c.Layers {
+		function.Layers = append(function.Layers, aws.ToString(l.Arn))
+	}
+	if c.Environment != nil {
+		function.Environment = c.Environment.Variables
+	}
+	if c.VpcConfig != nil && len(c.VpcConfig.SubnetIds) > 0 {
+		function.VpcConfig = &VpcConfig{
+			SubnetIDs:        c.VpcConfig.SubnetIds,
+			SecurityGroupIDs: c.VpcConfig.SecurityGroupIds,
+		}
+	}
+	if c.TracingConfig != nil {
+		function.TracingMode = string(c.TracingConfig.Mode)
+	}
+	if c.DeadLetterConfig != nil {
+		function.DeadLetterTargetArn = aws.ToString(c.DeadLetterConfig.TargetArn)
+	}
+
+	function.ReservedConcurrency, err = s.repo.GetFunctionConcurrency(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.repo.ListAliases(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range aliases {
+		alias := Alias{
+			Name:            aws.ToString(a.Name),
+			FunctionVersion: aws.ToString(a.FunctionVersion),
+			Description:     aws.ToString(a.Description),
+		}
+		if a.RoutingConfig != nil {
+			alias.AdditionalVersionWeights = a.RoutingConfig.AdditionalVersionWeights
+		}
+		function.Aliases = append(function.Aliases, alias)
+	}
+
+	// エイリアスに対するパーミッションは関数本体とは別のポリシーとして保持されます。
+	for _, qualifier := range append([]string{""}, aliasNames(function.Aliases)...) {
+		policy, err := s.repo.GetPolicy(ctx, name, qualifier)
+		if err != nil {
+			return nil, err
+		}
+		permissions, skipped, err := parsePermissions(policy, qualifier)
+		if err != nil {
+			return nil, err
+		}
+		function.Permissions = append(function.Permissions, permissions...)
+		function.SkippedPermissions = append(function.SkippedPermissions, skipped...)
+	}
+
+	mappings, err := s.repo.ListEventSourceMappings(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range mappings {
+		function.EventSourceMappings = append(function.EventSourceMappings, convertEventSourceMapping(m))
+	}
+
+	urlConfigs, err := s.repo.ListFunctionUrlConfigs(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range urlConfigs {
+		function.URLs = append(function.URLs, convertFunctionURL(u, function.Arn))
+	}
+
+	logGroupName := "/aws/lambda/" + name
+	if c.LoggingConfig != nil && aws.ToString(c.LoggingConfig.LogGroup) != "" {
+		logGroupName = aws.ToString(c.LoggingConfig.LogGroup)
+	}
+	logGroup, err := s.repo.DescribeLogGroup(ctx, logGroupName)
+	if err != nil {
+		return nil, err
+	}
+	if logGroup != nil {
+		function.LogGroup = &LogGroup{
+			Name:            logGroupName,
+			RetentionInDays: aws.ToInt32(logGroup.RetentionInDays),
+			KmsKeyID:        aws.ToString(logGroup.KmsKeyId),
+		}
+	}
+
+	return function, nil
+}
+
+func aliasNames(aliases []Alias) []string {
+	var names []string
+	for _, a := range aliases {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
+// policyStatement は関数のリソースベースポリシーのステートメントです。
+// Action, Principal, 条件の値は単一の値の場合と配列の場合があるため、json.RawMessage で受け取ります。
+type policyStatement struct {
+	Sid       string                                `json:"Sid"`
+	Effect    string                                `json:"Effect"`
+	Action    json.RawMessage                       `json:"Action"`
+	Principal json.RawMessage                       `json:"Principal"`
+	Condition map[string]map[string]json.RawMessage `json:"Condition"`
+}
+
+// permissionConditions は aws_lambda_permission の属性で表現できる条件です。
+var permissionConditions = map[string]map[string]func(*Permission, string){
+	"ArnLike": {
+		"AWS:SourceArn": func(p *Permission, v string) { p.SourceArn = v },
+	},
+	"StringEquals": {
+		"AWS:SourceAccount":          func(p *Permission, v string) { p.SourceAccount = v },
+		"aws:PrincipalOrgID":         func(p *Permission, v string) { p.PrincipalOrgID = v },
+		"lambda:EventSourceToken":    func(p *Permission, v string) { p.EventSourceToken = v },
+		"lambda:FunctionUrlAuthType": func(p *Permission, v string) { p.FunctionURLAuthType = v },
+	},
+}
+
+// parsePermissions はリソースベースポリシーを aws_lambda_permission 単位のパーミッションに変換します。
+// Deny のステートメントや、複数のアクション、プリンシパル、条件値を持つステートメント、
+// aws_lambda_permission に無い条件を持つステートメントは変換せず、理由とともに skipped として返します。
+func parsePermissions(policy string, qualifier string) (permissions []Permission, skipped []SkippedPermission, err error) {
+	if policy == "" {
+		return nil, nil, nil
+	}
+	var document struct {
+		Statement []policyStatement `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, nil, err
+	}
+
+	for _, st := range document.Statement {
+		permission, reason := convertPermission(st, qualifier)
+		if reason != "" {
+			skipped = append(skipped, SkippedPermission{
+				StatementID: st.Sid,
+				Qualifier:   qualifier,
+				Reason:      reason,
+			})
+			continue
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, skipped, nil
+}
+
+// convertPermission はステートメントをパーミッションに変換します。変換できない場合は理由を返します。
+func convertPermission(st policyStatement, qualifier string) (Permission, string) {
+	if st.Effect != "" && st.Effect != "Allow" {
+		return Permission{}, "effect " + st.Effect + " is not supported"
+	}
+	action, ok := scalar(st.Action)
+	if !ok {
+		return Permission{}, "multiple actions are not supported"
+	}
+	principal, reason := parsePrincipal(st.Principal)
+	if reason != "" {
+		return Permission{}, reason
+	}
+
+	permission := Permission{
+		StatementID: st.Sid,
+		Action:      action,
+		Principal:   principal,
+		Qualifier:   qualifier,
+	}
+	for operator, conditions := range st.Condition {
+		for key, raw := range conditions {
+			set, ok := permissionConditions[operator][key]
+			if !ok {
+				return Permission{}, "condition " + operator + " " + key + " is not supported"
+			}
+			value, ok := scalar(raw)
+			if !ok {
+				return Permission{}, "multiple values of condition " + operator + " " + key + " are not supported"
+			}
+			set(&permission, value)
+		}
+	}
+	return permission, ""
+}
+
+// parsePrincipal はサービスプリンシパルまたはアカウントIDを返します。
+// アカウントのルートARN (arn:aws:iam::<account>:root) はアカウントIDに変換します。
+// 単一のプリンシパルで表せない場合は理由を返します。
+func parsePrincipal(raw json.RawMessage) (string, string) {
+	if principal, ok := scalar(raw); ok {
+		return principal, ""
+	}
+	var principalMap map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &principalMap); err != nil || len(principalMap) != 1 {
+		return "", "principal must be a single service or aws principal"
+	}
+	if service, ok := principalMap["Service"]; ok {
+		principal, ok := scalar(service)
+		if !ok {
+			return "", "multiple service principals are not supported"
+		}
+		return principal, ""
+	}
+	awsPrincipal, ok := principalMap["AWS"]
+	if !ok {
+		return "", "principal must be a single service or aws principal"
+	}
+	principal, ok := scalar(awsPrincipal)
+	if !ok {
+		return "", "multiple aws principals are not supported"
+	}
+	if strings.HasPrefix(principal, "arn:") && strings.HasSuffix(principal, ":root") {
+		parts := strings.Split(principal, ":")
+		if len(parts) > 4 {
+			return parts[4], ""
+		}
+	}
+	return principal, ""
+}
+
+// scalar は文字列、または要素が1つだけの文字列の配列から値を取り出します。
+func scalar(raw json.RawMessage) (string, bool) {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value, true
+	}
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil && len(values) == 1 {
+		return values[0], true
+	}
+	return "", false
+}
+
+func convertEventSourceMapping(m types.EventSourceMappingConfiguration) EventSourceMapping {
+	state := aws.ToString(m.State)
+	mapping := EventSourceMapping{
+		UUID:                           aws.ToString(m.UUID),
+		EventSourceArn:                 aws.ToString(m.EventSourceArn),
+		BatchSize:                      aws.ToInt32(m.BatchSize),
+		Enabled:                        state == "Enabled" || state == "Enabling" || state == "Updating",
+		StartingPosition:               string(m.StartingPosition),
+		MaximumBatchingWindowInSeconds: aws.ToInt32(m.MaximumBatchingWindowInSeconds),
+		MaximumRetryAttempts:           m.MaximumRetryAttempts,
+		MaximumRecordAgeInSeconds:      m.MaximumRecordAgeInSeconds,
+		BisectBatchOnFunctionError:     aws.ToBool(m.BisectBatchOnFunctionError),
+		ParallelizationFactor:          aws.ToInt32(m.ParallelizationFactor),
+	}
+	for _, t := range m.FunctionResponseTypes {
+		mapping.FunctionResponseTypes = append(mapping.FunctionResponseTypes, string(t))
+	}
+	if m.FilterCriteria != nil {
+		for _, f := range m.FilterCriteria.Filters {
+			mapping.FilterPatterns = append(mapping.FilterPatterns, aws.ToString(f.Pattern))
+		}
+	}
+	return mapping
+}
+
+// convertFunctionURL は関数URLの設定を変換します。エイリアスのURLの場合は関数ARNの末尾からエイリアス名を取り出します。
+func convertFunctionURL(u types.FunctionUrlConfig, functionArn string) FunctionURL {
+	url := FunctionURL{
+		AuthType:   string(u.AuthType),
+		InvokeMode: string(u.InvokeMode),
+	}
+	if arn := aws.ToString(u.FunctionArn); strings.HasPrefix(arn, functionArn+":") {
+		url.Qualifier = strings.TrimPrefix(arn, functionArn+":")
+	}
+	if u.Cors != nil {
+		url.Cors = &Cors{
+			AllowCredentials: aws.ToBool(u.Cors.AllowCredentials),
+			AllowHeaders:     u.Cors.AllowHeaders,
+			AllowMethods:     u.Cors.AllowMethods,
+			AllowOrigins:     u.Cors.AllowOrigins,
+			ExposeHeaders:    u.Cors.ExposeHeaders,
+			MaxAge:           aws.ToInt32(u.Cors.MaxAge),
+		}
+	}
+	return url
+}
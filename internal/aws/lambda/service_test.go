@@ -0,0 +1,108 @@
+package lambda
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		qualifier   string
+		permissions []Permission
+		skipped     []SkippedPermission
+	}{
+		{
+			name:   "empty policy",
+			policy: "",
+		},
+		{
+			name: "service principal with source arn",
+			policy: `{"Statement":[{"Sid":"s3","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction",
+				"Condition":{"ArnLike":{"AWS:SourceArn":"arn:aws:s3:::bucket"},"StringEquals":{"AWS:SourceAccount":"123456789012"}}}]}`,
+			permissions: []Permission{{
+				StatementID:   "s3",
+				Action:        "lambda:InvokeFunction",
+				Principal:     "s3.amazonaws.com",
+				SourceArn:     "arn:aws:s3:::bucket",
+				SourceAccount: "123456789012",
+			}},
+		},
+		{
+			name:      "account root arn in a single element array",
+			policy:    `{"Statement":[{"Sid":"acct","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":["lambda:InvokeFunction"]}]}`,
+			qualifier: "live",
+			permissions: []Permission{{
+				StatementID: "acct",
+				Action:      "lambda:InvokeFunction",
+				Principal:   "123456789012",
+				Qualifier:   "live",
+			}},
+		},
+		{
+			name:   "wildcard principal with function url auth type",
+			policy: `{"Statement":[{"Sid":"url","Effect":"Allow","Principal":"*","Action":"lambda:InvokeFunctionUrl","Condition":{"StringEquals":{"lambda:FunctionUrlAuthType":["NONE"]}}}]}`,
+			permissions: []Permission{{
+				StatementID:         "url",
+				Action:              "lambda:InvokeFunctionUrl",
+				Principal:           "*",
+				FunctionURLAuthType: "NONE",
+			}},
+		},
+		{
+			name:    "multiple actions",
+			policy:  `{"Statement":[{"Sid":"multi","Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Action":["lambda:InvokeFunction","lambda:GetFunction"]}]}`,
+			skipped: []SkippedPermission{{StatementID: "multi", Reason: "multiple actions are not supported"}},
+		},
+		{
+			name:    "multiple aws principals",
+			policy:  `{"Statement":[{"Sid":"accts","Effect":"Allow","Principal":{"AWS":["111111111111","222222222222"]},"Action":"lambda:InvokeFunction"}]}`,
+			skipped: []SkippedPermission{{StatementID: "accts", Reason: "multiple aws principals are not supported"}},
+		},
+		{
+			name:    "multiple condition values",
+			policy:  `{"Statement":[{"Sid":"arns","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"lambda:InvokeFunction","Condition":{"ArnLike":{"AWS:SourceArn":["arn:aws:sns:a","arn:aws:sns:b"]}}}]}`,
+			skipped: []SkippedPermission{{StatementID: "arns", Reason: "multiple values of condition ArnLike AWS:SourceArn are not supported"}},
+		},
+		{
+			name:    "unsupported condition",
+			policy:  `{"Statement":[{"Sid":"ip","Effect":"Allow","Principal":"*","Action":"lambda:InvokeFunction","Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`,
+			skipped: []SkippedPermission{{StatementID: "ip", Reason: "condition IpAddress aws:SourceIp is not supported"}},
+		},
+		{
+			name: "deny is skipped while allow is kept",
+			policy: `{"Statement":[{"Sid":"deny","Effect":"Deny","Principal":"*","Action":"lambda:InvokeFunction"},
+				{"Sid":"allow","Effect":"Allow","Principal":{"Service":"apigateway.amazonaws.com"},"Action":"lambda:InvokeFunction"}]}`,
+			qualifier: "live",
+			permissions: []Permission{{
+				StatementID: "allow",
+				Action:      "lambda:InvokeFunction",
+				Principal:   "apigateway.amazonaws.com",
+				Qualifier:   "live",
+			}},
+			skipped: []SkippedPermission{{StatementID: "deny", Qualifier: "live", Reason: "effect Deny is not supported"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions, skipped, err := parsePermissions(tt.policy, tt.qualifier)
+			if err != nil {
+				t.Fatalf("parsePermissions() error = %v", err)
+			}
+			if !reflect.DeepEqual(permissions, tt.permissions) {
+				t.Errorf("permissions = %+v, want %+v", permissions, tt.permissions)
+			}
+			if !reflect.DeepEqual(skipped, tt.skipped) {
+				t.Errorf("skipped = %+v, want %+v", skipped, tt.skipped)
+			}
+		})
+	}
+}
+
+func TestParsePermissionsInvalidPolicy(t *testing.T) {
+	if _, _, err := parsePermissions("{", ""); err == nil {
+		t.Error("parsePermissions() error = nil, want an error for an invalid policy")
+	}
+}
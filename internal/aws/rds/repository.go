@@ -4,6 +4,7 @@ package rds
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 )
@@ -13,6 +14,18 @@ type RDSRepositoryInterface interface {
 	DescribeDBClusters(ctx context.Context, dbClusterIdentifier *string) ([]types.DBCluster, error)
 	DescribeDBInstances(ctx context.Context, dbInstanceIdentifier *string) ([]types.DBInstance, error)
 	DescribeDBParameterGroups(ctx context.Context, dbParameterGroupName *string) ([]types.DBParameterGroup, error)
+	DescribeDBClusterParameterGroups(ctx context.Context, dbClusterParameterGroupName *string) ([]types.DBClusterParameterGroup, error)
+	DescribeDBSubnetGroups(ctx context.Context, dbSubnetGroupName *string) ([]types.DBSubnetGroup, error)
+	DescribeOptionGroups(ctx context.Context, optionGroupName *string) ([]types.OptionGroup, error)
+	DescribeDBParameters(ctx context.Context, dbParameterGroupName string, source string) ([]types.Parameter, error)
+	DescribeDBClusterParameters(ctx context.Context, dbClusterParameterGroupName string, source string) ([]types.Parameter, error)
+	DescribeDBProxies(ctx context.Context) ([]types.DBProxy, error)
+	DescribeDBProxyTargetGroups(ctx context.Context, dbProxyName string) ([]types.DBProxyTargetGroup, error)
+	DescribeDBProxyTargets(ctx context.Context, dbProxyName string, targetGroupName string) ([]types.DBProxyTarget, error)
+	DescribeGlobalClusters(ctx context.Context) ([]types.GlobalCluster, error)
+	DescribeEventSubscriptions(ctx context.Context) ([]types.EventSubscription, error)
+	DescribeManualDBSnapshots(ctx context.Context, dbInstanceIdentifier string) ([]types.DBSnapshot, error)
+	DescribeManualDBClusterSnapshots(ctx context.Context, dbClusterIdentifier string) ([]types.DBClusterSnapshot, error)
 	ListTagsForResource(ctx context.Context, resourceName *string) ([]types.Tag, error)
 }
 
@@ -74,4 +87,217 @@ func (r *RDSRepository) ListTagsForResource(ctx context.Context, resourceName *s
 		return nil, err
 	}
 	return result.TagList, nil
-} 
+}
+
+// DescribeDBClusterParameterGroups はAWSからDBクラスタパラメータグループのリストを取得します。
+func (r *RDSRepository) DescribeDBClusterParameterGroups(ctx context.Context, dbClusterParameterGroupName *string) ([]types.DBClusterParameterGroup, error) {
+	input := &rds.DescribeDBClusterParameterGroupsInput{
+		DBClusterParameterGroupName: dbClusterParameterGroupName,
+	}
+
+	var groups []types.DBClusterParameterGroup
+	paginator := rds.NewDescribeDBClusterParameterGroupsPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, output.DBClusterParameterGroups...)
+	}
+	return groups, nil
+}
+
+// DescribeDBSubnetGroups はAWSからDBサブネットグループのリストを取得します。
+func (r *RDSRepository) DescribeDBSubnetGroups(ctx context.Context, dbSubnetGroupName *string) ([]types.DBSubnetGroup, error) {
+	input := &rds.DescribeDBSubnetGroupsInput{
+		DBSubnetGroupName: dbSubnetGroupName,
+	}
+
+	var groups []types.DBSubnetGroup
+	paginator := rds.NewDescribeDBSubnetGroupsPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, output.DBSubnetGroups...)
+	}
+	return groups, nil
+}
+
+// DescribeOptionGroups はAWSからオプショングループのリストを取得します。
+func (r *RDSRepository) DescribeOptionGroups(ctx context.Context, optionGroupName *string) ([]types.OptionGroup, error) {
+	input := &rds.DescribeOptionGroupsInput{
+		OptionGroupName: optionGroupName,
+	}
+
+	var groups []types.OptionGroup
+	paginator := rds.NewDescribeOptionGroupsPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, output.OptionGroupsList...)
+	}
+	return groups, nil
+}
+
+// DescribeDBParameters はDBパラメータグループのパラメータを取得します。source が空でなければその値で絞り込みます。
+func (r *RDSRepository) DescribeDBParameters(ctx context.Context, dbParameterGroupName string, source string) ([]types.Parameter, error) {
+	input := &rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(dbParameterGroupName),
+	}
+	if source != "" {
+		input.Source = aws.String(source)
+	}
+
+	var parameters []types.Parameter
+	paginator := rds.NewDescribeDBParametersPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		parameters = append(parameters, output.Parameters...)
+	}
+	return parameters, nil
+}
+
+// DescribeDBClusterParameters はDBクラスタパラメータグループのパラメータを取得します。source が空でなければその値で絞り込みます。
+func (r *RDSRepository) DescribeDBClusterParameters(ctx context.Context, dbClusterParameterGroupName string, source string) ([]types.Parameter, error) {
+	input := &rds.DescribeDBClusterParametersInput{
+		DBClusterParameterGroupName: aws.String(dbClusterParameterGroupName),
+	}
+	if source != "" {
+		input.Source = aws.String(source)
+	}
+
+	var parameters []types.Parameter
+	paginator := rds.NewDescribeDBClusterParametersPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		parameters = append(parameters, output.Parameters...)
+	}
+	return parameters, nil
+}
+
+// DescribeDBProxies はAWSからRDS Proxyのリストを取得します。
+func (r *RDSRepository) DescribeDBProxies(ctx context.Context) ([]types.DBProxy, error) {
+	var proxies []types.DBProxy
+	paginator := rds.NewDescribeDBProxiesPaginator(r.client, &rds.DescribeDBProxiesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, output.DBProxies...)
+	}
+	return proxies, nil
+}
+
+// DescribeDBProxyTargetGroups はRDS Proxyのターゲットグループを取得します。
+func (r *RDSRepository) DescribeDBProxyTargetGroups(ctx context.Context, dbProxyName string) ([]types.DBProxyTargetGroup, error) {
+	input := &rds.DescribeDBProxyTargetGroupsInput{
+		DBProxyName: aws.String(dbProxyName),
+	}
+
+	var targetGroups []types.DBProxyTargetGroup
+	paginator := rds.NewDescribeDBProxyTargetGroupsPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		targetGroups = append(targetGroups, output.TargetGroups...)
+	}
+	return targetGroups, nil
+}
+
+// DescribeDBProxyTargets はRDS Proxyのターゲットグループに登録されたターゲットを取得します。
+func (r *RDSRepository) DescribeDBProxyTargets(ctx context.Context, dbProxyName string, targetGroupName string) ([]types.DBProxyTarget, error) {
+	input := &rds.DescribeDBProxyTargetsInput{
+		DBProxyName:     aws.String(dbProxyName),
+		TargetGroupName: aws.String(targetGroupName),
+	}
+
+	var targets []types.DBProxyTarget
+	paginator := rds.NewDescribeDBProxyTargetsPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, output.Targets...)
+	}
+	return targets, nil
+}
+
+// DescribeGlobalClusters はAWSからグローバルクラスタのリストを取得します。
+func (r *RDSRepository) DescribeGlobalClusters(ctx context.Context) ([]types.GlobalCluster, error) {
+	var globalClusters []types.GlobalCluster
+	paginator := rds.NewDescribeGlobalClustersPaginator(r.client, &rds.DescribeGlobalClustersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		globalClusters = append(globalClusters, output.GlobalClusters...)
+	}
+	return globalClusters, nil
+}
+
+// DescribeEventSubscriptions はAWSからRDSイベントサブスクリプションのリストを取得します。
+func (r *RDSRepository) DescribeEventSubscriptions(ctx context.Context) ([]types.EventSubscription, error) {
+	var subscriptions []types.EventSubscription
+	paginator := rds.NewDescribeEventSubscriptionsPaginator(r.client, &rds.DescribeEventSubscriptionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, output.EventSubscriptionsList...)
+	}
+	return subscriptions, nil
+}
+
+// DescribeManualDBSnapshots はDBインスタンスの手動スナップショットを取得します。
+func (r *RDSRepository) DescribeManualDBSnapshots(ctx context.Context, dbInstanceIdentifier string) ([]types.DBSnapshot, error) {
+	input := &rds.DescribeDBSnapshotsInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		SnapshotType:         aws.String("manual"),
+	}
+
+	var snapshots []types.DBSnapshot
+	paginator := rds.NewDescribeDBSnapshotsPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, output.DBSnapshots...)
+	}
+	return snapshots, nil
+}
+
+// DescribeManualDBClusterSnapshots はDBクラスタの手動スナップショットを取得します。
+func (r *RDSRepository) DescribeManualDBClusterSnapshots(ctx context.Context, dbClusterIdentifier string) ([]types.DBClusterSnapshot, error) {
+	input := &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterIdentifier: aws.String(dbClusterIdentifier),
+		SnapshotType:        aws.String("manual"),
+	}
+
+	var snapshots []types.DBClusterSnapshot
+	paginator := rds.NewDescribeDBClusterSnapshotsPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, output.DBClusterSnapshots...)
+	}
+	return snapshots, nil
+}
@@ -3,6 +3,9 @@ package rds
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
@@ -11,28 +14,235 @@ import (
 
 // DBCluster はHCL生成に必要なDBクラスタの情報を保持します。
 type DBCluster struct {
-	Identifier              string
-	Engine                  string
-	EngineMode              string
-	Tags                    map[string]string
-	DBClusterParameterGroup string
-	MemberIdentifiers       []string
+	Identifier               string
+	Engine                   string
+	EngineMode               string
+	EngineVersion            string
+	DatabaseName             string
+	MasterUsername           string
+	ManageMasterUserPassword bool
+	Port                     int32
+	// DBClusterInstanceClass はマルチAZ DBクラスタ (Aurora 以外) の場合のみ設定されます。
+	DBClusterInstanceClass             string
+	AllocatedStorage                   int32
+	StorageType                        string
+	Iops                               int32
+	StorageEncrypted                   bool
+	KmsKeyID                           string
+	BackupRetentionPeriod              int32
+	PreferredBackupWindow              string
+	PreferredMaintenanceWindow         string
+	DBSubnetGroupName                  string
+	VpcSecurityGroupIDs                []string
+	PerformanceInsightsEnabled         bool
+	PerformanceInsightsKMSKeyID        string
+	PerformanceInsightsRetentionPeriod int32
+	IAMDatabaseAuthenticationEnabled   bool
+	DeletionProtection                 bool
+	MonitoringInterval                 int32
+	MonitoringRoleArn                  string
+	EnabledCloudwatchLogsExports       []string
+	ServerlessV2Scaling                *ServerlessV2Scaling
+	CopyTagsToSnapshot                 bool
+	Tags                               map[string]string
+	DBClusterParameterGroup            string
+	MemberIdentifiers                  []string
+}
+
+// ServerlessV2Scaling は Aurora Serverless v2 のキャパシティ範囲です。
+type ServerlessV2Scaling struct {
+	MinCapacity float64
+	MaxCapacity float64
 }
 
 // DBInstance はHCL生成に必要なDBインスタンスの情報を保持します。
 type DBInstance struct {
-	Identifier        string
-	Engine            string
-	InstanceClass     string
-	Tags              map[string]string
-	DBParameterGroups []string
+	Identifier                         string
+	Engine                             string
+	EngineVersion                      string
+	InstanceClass                      string
+	DBName                             string
+	MasterUsername                     string
+	ManageMasterUserPassword           bool
+	Port                               int32
+	AllocatedStorage                   int32
+	MaxAllocatedStorage                int32
+	StorageType                        string
+	Iops                               int32
+	StorageThroughput                  int32
+	StorageEncrypted                   bool
+	KmsKeyID                           string
+	MultiAZ                            bool
+	AvailabilityZone                   string
+	PubliclyAccessible                 bool
+	AutoMinorVersionUpgrade            bool
+	CACertificateIdentifier            string
+	BackupRetentionPeriod              int32
+	PreferredBackupWindow              string
+	PreferredMaintenanceWindow         string
+	DBSubnetGroupName                  string
+	VpcSecurityGroupIDs                []string
+	PerformanceInsightsEnabled         bool
+	PerformanceInsightsKMSKeyID        string
+	PerformanceInsightsRetentionPeriod int32
+	IAMDatabaseAuthenticationEnabled   bool
+	DeletionProtection                 bool
+	MonitoringInterval                 int32
+	MonitoringRoleArn                  string
+	EnabledCloudwatchLogsExports       []string
+	CopyTagsToSnapshot                 bool
+	Tags                               map[string]string
+	DBParameterGroups                  []string
+	OptionGroupNames                   []string
+	// DBClusterIdentifier は Aurora クラスタのメンバーである場合に所属するクラスタの識別子です。
+	DBClusterIdentifier string
+	PromotionTier       int32
 }
 
 // DBParameterGroup はHCL生成に必要なDBパラメータグループの情報を保持します。
 type DBParameterGroup struct {
-	Name   string
-	Family string
-	Tags   map[string]string
+	Name        string
+	Family      string
+	Description string
+	Parameters  []Parameter
+	Tags        map[string]string
+}
+
+// DBClusterParameterGroup はHCL生成に必要なDBクラスタパラメータグループの情報を保持します。
+type DBClusterParameterGroup struct {
+	Name        string
+	Family      string
+	Description string
+	Parameters  []Parameter
+	Tags        map[string]string
+}
+
+// Parameter はユーザーが変更したパラメータです。
+type Parameter struct {
+	Name        string
+	Value       string
+	ApplyMethod string
+}
+
+// userParameterSource はユーザーが変更したパラメータのみを取得するための Source の値です。
+const userParameterSource = "user"
+
+// DBSubnetGroup はHCL生成に必要なDBサブネットグループの情報を保持します。
+type DBSubnetGroup struct {
+	Name        string
+	Description string
+	SubnetIDs   []string
+	Tags        map[string]string
+}
+
+// OptionGroup はHCL生成に必要なオプショングループの情報を保持します。
+type OptionGroup struct {
+	Name               string
+	EngineName         string
+	MajorEngineVersion string
+	Description        string
+	Options            []Option
+	Tags               map[string]string
+}
+
+// Option はオプショングループに追加されたオプションです。
+type Option struct {
+	Name                string
+	Port                int32
+	Version             string
+	VpcSecurityGroupIDs []string
+	// Settings はデフォルト値から変更されているオプション設定のみを保持します。
+	Settings []OptionSetting
+}
+
+// OptionSetting はオプションの設定値です。
+type OptionSetting struct {
+	Name  string
+	Value string
+}
+
+// DBProxy はHCL生成に必要なRDS Proxyの情報を保持します。
+type DBProxy struct {
+	Name                string
+	Arn                 string
+	EngineFamily        string
+	RoleArn             string
+	VpcSubnetIDs        []string
+	VpcSecurityGroupIDs []string
+	RequireTLS          bool
+	DebugLogging        bool
+	IdleClientTimeout   int32
+	Auth                []DBProxyAuth
+	// DefaultTargetGroup は Terraform で管理できる唯一のターゲットグループ (default) です。
+	DefaultTargetGroup *DBProxyTargetGroup
+	Targets            []DBProxyTarget
+	Tags               map[string]string
+}
+
+// DBProxyAuth はRDS Proxyの認証設定です。
+type DBProxyAuth struct {
+	AuthScheme             string
+	ClientPasswordAuthType string
+	Description            string
+	IAMAuth                string
+	SecretArn              string
+	Username               string
+}
+
+// DBProxyTargetGroup はRDS Proxyのターゲットグループと接続プール設定です。
+type DBProxyTargetGroup struct {
+	Name                      string
+	ConnectionBorrowTimeout   int32
+	InitQuery                 string
+	MaxConnectionsPercent     int32
+	MaxIdleConnectionsPercent int32
+	SessionPinningFilters     []string
+}
+
+// DBProxyTarget はRDS Proxyのターゲットです。Type は RDS_INSTANCE または TRACKED_CLUSTER です。
+type DBProxyTarget struct {
+	TargetGroupName string
+	Type            string
+	RdsResourceID   string
+}
+
+// GlobalCluster はHCL生成に必要なグローバルクラスタの情報を保持します。
+type GlobalCluster struct {
+	Identifier         string
+	Engine             string
+	EngineVersion      string
+	DatabaseName       string
+	StorageEncrypted   bool
+	DeletionProtection bool
+	MemberClusterArns  []string
+	Tags               map[string]string
+}
+
+// EventSubscription はHCL生成に必要なRDSイベントサブスクリプションの情報を保持します。
+type EventSubscription struct {
+	Name            string
+	SnsTopicArn     string
+	SourceType      string
+	SourceIDs       []string
+	EventCategories []string
+	Enabled         bool
+	Tags            map[string]string
+}
+
+// Snapshot はレポート用の手動スナップショットの情報です。Terraform のリソースとしては出力しません。
+type Snapshot struct {
+	// SourceType は "instance" または "cluster" です。
+	SourceType       string
+	SourceIdentifier string
+	Identifier       string
+	Arn              string
+	CreateTime       time.Time
+	Engine           string
+	EngineVersion    string
+	Encrypted        bool
+	KmsKeyID         string
+	AllocatedStorage int32
+	Status           string
 }
 
 // Service はRDS関連のビジネスロジックを定義します。
@@ -40,6 +250,13 @@ type Service interface {
 	ListDBClusters(ctx context.Context, dbClusterIdentifier string) ([]DBCluster, error)
 	ListDBInstances(ctx context.Context, dbInstanceIdentifier string) ([]DBInstance, error)
 	ListDBParameterGroups(ctx context.Context, dbParameterGroupName string) ([]DBParameterGroup, error)
+	ListDBClusterParameterGroups(ctx context.Context, dbClusterParameterGroupName string) ([]DBClusterParameterGroup, error)
+	ListDBSubnetGroups(ctx context.Context, dbSubnetGroupName string) ([]DBSubnetGroup, error)
+	ListOptionGroups(ctx context.Context, optionGroupName string) ([]OptionGroup, error)
+	ListDBProxies(ctx context.Context, nameContains string) ([]DBProxy, error)
+	ListGlobalClusters(ctx context.Context, identifierContains string) ([]GlobalCluster, error)
+	ListEventSubscriptions(ctx context.Context, nameContains string) ([]EventSubscription, error)
+	ListManualSnapshots(ctx context.Context, clusterIdentifiers []string, instanceIdentifiers []string) ([]Snapshot, error)
 }
 
 // RDSService はServiceを実装します。
@@ -77,14 +294,46 @@ func (s *RDSService) ListDBClusters(ctx context.Context, dbClusterIdentifier str
 			clusterPgName = *c.DBClusterParameterGroup
 		}
 
-		clusters = append(clusters, DBCluster{
-			Identifier:              *c.DBClusterIdentifier,
-			Engine:                  *c.Engine,
-			EngineMode:              *c.EngineMode,
-			Tags:                    convertTags(c.TagList),
-			DBClusterParameterGroup: clusterPgName,
-			MemberIdentifiers:       memberIdentifiers,
-		})
+		cluster := DBCluster{
+			Identifier:                         *c.DBClusterIdentifier,
+			Engine:                             *c.Engine,
+			EngineMode:                         aws.ToString(c.EngineMode),
+			EngineVersion:                      aws.ToString(c.EngineVersion),
+			DatabaseName:                       aws.ToString(c.DatabaseName),
+			MasterUsername:                     aws.ToString(c.MasterUsername),
+			ManageMasterUserPassword:           c.MasterUserSecret != nil,
+			Port:                               aws.ToInt32(c.Port),
+			DBClusterInstanceClass:             aws.ToString(c.DBClusterInstanceClass),
+			AllocatedStorage:                   aws.ToInt32(c.AllocatedStorage),
+			StorageType:                        aws.ToString(c.StorageType),
+			Iops:                               aws.ToInt32(c.Iops),
+			StorageEncrypted:                   aws.ToBool(c.StorageEncrypted),
+			KmsKeyID:                           aws.ToString(c.KmsKeyId),
+			BackupRetentionPeriod:              aws.ToInt32(c.BackupRetentionPeriod),
+			PreferredBackupWindow:              aws.ToString(c.PreferredBackupWindow),
+			PreferredMaintenanceWindow:         aws.ToString(c.PreferredMaintenanceWindow),
+			DBSubnetGroupName:                  aws.ToString(c.DBSubnetGroup),
+			VpcSecurityGroupIDs:                vpcSecurityGroupIDs(c.VpcSecurityGroups),
+			PerformanceInsightsEnabled:         aws.ToBool(c.PerformanceInsightsEnabled),
+			PerformanceInsightsKMSKeyID:        aws.ToString(c.PerformanceInsightsKMSKeyId),
+			PerformanceInsightsRetentionPeriod: aws.ToInt32(c.PerformanceInsightsRetentionPeriod),
+			IAMDatabaseAuthenticationEnabled:   aws.ToBool(c.IAMDatabaseAuthenticationEnabled),
+			DeletionProtection:                 aws.ToBool(c.DeletionProtection),
+			MonitoringInterval:                 aws.ToInt32(c.MonitoringInterval),
+			MonitoringRoleArn:                  aws.ToString(c.MonitoringRoleArn),
+			EnabledCloudwatchLogsExports:       c.EnabledCloudwatchLogsExports,
+			CopyTagsToSnapshot:                 aws.ToBool(c.CopyTagsToSnapshot),
+			Tags:                               convertTags(c.TagList),
+			DBClusterParameterGroup:            clusterPgName,
+			MemberIdentifiers:                  memberIdentifiers,
+		}
+		if c.ServerlessV2ScalingConfiguration != nil {
+			cluster.ServerlessV2Scaling = &ServerlessV2Scaling{
+				MinCapacity: aws.ToFloat64(c.ServerlessV2ScalingConfiguration.MinCapacity),
+				MaxCapacity: aws.ToFloat64(c.ServerlessV2ScalingConfiguration.MaxCapacity),
+			}
+		}
+		clusters = append(clusters, cluster)
 	}
 	return clusters, nil
 }
@@ -106,14 +355,59 @@ func (s *RDSService) ListDBInstances(ctx context.Context, dbInstanceIdentifier s
 		for _, pg := range i.DBParameterGroups {
 			pgNames = append(pgNames, *pg.DBParameterGroupName)
 		}
+		var optionGroupNames []string
+		for _, og := range i.OptionGroupMemberships {
+			optionGroupNames = append(optionGroupNames, aws.ToString(og.OptionGroupName))
+		}
 
-		instances = append(instances, DBInstance{
-			Identifier:        *i.DBInstanceIdentifier,
-			Engine:            *i.Engine,
-			InstanceClass:     *i.DBInstanceClass,
-			Tags:              convertTags(i.TagList),
-			DBParameterGroups: pgNames,
-		})
+		instance := DBInstance{
+			Identifier:                         *i.DBInstanceIdentifier,
+			Engine:                             *i.Engine,
+			EngineVersion:                      aws.ToString(i.EngineVersion),
+			InstanceClass:                      *i.DBInstanceClass,
+			DBName:                             aws.ToString(i.DBName),
+			MasterUsername:                     aws.ToString(i.MasterUsername),
+			ManageMasterUserPassword:           i.MasterUserSecret != nil,
+			Port:                               aws.ToInt32(i.DbInstancePort),
+			AllocatedStorage:                   aws.ToInt32(i.AllocatedStorage),
+			MaxAllocatedStorage:                aws.ToInt32(i.MaxAllocatedStorage),
+			StorageType:                        aws.ToString(i.StorageType),
+			Iops:                               aws.ToInt32(i.Iops),
+			StorageThroughput:                  aws.ToInt32(i.StorageThroughput),
+			StorageEncrypted:                   aws.ToBool(i.StorageEncrypted),
+			KmsKeyID:                           aws.ToString(i.KmsKeyId),
+			MultiAZ:                            aws.ToBool(i.MultiAZ),
+			AvailabilityZone:                   aws.ToString(i.AvailabilityZone),
+			PubliclyAccessible:                 aws.ToBool(i.PubliclyAccessible),
+			AutoMinorVersionUpgrade:            aws.ToBool(i.AutoMinorVersionUpgrade),
+			CACertificateIdentifier:            aws.ToString(i.CACertificateIdentifier),
+			BackupRetentionPeriod:              aws.ToInt32(i.BackupRetentionPeriod),
+			PreferredBackupWindow:              aws.ToString(i.PreferredBackupWindow),
+			PreferredMaintenanceWindow:         aws.ToString(i.PreferredMaintenanceWindow),
+			VpcSecurityGroupIDs:                vpcSecurityGroupIDs(i.VpcSecurityGroups),
+			PerformanceInsightsEnabled:         aws.ToBool(i.PerformanceInsightsEnabled),
+			PerformanceInsightsKMSKeyID:        aws.ToString(i.PerformanceInsightsKMSKeyId),
+			PerformanceInsightsRetentionPeriod: aws.ToInt32(i.PerformanceInsightsRetentionPeriod),
+			IAMDatabaseAuthenticationEnabled:   aws.ToBool(i.IAMDatabaseAuthenticationEnabled),
+			DeletionProtection:                 aws.ToBool(i.DeletionProtection),
+			MonitoringInterval:                 aws.ToInt32(i.MonitoringInterval),
+			MonitoringRoleArn:                  aws.ToString(i.MonitoringRoleArn),
+			EnabledCloudwatchLogsExports:       i.EnabledCloudwatchLogsExports,
+			CopyTagsToSnapshot:                 aws.ToBool(i.CopyTagsToSnapshot),
+			Tags:                               convertTags(i.TagList),
+			DBParameterGroups:                  pgNames,
+			OptionGroupNames:                   optionGroupNames,
+			DBClusterIdentifier:                aws.ToString(i.DBClusterIdentifier),
+			PromotionTier:                      aws.ToInt32(i.PromotionTier),
+		}
+		if i.DBSubnetGroup != nil {
+			instance.DBSubnetGroupName = aws.ToString(i.DBSubnetGroup.DBSubnetGroupName)
+		}
+		// DbInstancePort は 0 を返すことがあるため、エンドポイントのポートを優先します。
+		if i.Endpoint != nil && i.Endpoint.Port != nil {
+			instance.Port = *i.Endpoint.Port
+		}
+		instances = append(instances, instance)
 	}
 	return instances, nil
 }
@@ -139,10 +433,16 @@ func (s *RDSService) ListDBParameterGroups(ctx context.Context, dbParameterGroup
 			if err != nil {
 				return err
 			}
+			parameters, err := s.repo.DescribeDBParameters(ctx, *parameterGroup.DBParameterGroupName, userParameterSource)
+			if err != nil {
+				return err
+			}
 			pgDomain := DBParameterGroup{
-				Name:   *parameterGroup.DBParameterGroupName,
-				Family: *parameterGroup.DBParameterGroupFamily,
-				Tags:   convertTags(tags),
+				Name:        *parameterGroup.DBParameterGroupName,
+				Family:      *parameterGroup.DBParameterGroupFamily,
+				Description: aws.ToString(parameterGroup.Description),
+				Parameters:  convertParameters(parameters),
+				Tags:        convertTags(tags),
 			}
 			pgsWithTags <- pgDomain
 			return nil
@@ -162,10 +462,397 @@ func (s *RDSService) ListDBParameterGroups(ctx context.Context, dbParameterGroup
 	return pgs, nil
 }
 
+// ListDBClusterParameterGroups はDBクラスタパラメータグループのリストを取得し、ドメインオブジェクトに変換します。
+func (s *RDSService) ListDBClusterParameterGroups(ctx context.Context, dbClusterParameterGroupName string) ([]DBClusterParameterGroup, error) {
+	var name *string
+	if dbClusterParameterGroupName != "" {
+		name = aws.String(dbClusterParameterGroupName)
+	}
+	awsPGs, err := s.repo.DescribeDBClusterParameterGroups(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pgs := make([]DBClusterParameterGroup, len(awsPGs))
+	var eg errgroup.Group
+	for i, pg := range awsPGs {
+		i, pg := i, pg
+		eg.Go(func() error {
+			tags, err := s.repo.ListTagsForResource(ctx, pg.DBClusterParameterGroupArn)
+			if err != nil {
+				return err
+			}
+			parameters, err := s.repo.DescribeDBClusterParameters(ctx, *pg.DBClusterParameterGroupName, userParameterSource)
+			if err != nil {
+				return err
+			}
+			pgs[i] = DBClusterParameterGroup{
+				Name:        *pg.DBClusterParameterGroupName,
+				Family:      *pg.DBParameterGroupFamily,
+				Description: aws.ToString(pg.Description),
+				Parameters:  convertParameters(parameters),
+				Tags:        convertTags(tags),
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return pgs, nil
+}
+
+// ListDBSubnetGroups はDBサブネットグループのリストを取得し、ドメインオブジェクトに変換します。
+func (s *RDSService) ListDBSubnetGroups(ctx context.Context, dbSubnetGroupName string) ([]DBSubnetGroup, error) {
+	var name *string
+	if dbSubnetGroupName != "" {
+		name = aws.String(dbSubnetGroupName)
+	}
+	awsGroups, err := s.repo.DescribeDBSubnetGroups(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]DBSubnetGroup, len(awsGroups))
+	var eg errgroup.Group
+	for i, g := range awsGroups {
+		i, g := i, g
+		eg.Go(func() error {
+			tags, err := s.repo.ListTagsForResource(ctx, g.DBSubnetGroupArn)
+			if err != nil {
+				return err
+			}
+			var subnetIDs []string
+			for _, subnet := range g.Subnets {
+				subnetIDs = append(subnetIDs, aws.ToString(subnet.SubnetIdentifier))
+			}
+			groups[i] = DBSubnetGroup{
+				Name:        *g.DBSubnetGroupName,
+				Description: aws.ToString(g.DBSubnetGroupDescription),
+				SubnetIDs:   subnetIDs,
+				Tags:        convertTags(tags),
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// ListOptionGroups はオプショングループのリストを取得し、ドメインオブジェクトに変換します。
+func (s *RDSService) ListOptionGroups(ctx context.Context, optionGroupName string) ([]OptionGroup, error) {
+	var name *string
+	if optionGroupName != "" {
+		name = aws.String(optionGroupName)
+	}
+	awsGroups, err := s.repo.DescribeOptionGroups(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]OptionGroup, len(awsGroups))
+	var eg errgroup.Group
+	for i, g := range awsGroups {
+		i, g := i, g
+		eg.Go(func() error {
+			tags, err := s.repo.ListTagsForResource(ctx, g.OptionGroupArn)
+			if err != nil {
+				return err
+			}
+			groups[i] = OptionGroup{
+				Name:               *g.OptionGroupName,
+				EngineName:         aws.ToString(g.EngineName),
+				MajorEngineVersion: aws.ToString(g.MajorEngineVersion),
+				Description:        aws.ToString(g.OptionGroupDescription),
+				Options:            convertOptions(g.Options),
+				Tags:               convertTags(tags),
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// ListDBProxies は名前に nameContains を含むRDS Proxyを、default ターゲットグループとターゲットとあわせて取得します。
+func (s *RDSService) ListDBProxies(ctx context.Context, nameContains string) ([]DBProxy, error) {
+	awsProxies, err := s.repo.DescribeDBProxies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredProxies []types.DBProxy
+	for _, p := range awsProxies {
+		if nameContains == "" || strings.Contains(*p.DBProxyName, nameContains) {
+			filteredProxies = append(filteredProxies, p)
+		}
+	}
+
+	proxies := make([]DBProxy, len(filteredProxies))
+	var eg errgroup.Group
+	for i, p := range filteredProxies {
+		i, p := i, p
+		eg.Go(func() error {
+			proxy, err := s.buildDBProxy(ctx, p)
+			if err != nil {
+				return err
+			}
+			proxies[i] = *proxy
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return proxies, nil
+}
+
+func (s *RDSService) buildDBProxy(ctx context.Context, p types.DBProxy) (*DBProxy, error) {
+	tags, err := s.repo.ListTagsForResource(ctx, p.DBProxyArn)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := &DBProxy{
+		Name:                *p.DBProxyName,
+		Arn:                 aws.ToString(p.DBProxyArn),
+		EngineFamily:        aws.ToString(p.EngineFamily),
+		RoleArn:             aws.ToString(p.RoleArn),
+		VpcSubnetIDs:        p.VpcSubnetIds,
+		VpcSecurityGroupIDs: p.VpcSecurityGroupIds,
+		RequireTLS:          aws.ToBool(p.RequireTLS),
+		DebugLogging:        aws.ToBool(p.DebugLogging),
+		IdleClientTimeout:   aws.ToInt32(p.IdleClientTimeout),
+		Tags:                convertTags(tags),
+	}
+	for _, a := range p.Auth {
+		proxy.Auth = append(proxy.Auth, DBProxyAuth{
+			AuthScheme:             string(a.AuthScheme),
+			ClientPasswordAuthType: string(a.ClientPasswordAuthType),
+			Description:            aws.ToString(a.Description),
+			IAMAuth:                string(a.IAMAuth),
+			SecretArn:              aws.ToString(a.SecretArn),
+			Username:               aws.ToString(a.UserName),
+		})
+	}
+
+	targetGroups, err := s.repo.DescribeDBProxyTargetGroups(ctx, proxy.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, tg := range targetGroups {
+		if !aws.ToBool(tg.IsDefault) {
+			continue
+		}
+		targetGroup := &DBProxyTargetGroup{Name: aws.ToString(tg.TargetGroupName)}
+		if c := tg.ConnectionPoolConfig; c != nil {
+			targetGroup.ConnectionBorrowTimeout = aws.ToInt32(c.ConnectionBorrowTimeout)
+			targetGroup.InitQuery = aws.ToString(c.InitQuery)
+			targetGroup.MaxConnectionsPercent = aws.ToInt32(c.MaxConnectionsPercent)
+			targetGroup.MaxIdleConnectionsPercent = aws.ToInt32(c.MaxIdleConnectionsPercent)
+			targetGroup.SessionPinningFilters = c.SessionPinningFilters
+		}
+		proxy.DefaultTargetGroup = targetGroup
+
+		targets, err := s.repo.DescribeDBProxyTargets(ctx, proxy.Name, targetGroup.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range targets {
+			// クラスタをターゲットにすると各メンバーのインスタンスも返されるため、クラスタ側のみを残します。
+			if t.TrackedClusterId != nil && t.Type == types.TargetTypeRdsInstance {
+				continue
+			}
+			proxy.Targets = append(proxy.Targets, DBProxyTarget{
+				TargetGroupName: targetGroup.Name,
+				Type:            string(t.Type),
+				RdsResourceID:   aws.ToString(t.RdsResourceId),
+			})
+		}
+	}
+
+	return proxy, nil
+}
+
+// ListGlobalClusters は識別子に identifierContains を含むグローバルクラスタを取得します。
+func (s *RDSService) ListGlobalClusters(ctx context.Context, identifierContains string) ([]GlobalCluster, error) {
+	awsGlobalClusters, err := s.repo.DescribeGlobalClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var globalClusters []GlobalCluster
+	for _, c := range awsGlobalClusters {
+		if identifierContains != "" && !strings.Contains(*c.GlobalClusterIdentifier, identifierContains) {
+			continue
+		}
+		var memberArns []string
+		for _, m := range c.GlobalClusterMembers {
+			memberArns = append(memberArns, aws.ToString(m.DBClusterArn))
+		}
+		globalClusters = append(globalClusters, GlobalCluster{
+			Identifier:         *c.GlobalClusterIdentifier,
+			Engine:             aws.ToString(c.Engine),
+			EngineVersion:      aws.ToString(c.EngineVersion),
+			DatabaseName:       aws.ToString(c.DatabaseName),
+			StorageEncrypted:   aws.ToBool(c.StorageEncrypted),
+			DeletionProtection: aws.ToBool(c.DeletionProtection),
+			MemberClusterArns:  memberArns,
+			Tags:               convertTags(c.TagList),
+		})
+	}
+	return globalClusters, nil
+}
+
+// ListEventSubscriptions は名前に nameContains を含むRDSイベントサブスクリプションを取得します。
+func (s *RDSService) ListEventSubscriptions(ctx context.Context, nameContains string) ([]EventSubscription, error) {
+	awsSubscriptions, err := s.repo.DescribeEventSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredSubscriptions []types.EventSubscription
+	for _, sub := range awsSubscriptions {
+		if nameContains == "" || strings.Contains(*sub.CustSubscriptionId, nameContains) {
+			filteredSubscriptions = append(filteredSubscriptions, sub)
+		}
+	}
+
+	subscriptions := make([]EventSubscription, len(filteredSubscriptions))
+	var eg errgroup.Group
+	for i, sub := range filteredSubscriptions {
+		i, sub := i, sub
+		eg.Go(func() error {
+			tags, err := s.repo.ListTagsForResource(ctx, sub.EventSubscriptionArn)
+			if err != nil {
+				return err
+			}
+			subscriptions[i] = EventSubscription{
+				Name:            *sub.CustSubscriptionId,
+				SnsTopicArn:     aws.ToString(sub.SnsTopicArn),
+				SourceType:      aws.ToString(sub.SourceType),
+				SourceIDs:       sub.SourceIdsList,
+				EventCategories: sub.EventCategoriesList,
+				Enabled:         aws.ToBool(sub.Enabled),
+				Tags:            convertTags(tags),
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// ListManualSnapshots は指定したクラスタとインスタンスの手動スナップショットを取得します。
+func (s *RDSService) ListManualSnapshots(ctx context.Context, clusterIdentifiers []string, instanceIdentifiers []string) ([]Snapshot, error) {
+	var snapshots []Snapshot
+	for _, id := range clusterIdentifiers {
+		awsSnapshots, err := s.repo.DescribeManualDBClusterSnapshots(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, sn := range awsSnapshots {
+			snapshots = append(snapshots, Snapshot{
+				SourceType:       "cluster",
+				SourceIdentifier: id,
+				Identifier:       aws.ToString(sn.DBClusterSnapshotIdentifier),
+				Arn:              aws.ToString(sn.DBClusterSnapshotArn),
+				CreateTime:       aws.ToTime(sn.SnapshotCreateTime),
+				Engine:           aws.ToString(sn.Engine),
+				EngineVersion:    aws.ToString(sn.EngineVersion),
+				Encrypted:        aws.ToBool(sn.StorageEncrypted),
+				KmsKeyID:         aws.ToString(sn.KmsKeyId),
+				AllocatedStorage: aws.ToInt32(sn.AllocatedStorage),
+				Status:           aws.ToString(sn.Status),
+			})
+		}
+	}
+	for _, id := range instanceIdentifiers {
+		awsSnapshots, err := s.repo.DescribeManualDBSnapshots(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, sn := range awsSnapshots {
+			snapshots = append(snapshots, Snapshot{
+				SourceType:       "instance",
+				SourceIdentifier: id,
+				Identifier:       aws.ToString(sn.DBSnapshotIdentifier),
+				Arn:              aws.ToString(sn.DBSnapshotArn),
+				CreateTime:       aws.ToTime(sn.SnapshotCreateTime),
+				Engine:           aws.ToString(sn.Engine),
+				EngineVersion:    aws.ToString(sn.EngineVersion),
+				Encrypted:        aws.ToBool(sn.Encrypted),
+				KmsKeyID:         aws.ToString(sn.KmsKeyId),
+				AllocatedStorage: aws.ToInt32(sn.AllocatedStorage),
+				Status:           aws.ToString(sn.Status),
+			})
+		}
+	}
+	return snapshots, nil
+}
+
+// convertParameters はパラメータを名前順に並べて変換します。
+func convertParameters(awsParameters []types.Parameter) []Parameter {
+	var parameters []Parameter
+	for _, p := range awsParameters {
+		parameters = append(parameters, Parameter{
+			Name:        aws.ToString(p.ParameterName),
+			Value:       aws.ToString(p.ParameterValue),
+			ApplyMethod: string(p.ApplyMethod),
+		})
+	}
+	sort.Slice(parameters, func(i, j int) bool {
+		return parameters[i].Name < parameters[j].Name
+	})
+	return parameters
+}
+
+func convertOptions(awsOptions []types.Option) []Option {
+	var options []Option
+	for _, o := range awsOptions {
+		option := Option{
+			Name:                aws.ToString(o.OptionName),
+			Port:                aws.ToInt32(o.Port),
+			Version:             aws.ToString(o.OptionVersion),
+			VpcSecurityGroupIDs: vpcSecurityGroupIDs(o.VpcSecurityGroupMemberships),
+		}
+		for _, setting := range o.OptionSettings {
+			if aws.ToString(setting.Value) == aws.ToString(setting.DefaultValue) {
+				continue
+			}
+			option.Settings = append(option.Settings, OptionSetting{
+				Name:  aws.ToString(setting.Name),
+				Value: aws.ToString(setting.Value),
+			})
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+func vpcSecurityGroupIDs(memberships []types.VpcSecurityGroupMembership) []string {
+	var ids []string
+	for _, m := range memberships {
+		ids = append(ids, aws.ToString(m.VpcSecurityGroupId))
+	}
+	return ids
+}
+
 func convertTags(tags []types.Tag) map[string]string {
 	m := make(map[string]string)
 	for _, t := range tags {
 		m[*t.Key] = *t.Value
 	}
 	return m
-} 
+}
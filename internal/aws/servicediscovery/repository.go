@@ -0,0 +1,78 @@
+package servicediscovery
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
+)
+
+// ServiceDiscoveryRepositoryInterface はCloud Mapリソースへのアクセスを抽象化します。
+type ServiceDiscoveryRepositoryInterface interface {
+	GetService(ctx context.Context, id string) (*types.Service, error)
+	GetNamespace(ctx context.Context, id string) (*types.Namespace, error)
+	ListTagsForResource(ctx context.Context, arn string) ([]types.Tag, error)
+	GetHostedZoneVpcIDs(ctx context.Context, hostedZoneID string) ([]string, error)
+}
+
+// ServiceDiscoveryRepository はServiceDiscoveryRepositoryInterfaceを実装します。
+// プライベートDNS名前空間のVPCはホストゾーンから取得するため、Route 53クライアントも保持します。
+type ServiceDiscoveryRepository struct {
+	client        *servicediscovery.Client
+	route53Client *route53.Client
+}
+
+// NewServiceDiscoveryRepository は新しいServiceDiscoveryRepositoryを生成します。
+func NewServiceDiscoveryRepository(client *servicediscovery.Client, route53Client *route53.Client) *ServiceDiscoveryRepository {
+	return &ServiceDiscoveryRepository{client: client, route53Client: route53Client}
+}
+
+// GetService はCloud Mapサービスを取得します。
+func (r *ServiceDiscoveryRepository) GetService(ctx context.Context, id string) (*types.Service, error) {
+	result, err := r.client.GetService(ctx, &servicediscovery.GetServiceInput{
+		Id: aws.String(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Service, nil
+}
+
+// GetNamespace はCloud Map名前空間を取得します。
+func (r *ServiceDiscoveryRepository) GetNamespace(ctx context.Context, id string) (*types.Namespace, error) {
+	result, err := r.client.GetNamespace(ctx, &servicediscovery.GetNamespaceInput{
+		Id: aws.String(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Namespace, nil
+}
+
+// ListTagsForResource はCloud Mapリソースのタグを取得します。
+func (r *ServiceDiscoveryRepository) ListTagsForResource(ctx context.Context, arn string) ([]types.Tag, error) {
+	result, err := r.client.ListTagsForResource(ctx, &servicediscovery.ListTagsForResourceInput{
+		ResourceARN: aws.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Tags, nil
+}
+
+// GetHostedZoneVpcIDs はプライベートホストゾーンに関連付けられたVPCのIDを取得します。
+func (r *ServiceDiscoveryRepository) GetHostedZoneVpcIDs(ctx context.Context, hostedZoneID string) ([]string, error) {
+	result, err := r.route53Client.GetHostedZone(ctx, &route53.GetHostedZoneInput{
+		Id: aws.String(hostedZoneID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var vpcIDs []string
+	for _, vpc := range result.VPCs {
+		vpcIDs = append(vpcIDs, aws.ToString(vpc.VPCId))
+	}
+	return vpcIDs, nil
+}
@@ -0,0 +1,188 @@
+package servicediscovery
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// --- Domain Models ---
+
+// Namespace はCloud Map名前空間を保持します。
+type Namespace struct {
+	ID          string
+	Arn         string
+	Name        string
+	Type        string
+	Description string
+	// VpcID はプライベートDNS名前空間のホストゾーンに関連付けられたVPCです。
+	VpcID string
+	Tags  map[string]string
+}
+
+// DnsRecord はCloud MapサービスのDNSレコード設定を保持します。
+type DnsRecord struct {
+	Type string
+	TTL  int64
+}
+
+// HealthCheckConfig はRoute 53ヘルスチェックの設定を保持します。
+type HealthCheckConfig struct {
+	Type             string
+	ResourcePath     string
+	FailureThreshold int32
+}
+
+// HealthCheckCustomConfig はカスタムヘルスチェックの設定を保持します。
+type HealthCheckCustomConfig struct {
+	FailureThreshold int32
+}
+
+// Service はCloud Mapサービスを保持します。
+type Service struct {
+	ID                      string
+	Arn                     string
+	Name                    string
+	Description             string
+	NamespaceID             string
+	RoutingPolicy           string
+	DnsRecords              []DnsRecord
+	HealthCheckConfig       *HealthCheckConfig
+	HealthCheckCustomConfig *HealthCheckCustomConfig
+	Tags                    map[string]string
+}
+
+// --- Service Interface and Implementation ---
+
+// ServiceInterface はCloud Map関連のビジネスロジックを定義します。
+type ServiceInterface interface {
+	ListServices(ctx context.Context, arns []string) ([]Service, error)
+	ListNamespaces(ctx context.Context, arns []string) ([]Namespace, error)
+}
+
+// ServiceDiscoveryService はServiceInterfaceを実装します。
+type ServiceDiscoveryService struct {
+	repo ServiceDiscoveryRepositoryInterface
+}
+
+// NewServiceDiscoveryService は新しいServiceDiscoveryServiceを生成します。
+func NewServiceDiscoveryService(repo ServiceDiscoveryRepositoryInterface) *ServiceDiscoveryService {
+	return &ServiceDiscoveryService{repo: repo}
+}
+
+// ListServices はARN(またはID)で指定されたCloud Mapサービスを取得します。
+func (s *ServiceDiscoveryService) ListServices(ctx context.Context, arns []string) ([]Service, error) {
+	services := make([]Service, len(arns))
+	var eg errgroup.Group
+	for i, arn := range arns {
+		i, arn := i, arn
+		eg.Go(func() error {
+			awsService, err := s.repo.GetService(ctx, ResourceID(arn))
+			if err != nil {
+				return err
+			}
+			tags, err := s.repo.ListTagsForResource(ctx, aws.ToString(awsService.Arn))
+			if err != nil {
+				return err
+			}
+			services[i] = convertService(awsService, tags)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// ListNamespaces はARN(またはID)で指定されたCloud Map名前空間を取得します。
+func (s *ServiceDiscoveryService) ListNamespaces(ctx context.Context, arns []string) ([]Namespace, error) {
+	namespaces := make([]Namespace, len(arns))
+	var eg errgroup.Group
+	for i, arn := range arns {
+		i, arn := i, arn
+		eg.Go(func() error {
+			ns, err := s.repo.GetNamespace(ctx, ResourceID(arn))
+			if err != nil {
+				return err
+			}
+			namespace := Namespace{
+				ID:          aws.ToString(ns.Id),
+				Arn:         aws.ToString(ns.Arn),
+				Name:        aws.ToString(ns.Name),
+				Type:        string(ns.Type),
+				Description: aws.ToString(ns.Description),
+				Tags:        make(map[string]string),
+			}
+			if ns.Type == types.NamespaceTypeDnsPrivate && ns.Properties != nil && ns.Properties.DnsProperties != nil {
+				vpcIDs, err := s.repo.GetHostedZoneVpcIDs(ctx, aws.ToString(ns.Properties.DnsProperties.HostedZoneId))
+				if err != nil {
+					return err
+				}
+				if len(vpcIDs) > 0 {
+					namespace.VpcID = vpcIDs[0]
+				}
+			}
+			tags, err := s.repo.ListTagsForResource(ctx, namespace.Arn)
+			if err != nil {
+				return err
+			}
+			for _, tag := range tags {
+				namespace.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			namespaces[i] = namespace
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// ResourceID はCloud MapのARNからリソースID(srv-xxx, ns-xxx)を取り出します。IDが渡された場合はそのまま返します。
+func ResourceID(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+func convertService(s *types.Service, tags []types.Tag) Service {
+	service := Service{
+		ID:          aws.ToString(s.Id),
+		Arn:         aws.ToString(s.Arn),
+		Name:        aws.ToString(s.Name),
+		Description: aws.ToString(s.Description),
+		NamespaceID: aws.ToString(s.NamespaceId),
+		Tags:        make(map[string]string),
+	}
+	if dc := s.DnsConfig; dc != nil {
+		service.RoutingPolicy = string(dc.RoutingPolicy)
+		if service.NamespaceID == "" {
+			service.NamespaceID = aws.ToString(dc.NamespaceId)
+		}
+		for _, record := range dc.DnsRecords {
+			service.DnsRecords = append(service.DnsRecords, DnsRecord{
+				Type: string(record.Type),
+				TTL:  aws.ToInt64(record.TTL),
+			})
+		}
+	}
+	if hc := s.HealthCheckConfig; hc != nil {
+		service.HealthCheckConfig = &HealthCheckConfig{
+			Type:             string(hc.Type),
+			ResourcePath:     aws.ToString(hc.ResourcePath),
+			FailureThreshold: aws.ToInt32(hc.FailureThreshold),
+		}
+	}
+	if hcc := s.HealthCheckCustomConfig; hcc != nil {
+		service.HealthCheckCustomConfig = &HealthCheckCustomConfig{
+			FailureThreshold: aws.ToInt32(hcc.FailureThreshold),
+		}
+	}
+	for _, tag := range tags {
+		service.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return service
+}
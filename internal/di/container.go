@@ -2,17 +2,28 @@
 package di
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
+	"path"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Haussmann000/tfimport/internal/aws"
+	"github.com/Haussmann000/tfimport/internal/aws/apigateway"
+	"github.com/Haussmann000/tfimport/internal/aws/apigatewayv2"
+	"github.com/Haussmann000/tfimport/internal/aws/applicationautoscaling"
+	"github.com/Haussmann000/tfimport/internal/aws/autoscaling"
 	"github.com/Haussmann000/tfimport/internal/aws/ec2"
 	"github.com/Haussmann000/tfimport/internal/aws/ecs"
 	"github.com/Haussmann000/tfimport/internal/aws/elbv2"
 	"github.com/Haussmann000/tfimport/internal/aws/iam"
+	"github.com/Haussmann000/tfimport/internal/aws/lambda"
 	"github.com/Haussmann000/tfimport/internal/aws/rds"
 	"github.com/Haussmann000/tfimport/internal/aws/s3"
+	"github.com/Haussmann000/tfimport/internal/aws/servicediscovery"
 	"github.com/Haussmann000/tfimport/internal/hcl"
 	"github.com/Haussmann000/tfimport/internal/writer"
 	"golang.org/x/sync/errgroup"
@@ -20,26 +31,72 @@ import (
 
 // RunOptions はコマンドラインから渡されるオプションを保持します。
 type RunOptions struct {
-	ResourceTypes        []string
-	ResourceName         string
-	BucketName           string
-	ClusterName          string
-	ServiceName          string
-	SecurityGroupID      string
-	DBClusterIdentifier  string
-	DBInstanceIdentifier string
+	ResourceTypes       []string
+	ResourceName        string
+	BucketName          string
+	ClusterName         string
+	ServiceName         string
+	SecurityGroupID     string
+	SecurityGroupInline bool
+	NetworkAclRules     bool
+	InstanceIDs         string
+	VpcID               string
+	// LaunchTemplateVersion は出力する起動テンプレートのバージョン("latest" または "default")です。
+	LaunchTemplateVersion string
+	DBClusterIdentifier   string
+	DBInstanceIdentifier  string
+	// TargetGroupAttachments が true の場合、target_group でターゲットの登録も出力します。
+	TargetGroupAttachments bool
+	// IamPolicyFormat はIAMポリシードキュメントの出力形式("string", "jsonencode", "policy_document")です。
+	IamPolicyFormat string
+	// IamServiceLinkedRoles はサービスにリンクされたロールの扱い("skip" または "emit")です。
+	IamServiceLinkedRoles string
+	// IamConcurrency は IAM API を同時に呼び出すワーカー数です。0 の場合は既定値を使います。
+	IamConcurrency int
+	// IamAuthorizationDetails が true の場合、ロールとポリシーを GetAccountAuthorizationDetails でまとめて取得します。
+	IamAuthorizationDetails bool
+	// LambdaCodeBucket が指定された場合、Lambda関数のコードを filename ではなくこのバケットの s3_key で参照します。
+	LambdaCodeBucket string
+	// ApiGatewayOpenAPIBody が true の場合、REST APIをOpenAPI定義の body として出力し、リソースツリーを個別に出力しません。
+	// body はステージにデプロイされた定義のため、デプロイされていない変更は apply 時の上書きで失われます。
+	ApiGatewayOpenAPIBody bool
+	// ApiGatewayExportStage は body としてエクスポートするステージの名前です。
+	// 空の場合は、すべてのステージが同じデプロイメントを参照しているREST APIに限りエクスポートします。
+	ApiGatewayExportStage string
 }
 
 // App はアプリケーションの主要なロジックをカプセル化します。
 type App struct {
-	s3Service  *s3.BucketService
-	ec2Service *ec2.EC2Service
-	ecsService *ecs.ECSService
-	elbService *elbv2.ELBV2Service
-	iamService *iam.IAMService
-	rdsService *rds.RDSService
-	writer     *writer.FileWriter
-	generator  *hcl.HCLGenerator
+	s3Service      *s3.BucketService
+	ec2Service     *ec2.EC2Service
+	ecsService     *ecs.ECSService
+	elbService     *elbv2.ELBV2Service
+	iamService     *iam.IAMService
+	rdsService     *rds.RDSService
+	asgService     *autoscaling.AutoScalingService
+	aasService     *applicationautoscaling.ApplicationAutoScalingService
+	sdService      *servicediscovery.ServiceDiscoveryService
+	lambdaService  *lambda.LambdaService
+	apigwService   *apigateway.APIGatewayService
+	apigwv2Service *apigatewayv2.APIGatewayV2Service
+	writer         *writer.FileWriter
+	generator      *hcl.HCLGenerator
+
+	// iamRoles は processIam が出力するIAMロールです。他のリソースからの参照にも使うため、一度だけ取得します。
+	iamRoles       []iam.Role
+	iamRolesLoaded bool
+
+	// vpcs は processVpc が出力するVPCです。VPC配下のリソースの重複出力を避けるため、一度だけ取得します。
+	vpcs       []ec2.Vpc
+	vpcsLoaded bool
+
+	// launchTemplates は processLaunchTemplate が出力する起動テンプレートです。Auto Scalingグループからの重複出力を避けるため、一度だけ取得します。
+	launchTemplates       []ec2.LaunchTemplate
+	launchTemplatesLoaded bool
+
+	// loadBalancers は processElb が出力するロードバランサーです。ターゲットグループの重複出力を避けるため、一度だけ取得します。
+	loadBalancers       []*elbv2.LoadBalancer
+	loadBalancersLoaded bool
 }
 
 // NewApp はAppのコンストラクタです。
@@ -50,27 +107,41 @@ func NewApp(
 	elbs *elbv2.ELBV2Service,
 	iams *iam.IAMService,
 	rdss *rds.RDSService,
+	asgs *autoscaling.AutoScalingService,
+	aass *applicationautoscaling.ApplicationAutoScalingService,
+	sds *servicediscovery.ServiceDiscoveryService,
+	lambdas *lambda.LambdaService,
+	apigws *apigateway.APIGatewayService,
+	apigwv2s *apigatewayv2.APIGatewayV2Service,
 	w *writer.FileWriter,
 	g *hcl.HCLGenerator,
 ) *App {
 	return &App{
-		s3Service:  s3s,
-		ec2Service: es,
-		ecsService: ecss,
-		elbService: elbs,
-		iamService: iams,
-		rdsService: rdss,
-		writer:     w,
-		generator:  g,
+		s3Service:      s3s,
+		ec2Service:     es,
+		ecsService:     ecss,
+		elbService:     elbs,
+		iamService:     iams,
+		rdsService:     rdss,
+		asgService:     asgs,
+		aasService:     aass,
+		sdService:      sds,
+		lambdaService:  lambdas,
+		apigwService:   apigws,
+		apigwv2Service: apigwv2s,
+		writer:         w,
+		generator:      g,
 	}
 }
 
 // Run はアプリケーションのメインの処理を実行します。
 func (a *App) Run(ctx context.Context, options RunOptions) error {
+	// IAM API の同時実行数はリソースタイプをまたいで共有するため、IAMService は1つだけ使います。
+	a.iamService = a.iamService.WithOptions(iamOptions(options))
 	for _, resourceType := range options.ResourceTypes {
 		switch resourceType {
 		case "vpc":
-			if err := a.processVpc(ctx, options.ResourceName); err != nil {
+			if err := a.processVpc(ctx, options.ResourceName, options.NetworkAclRules); err != nil {
 				return err
 			}
 		case "s3":
@@ -78,25 +149,105 @@ func (a *App) Run(ctx context.Context, options RunOptions) error {
 				return err
 			}
 		case "ecs":
-			if err := a.processEcs(ctx, options.ClusterName, options.ServiceName); err != nil {
+			if err := a.processEcs(ctx, options); err != nil {
 				return err
 			}
 		case "elbv2":
 			if err := a.processElb(ctx, options.ResourceName); err != nil {
 				return err
 			}
+		case "target_group":
+			if err := a.processTargetGroup(ctx, options); err != nil {
+				return err
+			}
+		case "classic_elb":
+			if err := a.processClassicElb(ctx, options.ResourceName); err != nil {
+				return err
+			}
 		case "iam":
-			if err := a.processIam(ctx, options.ResourceName); err != nil {
+			if err := a.processIam(ctx, options); err != nil {
+				return err
+			}
+		case "iam_provider":
+			if err := a.processIamProvider(ctx, options.ResourceName); err != nil {
+				return err
+			}
+		case "iam_user":
+			if err := a.processIamUser(ctx, options); err != nil {
+				return err
+			}
+		case "iam_group":
+			if err := a.processIamGroup(ctx, options); err != nil {
 				return err
 			}
 		case "security_group":
-			if err := a.processSecurityGroup(ctx, options.SecurityGroupID); err != nil {
+			if err := a.processSecurityGroup(ctx, options.SecurityGroupID, options.SecurityGroupInline); err != nil {
+				return err
+			}
+		case "ec2_instance":
+			if err := a.processEc2Instance(ctx, options); err != nil {
+				return err
+			}
+		case "nacl":
+			if err := a.processNetworkAcl(ctx, options); err != nil {
+				return err
+			}
+		case "dhcp_options":
+			if err := a.processDhcpOptions(ctx, options); err != nil {
+				return err
+			}
+		case "flow_log":
+			if err := a.processFlowLog(ctx, options); err != nil {
+				return err
+			}
+		case "vpc_endpoint":
+			if err := a.processVpcEndpoint(ctx, options.ResourceName); err != nil {
+				return err
+			}
+		case "vpc_peering":
+			if err := a.processVpcPeering(ctx, options.ResourceName); err != nil {
+				return err
+			}
+		case "transit_gateway":
+			if err := a.processTransitGateway(ctx, options.ResourceName); err != nil {
+				return err
+			}
+		case "launch_template":
+			if err := a.processLaunchTemplate(ctx, options); err != nil {
+				return err
+			}
+		case "autoscaling_group":
+			if err := a.processAutoScalingGroup(ctx, options); err != nil {
 				return err
 			}
 		case "rds":
 			if err := a.processRds(ctx, options); err != nil {
 				return err
 			}
+		case "rds_proxy":
+			if err := a.processRdsProxy(ctx, options.ResourceName); err != nil {
+				return err
+			}
+		case "rds_global_cluster":
+			if err := a.processRdsGlobalCluster(ctx, options.ResourceName); err != nil {
+				return err
+			}
+		case "rds_event_subscription":
+			if err := a.processRdsEventSubscription(ctx, options.ResourceName); err != nil {
+				return err
+			}
+		case "lambda":
+			if err := a.processLambda(ctx, options); err != nil {
+				return err
+			}
+		case "api_gateway":
+			if err := a.processApiGateway(ctx, options); err != nil {
+				return err
+			}
+		case "apigatewayv2":
+			if err := a.processApiGatewayV2(ctx, options.ResourceName); err != nil {
+				return err
+			}
 		default:
 			fmt.Printf("Unsupported resource type: %s\n", resourceType)
 		}
@@ -125,12 +276,12 @@ func (a *App) processS3(ctx context.Context, options RunOptions) error {
 	return a.writer.WriteFile("s3_import.tf", importFile)
 }
 
-func (a *App) processVpc(ctx context.Context, resourceName string) error {
-	vpcs, err := a.ec2Service.ListVpcs(ctx, resourceName)
+func (a *App) processVpc(ctx context.Context, resourceName string, networkAclRules bool) error {
+	vpcs, err := a.listVpcs(ctx, resourceName)
 	if err != nil {
 		return err
 	}
-	hclFile, importFile, err := a.generator.GenerateVpcBlocks(vpcs)
+	hclFile, importFile, err := a.generator.GenerateVpcBlocks(vpcs, networkAclRules)
 	if err != nil {
 		return err
 	}
@@ -141,237 +292,1183 @@ func (a *App) processVpc(ctx context.Context, resourceName string) error {
 	return a.writer.WriteFile("vpc_import.tf", importFile)
 }
 
-func (a *App) processEcs(ctx context.Context, clusterName, serviceName string) error {
-	var clusters []ecs.Cluster
-	if clusterName != "" {
-		cluster, err := a.ecsService.GetClusters(ctx, clusterName, serviceName)
-		if err != nil {
-			return err
-		}
-		if cluster != nil {
-			clusters = cluster
+// listVpcs は processVpc が出力するVPCを返します。
+func (a *App) listVpcs(ctx context.Context, resourceName string) ([]ec2.Vpc, error) {
+	if a.vpcsLoaded {
+		return a.vpcs, nil
+	}
+	vpcs, err := a.ec2Service.ListVpcs(ctx, resourceName)
+	if err != nil {
+		return nil, err
+	}
+	a.vpcs = vpcs
+	a.vpcsLoaded = true
+	return vpcs, nil
+}
+
+// vpcResources は vpc も指定されている場合に、processVpc が出力するVPCを返します。
+func (a *App) vpcResources(ctx context.Context, options RunOptions) ([]ec2.Vpc, error) {
+	if !containsResourceType(options.ResourceTypes, "vpc") {
+		return nil, nil
+	}
+	return a.listVpcs(ctx, options.ResourceName)
+}
+
+func (a *App) processEc2Instance(ctx context.Context, options RunOptions) error {
+	var instanceIDs []string
+	for _, id := range strings.Split(options.InstanceIDs, ",") {
+		if trimmedID := strings.TrimSpace(id); trimmedID != "" {
+			instanceIDs = append(instanceIDs, trimmedID)
 		}
 	}
 
-	hclFile, importFile, err := a.generator.GenerateEcsBlocks(clusters)
+	instances, err := a.ec2Service.ListInstances(ctx, instanceIDs, options.ResourceName, options.VpcID)
 	if err != nil {
 		return err
 	}
-	err = a.writer.WriteFile("ecs_generated.tf", hclFile)
+	hclFile, importFile, err := a.generator.GenerateEc2InstanceBlocks(instances)
 	if err != nil {
 		return err
 	}
-	return a.writer.WriteFile("ecs_import.tf", importFile)
-}
 
-func (a *App) processElb(ctx context.Context, resourceName string) error {
-	var lbs []*elbv2.LoadBalancer
-	var err error
-	if resourceName == "" {
-		lbs, err = a.elbService.ListLoadBalancers(ctx, resourceName)
-	} else {
-		var lb *elbv2.LoadBalancer
-		lb, err = a.elbService.GetLoadBalancer(ctx, resourceName)
-		if lb != nil {
-			lbs = append(lbs, lb)
+	for _, inst := range instances {
+		if inst.UserData == "" {
+			continue
+		}
+		if err := a.writer.WriteRawFile(a.generator.UserDataPath(inst.ID), []byte(inst.UserData)); err != nil {
+			return err
 		}
 	}
+
+	err = a.writer.WriteFile("ec2_instance_generated.tf", hclFile)
 	if err != nil {
 		return err
 	}
-	hclFile, importFile, err := a.generator.GenerateElbBlocks(lbs)
+	return a.writer.WriteFile("ec2_instance_import.tf", importFile)
+}
+
+func (a *App) processNetworkAcl(ctx context.Context, options RunOptions) error {
+	acls, err := a.ec2Service.ListNetworkAcls(ctx, options.ResourceName)
 	if err != nil {
 		return err
 	}
-	err = a.writer.WriteFile("elb_generated.tf", hclFile)
+	// vpc も指定されている場合、VPCに属するネットワークACLは vpc_generated.tf に出力されるため除外します。
+	vpcs, err := a.vpcResources(ctx, options)
 	if err != nil {
 		return err
 	}
-	return a.writer.WriteFile("elb_import.tf", importFile)
-}
-
-func (a *App) processIam(ctx context.Context, nameContains string) error {
-	var policies []iam.Policy
-	var roles []iam.Role
-	var eg errgroup.Group
-
-	eg.Go(func() error {
-		var err error
-		policies, err = a.iamService.ListPolicies(ctx, nameContains)
+	emitted := make(map[string]struct{})
+	for _, vpc := range vpcs {
+		for _, acl := range vpc.NetworkAcls {
+			emitted[acl.ID] = struct{}{}
+		}
+	}
+	var filtered []ec2.NetworkAcl
+	for _, acl := range acls {
+		if _, ok := emitted[acl.ID]; !ok {
+			filtered = append(filtered, acl)
+		}
+	}
+	hclFile, importFile, err := a.generator.GenerateNetworkAclBlocks(filtered, options.NetworkAclRules)
+	if err != nil {
 		return err
-	})
-
-	eg.Go(func() error {
-		var err error
-		roles, err = a.iamService.ListRoles(ctx, nameContains)
+	}
+	err = a.writer.WriteFile("nacl_generated.tf", hclFile)
+	if err != nil {
 		return err
-	})
+	}
+	return a.writer.WriteFile("nacl_import.tf", importFile)
+}
 
-	if err := eg.Wait(); err != nil {
+func (a *App) processDhcpOptions(ctx context.Context, options RunOptions) error {
+	dhcpOptions, err := a.ec2Service.ListDhcpOptions(ctx, options.ResourceName)
+	if err != nil {
 		return err
 	}
-
-	hclFile, importFile, err := a.generator.GenerateIamBlocks(policies, roles)
+	// vpc も指定されている場合、VPCに関連付けられたDHCPオプションセットとその関連付けは vpc_generated.tf に出力されるため、
+	// ここでは残りのVPCへの関連付けのみ出力します。
+	vpcs, err := a.vpcResources(ctx, options)
 	if err != nil {
 		return err
 	}
-
-	err = a.writer.WriteFile("iam_generated.tf", hclFile)
+	emitted := make(map[string]struct{})
+	associated := make(map[string]struct{})
+	for _, vpc := range vpcs {
+		if vpc.DhcpOptions != nil {
+			emitted[vpc.DhcpOptions.ID] = struct{}{}
+			associated[vpc.ID] = struct{}{}
+		}
+	}
+	for i, opts := range dhcpOptions {
+		var vpcIDs []string
+		for _, vpcID := range opts.VpcIDs {
+			if _, ok := associated[vpcID]; !ok {
+				vpcIDs = append(vpcIDs, vpcID)
+			}
+		}
+		dhcpOptions[i].VpcIDs = vpcIDs
+	}
+	hclFile, importFile, err := a.generator.GenerateDhcpOptionsBlocks(dhcpOptions, emitted)
 	if err != nil {
 		return err
 	}
-	return a.writer.WriteFile("iam_import.tf", importFile)
+	err = a.writer.WriteFile("dhcp_options_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("dhcp_options_import.tf", importFile)
 }
 
-func (a *App) processSecurityGroup(ctx context.Context, sgIDsStr string) error {
-	if sgIDsStr == "" {
-		return nil
+func (a *App) processFlowLog(ctx context.Context, options RunOptions) error {
+	flowLogs, err := a.ec2Service.ListFlowLogs(ctx, options.ResourceName)
+	if err != nil {
+		return err
 	}
-
-	sgIDs := strings.Split(sgIDsStr, ",")
-	var validSgIDs []string
-	for _, id := range sgIDs {
-		trimmedID := strings.TrimSpace(id)
-		if trimmedID != "" {
-			validSgIDs = append(validSgIDs, trimmedID)
+	// vpc も指定されている場合、VPCのフローログは vpc_generated.tf に出力されるため除外します。
+	vpcs, err := a.vpcResources(ctx, options)
+	if err != nil {
+		return err
+	}
+	emitted := make(map[string]struct{})
+	for _, vpc := range vpcs {
+		for _, fl := range vpc.FlowLogs {
+			emitted[fl.ID] = struct{}{}
 		}
 	}
-
-	if len(validSgIDs) == 0 {
-		return nil
+	var filtered []ec2.FlowLog
+	for _, fl := range flowLogs {
+		if _, ok := emitted[fl.ID]; !ok {
+			filtered = append(filtered, fl)
+		}
 	}
-
-	sgs, err := a.ec2Service.ListSecurityGroups(ctx, validSgIDs)
+	hclFile, importFile, err := a.generator.GenerateFlowLogBlocks(filtered)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("flow_log_generated.tf", hclFile)
 	if err != nil {
 		return err
 	}
+	return a.writer.WriteFile("flow_log_import.tf", importFile)
+}
 
-	if len(sgs) == 0 {
-		return nil
+func (a *App) processVpcEndpoint(ctx context.Context, resourceName string) error {
+	endpoints, err := a.ec2Service.ListVpcEndpoints(ctx, resourceName)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateVpcEndpointBlocks(endpoints)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("vpc_endpoint_generated.tf", hclFile)
+	if err != nil {
+		return err
 	}
+	return a.writer.WriteFile("vpc_endpoint_import.tf", importFile)
+}
 
-	hclFile, importFile, err := a.generator.GenerateSecurityGroupBlocks(sgs)
+func (a *App) processVpcPeering(ctx context.Context, resourceName string) error {
+	peerings, err := a.ec2Service.ListVpcPeeringConnections(ctx, resourceName)
 	if err != nil {
 		return err
 	}
-	err = a.writer.WriteFile("security_group_generated.tf", hclFile)
+	hclFile, importFile, err := a.generator.GenerateVpcPeeringBlocks(peerings)
 	if err != nil {
 		return err
 	}
-	return a.writer.WriteFile("security_group_import.tf", importFile)
+	err = a.writer.WriteFile("vpc_peering_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("vpc_peering_import.tf", importFile)
 }
 
-func (a *App) processRds(ctx context.Context, options RunOptions) error {
-	var clusters []rds.DBCluster
-	var instances []rds.DBInstance
-	var pgs []rds.DBParameterGroup
-	var err error
+func (a *App) processTransitGateway(ctx context.Context, resourceName string) error {
+	tgws, err := a.ec2Service.ListTransitGateways(ctx, resourceName)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateTransitGatewayBlocks(tgws)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("transit_gateway_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("transit_gateway_import.tf", importFile)
+}
 
-	// Case 1: Specific cluster identifier is provided.
-	if options.DBClusterIdentifier != "" {
-		clusters, err = a.rdsService.ListDBClusters(ctx, options.DBClusterIdentifier)
-		if err != nil {
-			return err
-		}
-		if len(clusters) == 0 {
-			fmt.Printf("No cluster found with identifier: %s\n", options.DBClusterIdentifier)
-			return nil
-		}
+func (a *App) processLaunchTemplate(ctx context.Context, options RunOptions) error {
+	templates, err := a.listLaunchTemplates(ctx, options)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateLaunchTemplateBlocks(templates)
+	if err != nil {
+		return err
+	}
+	if err := a.writeLaunchTemplateUserData(templates); err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("launch_template_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("launch_template_import.tf", importFile)
+}
 
-		pgNames := make(map[string]struct{})
-		instanceIdentifiers := make(map[string]struct{})
+// listLaunchTemplates は processLaunchTemplate が出力する起動テンプレートを返します。
+func (a *App) listLaunchTemplates(ctx context.Context, options RunOptions) ([]ec2.LaunchTemplate, error) {
+	if a.launchTemplatesLoaded {
+		return a.launchTemplates, nil
+	}
+	templates, err := a.ec2Service.ListLaunchTemplates(ctx, nil, options.ResourceName, options.LaunchTemplateVersion == "default")
+	if err != nil {
+		return nil, err
+	}
+	a.launchTemplates = templates
+	a.launchTemplatesLoaded = true
+	return templates, nil
+}
 
-		for _, c := range clusters {
-			if c.DBClusterParameterGroup != "" {
-				pgNames[c.DBClusterParameterGroup] = struct{}{}
-			}
-			for _, memberID := range c.MemberIdentifiers {
-				instanceIdentifiers[memberID] = struct{}{}
-			}
-		}
+// processAutoScalingGroup はAuto Scalingグループと、それらが参照する起動テンプレートをまとめて出力します。
+func (a *App) processAutoScalingGroup(ctx context.Context, options RunOptions) error {
+	asgs, err := a.asgService.ListAutoScalingGroups(ctx, options.ResourceName)
+	if err != nil {
+		return err
+	}
 
-		for id := range instanceIdentifiers {
-			memberInstances, err := a.rdsService.ListDBInstances(ctx, id)
-			if err != nil {
-				return err
-			}
-			instances = append(instances, memberInstances...)
+	var templateIDs []string
+	seen := make(map[string]struct{})
+	addTemplateID := func(id string) {
+		if id == "" {
+			return
 		}
-
-		for _, inst := range instances {
-			for _, pgName := range inst.DBParameterGroups {
-				pgNames[pgName] = struct{}{}
-			}
+		if _, ok := seen[id]; ok {
+			return
 		}
-
-		for name := range pgNames {
-			paramGroups, err := a.rdsService.ListDBParameterGroups(ctx, name)
-			if err != nil {
-				return err
+		seen[id] = struct{}{}
+		templateIDs = append(templateIDs, id)
+	}
+	for _, asg := range asgs {
+		addTemplateID(asg.LaunchTemplateID)
+		if mip := asg.MixedInstancesPolicy; mip != nil {
+			addTemplateID(mip.LaunchTemplate.ID)
+			for _, o := range mip.Overrides {
+				if o.LaunchTemplate != nil {
+					addTemplateID(o.LaunchTemplate.ID)
+				}
 			}
-			pgs = append(pgs, paramGroups...)
 		}
+	}
 
-	// Case 2: Specific instance identifier is provided (but not cluster).
-	} else if options.DBInstanceIdentifier != "" {
-		instances, err = a.rdsService.ListDBInstances(ctx, options.DBInstanceIdentifier)
+	// launch_template も指定されている場合、その起動テンプレートは launch_template_generated.tf に出力されるため、
+	// ここではリソースを出力せず参照のみにします。
+	emitted := make(map[string]struct{})
+	if containsResourceType(options.ResourceTypes, "launch_template") {
+		ltTemplates, err := a.listLaunchTemplates(ctx, options)
 		if err != nil {
 			return err
 		}
-
-		pgNames := make(map[string]struct{})
-		for _, inst := range instances {
-			for _, pgName := range inst.DBParameterGroups {
-				pgNames[pgName] = struct{}{}
-			}
-		}
-		for name := range pgNames {
-			paramGroups, err := a.rdsService.ListDBParameterGroups(ctx, name)
-			if err != nil {
-				return err
-			}
-			pgs = append(pgs, paramGroups...)
+		for _, lt := range ltTemplates {
+			emitted[lt.ID] = struct{}{}
 		}
-	
-	// Case 3: No specific identifier, fetch all.
-	} else {
-		var eg errgroup.Group
-
-		eg.Go(func() error {
-			var err error
-			clusters, err = a.rdsService.ListDBClusters(ctx, "")
-			return err
-		})
-
-		eg.Go(func() error {
-			var err error
-			instances, err = a.rdsService.ListDBInstances(ctx, "")
-			return err
-		})
-
-		eg.Go(func() error {
-			var err error
-			pgs, err = a.rdsService.ListDBParameterGroups(ctx, options.ResourceName)
-			return err
-		})
+	}
 
-		if err := eg.Wait(); err != nil {
+	var templates []ec2.LaunchTemplate
+	if len(templateIDs) > 0 {
+		templates, err = a.ec2Service.ListLaunchTemplates(ctx, templateIDs, "", options.LaunchTemplateVersion == "default")
+		if err != nil {
 			return err
 		}
 	}
 
-	hclFile, importFile, err := a.generator.GenerateRdsBlocks(clusters, instances, pgs)
+	hclFile, importFile, err := a.generator.GenerateAutoScalingBlocks(asgs, templates, emitted)
 	if err != nil {
 		return err
 	}
-
-	err = a.writer.WriteFile("rds_generated.tf", hclFile)
+	var userDataTemplates []ec2.LaunchTemplate
+	for _, lt := range templates {
+		if _, ok := emitted[lt.ID]; !ok {
+			userDataTemplates = append(userDataTemplates, lt)
+		}
+	}
+	if err := a.writeLaunchTemplateUserData(userDataTemplates); err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("autoscaling_group_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("autoscaling_group_import.tf", importFile)
+}
+
+func (a *App) writeLaunchTemplateUserData(templates []ec2.LaunchTemplate) error {
+	for _, lt := range templates {
+		if lt.UserData == "" {
+			continue
+		}
+		if err := a.writer.WriteRawFile(a.generator.UserDataPath(lt.ID), []byte(lt.UserData)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *App) processEcs(ctx context.Context, options RunOptions) error {
+	clusters, err := a.ecsService.GetClusters(ctx, options.ClusterName, options.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	// 同じ実行でIAMロールやAuto Scalingグループも出力する場合は、それらへの参照にします。
+	roleRefs, err := a.iamRoleReferences(ctx, options)
+	if err != nil {
+		return err
+	}
+	refs := hcl.EcsReferences{
+		Roles:             roleRefs,
+		AutoScalingGroups: make(map[string]struct{}),
+	}
+	for _, c := range clusters {
+		if containsResourceType(options.ResourceTypes, "autoscaling_group") {
+			for _, cp := range c.CapacityProviderDetails {
+				if options.ResourceName == "" || cp.AutoScalingGroupName == options.ResourceName {
+					refs.AutoScalingGroups[cp.AutoScalingGroupName] = struct{}{}
+				}
+			}
+		}
+	}
+
+	related, err := a.listEcsRelatedResources(ctx, clusters)
+	if err != nil {
+		return err
+	}
+
+	hclFile, importFile, err := a.generator.GenerateEcsBlocks(clusters, refs, related)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("ecs_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("ecs_import.tf", importFile)
+}
+
+func (a *App) processElb(ctx context.Context, resourceName string) error {
+	lbs, err := a.listLoadBalancers(ctx, resourceName)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateElbBlocks(lbs)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("elb_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("elb_import.tf", importFile)
+}
+
+// listLoadBalancers は processElb が出力するロードバランサーを返します。
+func (a *App) listLoadBalancers(ctx context.Context, resourceName string) ([]*elbv2.LoadBalancer, error) {
+	if a.loadBalancersLoaded {
+		return a.loadBalancers, nil
+	}
+	var lbs []*elbv2.LoadBalancer
+	var err error
+	if resourceName == "" {
+		lbs, err = a.elbService.ListLoadBalancers(ctx, resourceName)
+	} else {
+		var lb *elbv2.LoadBalancer
+		lb, err = a.elbService.GetLoadBalancer(ctx, resourceName)
+		if lb != nil {
+			lbs = append(lbs, lb)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.loadBalancers = lbs
+	a.loadBalancersLoaded = true
+	return lbs, nil
+}
+
+func (a *App) processClassicElb(ctx context.Context, resourceName string) error {
+	lbs, err := a.elbService.ListClassicLoadBalancers(ctx, resourceName)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateClassicElbBlocks(lbs)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("classic_elb_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("classic_elb_import.tf", importFile)
+}
+
+func (a *App) processTargetGroup(ctx context.Context, options RunOptions) error {
+	tgs, err := a.elbService.ListTargetGroups(ctx, options.ResourceName, options.TargetGroupAttachments)
+	if err != nil {
+		return err
+	}
+	// elbv2 も指定されている場合、ロードバランサーに紐づくターゲットグループは elb_generated.tf に出力されるため、
+	// ここではリソースを出力せず、登録済みターゲットのみ出力します。
+	emitted := make(map[string]struct{})
+	if containsResourceType(options.ResourceTypes, "elbv2") {
+		lbs, err := a.listLoadBalancers(ctx, options.ResourceName)
+		if err != nil {
+			return err
+		}
+		for _, lb := range lbs {
+			for _, tg := range lb.TargetGroups {
+				emitted[tg.Arn] = struct{}{}
+			}
+		}
+	}
+	hclFile, importFile, err := a.generator.GenerateTargetGroupBlocks(tgs, emitted)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("target_group_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("target_group_import.tf", importFile)
+}
+
+func (a *App) processIam(ctx context.Context, options RunOptions) error {
+	var policies []iam.Policy
+	var roles []iam.Role
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		var err error
+		policies, err = a.iamService.ListPolicies(ctx, options.ResourceName)
+		return err
+	})
+
+	eg.Go(func() error {
+		var err error
+		roles, err = a.listIamRoles(ctx, options)
+		return err
+	})
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	hclFile, importFile, err := a.generator.GenerateIamBlocks(policies, roles, hcl.PolicyFormat(options.IamPolicyFormat))
+	if err != nil {
+		return err
+	}
+
+	err = a.writer.WriteFile("iam_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("iam_import.tf", importFile)
+}
+
+// listIamRoles は processIam が出力するIAMロールを返します。
+// サービスにリンクされたロールは aws_iam_role として管理できないため、指定がなければ除外します。
+func (a *App) listIamRoles(ctx context.Context, options RunOptions) ([]iam.Role, error) {
+	if a.iamRolesLoaded {
+		return a.iamRoles, nil
+	}
+	roles, err := a.iamService.ListRoles(ctx, options.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	if options.IamServiceLinkedRoles != "emit" {
+		var filtered []iam.Role
+		for _, r := range roles {
+			if !r.ServiceLinked {
+				filtered = append(filtered, r)
+			}
+		}
+		roles = filtered
+	}
+	a.iamRoles = roles
+	a.iamRolesLoaded = true
+	return roles, nil
+}
+
+// iamRoleReferences は同じ実行でIAMロールも出力する場合に、出力される aws_iam_role のARNからロール名への対応を返します。
+// aws_iam_service_linked_role として出力されるロールは含めません。
+func (a *App) iamRoleReferences(ctx context.Context, options RunOptions) (map[string]string, error) {
+	refs := make(map[string]string)
+	if !containsResourceType(options.ResourceTypes, "iam") {
+		return refs, nil
+	}
+	roles, err := a.listIamRoles(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		if !r.ServiceLinked {
+			refs[r.Arn] = r.Name
+		}
+	}
+	return refs, nil
+}
+
+// iamOptions は RunOptions から IAM リソース取得時のオプションを組み立てます。
+func iamOptions(options RunOptions) iam.Options {
+	return iam.Options{
+		Concurrency:             options.IamConcurrency,
+		UseAuthorizationDetails: options.IamAuthorizationDetails,
+	}
+}
+
+func (a *App) processIamProvider(ctx context.Context, nameContains string) error {
+	var oidcProviders []iam.OpenIDConnectProvider
+	var samlProviders []iam.SAMLProvider
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		var err error
+		oidcProviders, err = a.iamService.ListOpenIDConnectProviders(ctx, nameContains)
+		return err
+	})
+
+	eg.Go(func() error {
+		var err error
+		samlProviders, err = a.iamService.ListSAMLProviders(ctx, nameContains)
+		return err
+	})
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	hclFile, importFile, err := a.generator.GenerateIamProviderBlocks(oidcProviders, samlProviders)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range samlProviders {
+		if err := a.writer.WriteRawFile(a.generator.SamlMetadataPath(p.Name), []byte(p.SAMLMetadataDocument)); err != nil {
+			return err
+		}
+	}
+
+	err = a.writer.WriteFile("iam_provider_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("iam_provider_import.tf", importFile)
+}
+
+func (a *App) processSecurityGroup(ctx context.Context, sgIDsStr string, inlineRules bool) error {
+	if sgIDsStr == "" {
+		return nil
+	}
+
+	sgIDs := strings.Split(sgIDsStr, ",")
+	var validSgIDs []string
+	for _, id := range sgIDs {
+		trimmedID := strings.TrimSpace(id)
+		if trimmedID != "" {
+			validSgIDs = append(validSgIDs, trimmedID)
+		}
+	}
+
+	if len(validSgIDs) == 0 {
+		return nil
+	}
+
+	sgs, err := a.ec2Service.ListSecurityGroups(ctx, validSgIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(sgs) == 0 {
+		return nil
+	}
+
+	hclFile, importFile, err := a.generator.GenerateSecurityGroupBlocks(sgs, inlineRules)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("security_group_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("security_group_import.tf", importFile)
+}
+
+func (a *App) processRds(ctx context.Context, options RunOptions) error {
+	var clusters []rds.DBCluster
+	var instances []rds.DBInstance
+	var pgs []rds.DBParameterGroup
+	var err error
+
+	// Case 1: Specific cluster identifier is provided.
+	if options.DBClusterIdentifier != "" {
+		clusters, err = a.rdsService.ListDBClusters(ctx, options.DBClusterIdentifier)
+		if err != nil {
+			return err
+		}
+		if len(clusters) == 0 {
+			fmt.Printf("No cluster found with identifier: %s\n", options.DBClusterIdentifier)
+			return nil
+		}
+
+		instanceIdentifiers := make(map[string]struct{})
+		for _, c := range clusters {
+			for _, memberID := range c.MemberIdentifiers {
+				instanceIdentifiers[memberID] = struct{}{}
+			}
+		}
+
+		for id := range instanceIdentifiers {
+			memberInstances, err := a.rdsService.ListDBInstances(ctx, id)
+			if err != nil {
+				return err
+			}
+			instances = append(instances, memberInstances...)
+		}
+
+		pgs, err = a.listRdsParameterGroups(ctx, instances)
+		if err != nil {
+			return err
+		}
+
+		// Case 2: Specific instance identifier is provided (but not cluster).
+	} else if options.DBInstanceIdentifier != "" {
+		instances, err = a.rdsService.ListDBInstances(ctx, options.DBInstanceIdentifier)
+		if err != nil {
+			return err
+		}
+
+		pgs, err = a.listRdsParameterGroups(ctx, instances)
+		if err != nil {
+			return err
+		}
+
+		// Case 3: No specific identifier, fetch all.
+	} else {
+		var eg errgroup.Group
+
+		eg.Go(func() error {
+			var err error
+			clusters, err = a.rdsService.ListDBClusters(ctx, "")
+			return err
+		})
+
+		eg.Go(func() error {
+			var err error
+			instances, err = a.rdsService.ListDBInstances(ctx, "")
+			return err
+		})
+
+		eg.Go(func() error {
+			allPgs, err := a.rdsService.ListDBParameterGroups(ctx, options.ResourceName)
+			if err != nil {
+				return err
+			}
+			for _, pg := range allPgs {
+				if !isDefaultRdsGroup(pg.Name) {
+					pgs = append(pgs, pg)
+				}
+			}
+			return nil
+		})
+
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+	}
+
+	related, err := a.listRdsRelatedResources(ctx, clusters, instances)
+	if err != nil {
+		return err
+	}
+
+	hclFile, importFile, err := a.generator.GenerateRdsBlocks(clusters, instances, pgs, related)
+	if err != nil {
+		return err
+	}
+
+	var clusterIdentifiers, instanceIdentifiers []string
+	for _, c := range clusters {
+		clusterIdentifiers = append(clusterIdentifiers, c.Identifier)
+	}
+	for _, inst := range instances {
+		// クラスタのメンバーはインスタンス単位のスナップショットを持たないため対象外です。
+		if inst.DBClusterIdentifier == "" {
+			instanceIdentifiers = append(instanceIdentifiers, inst.Identifier)
+		}
+	}
+	snapshots, err := a.rdsService.ListManualSnapshots(ctx, clusterIdentifiers, instanceIdentifiers)
+	if err != nil {
+		return err
+	}
+	if err := a.writeRdsSnapshotReport(snapshots); err != nil {
+		return err
+	}
+
+	err = a.writer.WriteFile("rds_generated.tf", hclFile)
 	if err != nil {
 		return err
 	}
 	return a.writer.WriteFile("rds_import.tf", importFile)
 }
 
+// writeRdsSnapshotReport は取り込んだクラスタ・インスタンスの手動スナップショットをCSVで出力します。
+// スナップショットは Terraform で管理しないため、削除や移行の判断材料としてのみ使います。
+func (a *App) writeRdsSnapshotReport(snapshots []rds.Snapshot) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"source_type", "source_identifier", "snapshot_identifier", "snapshot_arn", "create_time", "engine", "engine_version", "encrypted", "kms_key_id", "allocated_storage", "status"}); err != nil {
+		return err
+	}
+	for _, sn := range snapshots {
+		record := []string{sn.SourceType, sn.SourceIdentifier, sn.Identifier, sn.Arn, sn.CreateTime.Format(time.RFC3339), sn.Engine, sn.EngineVersion, strconv.FormatBool(sn.Encrypted), sn.KmsKeyID, strconv.Itoa(int(sn.AllocatedStorage)), sn.Status}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return a.writer.WriteRawFile("rds_snapshot_report.csv", buf.Bytes())
+}
+
+func (a *App) processRdsProxy(ctx context.Context, nameContains string) error {
+	proxies, err := a.rdsService.ListDBProxies(ctx, nameContains)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateRdsProxyBlocks(proxies)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("rds_proxy_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("rds_proxy_import.tf", importFile)
+}
+
+func (a *App) processRdsGlobalCluster(ctx context.Context, identifierContains string) error {
+	globalClusters, err := a.rdsService.ListGlobalClusters(ctx, identifierContains)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateRdsGlobalClusterBlocks(globalClusters)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("rds_global_cluster_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("rds_global_cluster_import.tf", importFile)
+}
+
+func (a *App) processRdsEventSubscription(ctx context.Context, nameContains string) error {
+	subscriptions, err := a.rdsService.ListEventSubscriptions(ctx, nameContains)
+	if err != nil {
+		return err
+	}
+	hclFile, importFile, err := a.generator.GenerateRdsEventSubscriptionBlocks(subscriptions)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("rds_event_subscription_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("rds_event_subscription_import.tf", importFile)
+}
+
+// isDefaultRdsGroup は AWS が管理するデフォルトのパラメータグループ・オプショングループ・サブネットグループかどうかを判定します。
+// デフォルトのグループは変更できないため、リソースとしては出力せず名前のまま参照します。
+func isDefaultRdsGroup(name string) bool {
+	return name == "default" || strings.HasPrefix(name, "default.") || strings.HasPrefix(name, "default:")
+}
+
+// listRdsParameterGroups はインスタンスが使うDBパラメータグループを取得します。
+func (a *App) listRdsParameterGroups(ctx context.Context, instances []rds.DBInstance) ([]rds.DBParameterGroup, error) {
+	pgNames := make(map[string]struct{})
+	for _, inst := range instances {
+		for _, pgName := range inst.DBParameterGroups {
+			if !isDefaultRdsGroup(pgName) {
+				pgNames[pgName] = struct{}{}
+			}
+		}
+	}
+
+	var pgs []rds.DBParameterGroup
+	for name := range pgNames {
+		paramGroups, err := a.rdsService.ListDBParameterGroups(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		pgs = append(pgs, paramGroups...)
+	}
+	return pgs, nil
+}
+
+// listRdsRelatedResources はクラスタ・インスタンスが参照するクラスタパラメータグループ、
+// サブネットグループ、オプショングループを取得します。
+func (a *App) listRdsRelatedResources(ctx context.Context, clusters []rds.DBCluster, instances []rds.DBInstance) (hcl.RdsRelatedResources, error) {
+	var related hcl.RdsRelatedResources
+	clusterPgNames := make(map[string]struct{})
+	subnetGroupNames := make(map[string]struct{})
+	optionGroupNames := make(map[string]struct{})
+
+	for _, c := range clusters {
+		if c.DBClusterParameterGroup != "" && !isDefaultRdsGroup(c.DBClusterParameterGroup) {
+			clusterPgNames[c.DBClusterParameterGroup] = struct{}{}
+		}
+		if c.DBSubnetGroupName != "" && !isDefaultRdsGroup(c.DBSubnetGroupName) {
+			subnetGroupNames[c.DBSubnetGroupName] = struct{}{}
+		}
+	}
+	for _, inst := range instances {
+		if inst.DBSubnetGroupName != "" && !isDefaultRdsGroup(inst.DBSubnetGroupName) {
+			subnetGroupNames[inst.DBSubnetGroupName] = struct{}{}
+		}
+		for _, name := range inst.OptionGroupNames {
+			if !isDefaultRdsGroup(name) {
+				optionGroupNames[name] = struct{}{}
+			}
+		}
+	}
+
+	for name := range clusterPgNames {
+		pgs, err := a.rdsService.ListDBClusterParameterGroups(ctx, name)
+		if err != nil {
+			return related, err
+		}
+		related.ClusterParameterGroups = append(related.ClusterParameterGroups, pgs...)
+	}
+	for name := range subnetGroupNames {
+		groups, err := a.rdsService.ListDBSubnetGroups(ctx, name)
+		if err != nil {
+			return related, err
+		}
+		related.SubnetGroups = append(related.SubnetGroups, groups...)
+	}
+	for name := range optionGroupNames {
+		groups, err := a.rdsService.ListOptionGroups(ctx, name)
+		if err != nil {
+			return related, err
+		}
+		related.OptionGroups = append(related.OptionGroups, groups...)
+	}
+	return related, nil
+}
+
+// listEcsRelatedResources はECSサービスのオートスケーリング設定と、
+// サービス検出・Service Connectで参照されるCloud Mapリソースを取得します。
+func (a *App) listEcsRelatedResources(ctx context.Context, clusters []ecs.Cluster) (hcl.EcsRelatedResources, error) {
+	var related hcl.EcsRelatedResources
+	var resourceIDs, registryArns, namespaceIDs []string
+	seenRegistries := make(map[string]struct{})
+	seenNamespaces := make(map[string]struct{})
+	// 名前空間はARNとIDの両方で参照されるため、IDで重複を除きます。名前で指定されたものは取得できないため対象外です。
+	addNamespace := func(namespace string) {
+		id := servicediscovery.ResourceID(namespace)
+		if !strings.HasPrefix(id, "ns-") {
+			return
+		}
+		if _, ok := seenNamespaces[id]; ok {
+			return
+		}
+		seenNamespaces[id] = struct{}{}
+		namespaceIDs = append(namespaceIDs, id)
+	}
+
+	for _, c := range clusters {
+		addNamespace(c.ServiceConnectNamespace)
+		for _, svc := range c.Services {
+			resourceIDs = append(resourceIDs, ecs.ServiceResourceID(c.Name, svc.Name))
+			for _, sr := range svc.ServiceRegistries {
+				if _, ok := seenRegistries[sr.RegistryArn]; !ok {
+					seenRegistries[sr.RegistryArn] = struct{}{}
+					registryArns = append(registryArns, sr.RegistryArn)
+				}
+			}
+			if sc := svc.ServiceConnectConfiguration; sc != nil {
+				addNamespace(sc.Namespace)
+			}
+		}
+	}
+
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		var err error
+		related.ScalableTargets, err = a.aasService.ListScalableTargets(ctx, "ecs", resourceIDs)
+		return err
+	})
+
+	eg.Go(func() error {
+		var err error
+		related.DiscoveryServices, err = a.sdService.ListServices(ctx, registryArns)
+		if err != nil {
+			return err
+		}
+		// サービスが属する名前空間も合わせて出力します。
+		for _, svc := range related.DiscoveryServices {
+			addNamespace(svc.NamespaceID)
+		}
+		related.Namespaces, err = a.sdService.ListNamespaces(ctx, namespaceIDs)
+		return err
+	})
+
+	if err := eg.Wait(); err != nil {
+		return hcl.EcsRelatedResources{}, err
+	}
+	return related, nil
+}
+
+func (a *App) processIamUser(ctx context.Context, options RunOptions) error {
+	users, err := a.iamService.ListUsers(ctx, options.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	refs := hcl.IamReferences{
+		Policies: make(map[string]struct{}),
+		Groups:   make(map[string]struct{}),
+	}
+	for _, u := range users {
+		a.addIamPolicyReferences(refs, options, u.AttachedPolicyArns)
+		if containsResourceType(options.ResourceTypes, "iam_group") {
+			for _, name := range u.GroupNames {
+				if strings.Contains(name, options.ResourceName) {
+					refs.Groups[name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	hclFile, importFile, err := a.generator.GenerateIamUserBlocks(users, refs)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("iam_user_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("iam_user_import.tf", importFile)
+	if err != nil {
+		return err
+	}
+	return a.writeAccessKeyReport(users)
+}
+
+func (a *App) processIamGroup(ctx context.Context, options RunOptions) error {
+	groups, err := a.iamService.ListGroups(ctx, options.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	refs := hcl.IamReferences{
+		Policies: make(map[string]struct{}),
+		Users:    make(map[string]struct{}),
+	}
+	for _, gr := range groups {
+		a.addIamPolicyReferences(refs, options, gr.AttachedPolicyArns)
+		if containsResourceType(options.ResourceTypes, "iam_user") {
+			for _, name := range gr.MemberNames {
+				if strings.Contains(name, options.ResourceName) {
+					refs.Users[name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	// iam_user も出力する場合、所属は aws_iam_user_group_membership で表現します。
+	exclusiveMembership := !containsResourceType(options.ResourceTypes, "iam_user")
+	hclFile, importFile, err := a.generator.GenerateIamGroupBlocks(groups, refs, exclusiveMembership)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("iam_group_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("iam_group_import.tf", importFile)
+}
+
+// addIamPolicyReferences は同じ実行で iam として出力されるカスタマー管理ポリシーを参照先として登録します。
+func (a *App) addIamPolicyReferences(refs hcl.IamReferences, options RunOptions, policyArns []string) {
+	if !containsResourceType(options.ResourceTypes, "iam") {
+		return
+	}
+	for _, policyArn := range policyArns {
+		// AWS 管理ポリシー (arn:aws:iam::aws:policy/...) は出力対象になりません。
+		if strings.Contains(policyArn, ":aws:policy/") {
+			continue
+		}
+		if strings.Contains(path.Base(policyArn), options.ResourceName) {
+			refs.Policies[policyArn] = struct{}{}
+		}
+	}
+}
+
+// writeAccessKeyReport はユーザーごとのアクセスキーを CSV のレポートとして出力します。
+// アクセスキーは Terraform で管理するとシークレットが state に残るため、リソースとしては出力しません。
+func (a *App) writeAccessKeyReport(users []iam.User) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"user_name", "access_key_id", "status", "create_date", "last_used_date", "last_used_service", "last_used_region"}); err != nil {
+		return err
+	}
+	for _, u := range users {
+		for _, k := range u.AccessKeys {
+			lastUsedDate := ""
+			if k.LastUsedDate != nil {
+				lastUsedDate = k.LastUsedDate.Format(time.RFC3339)
+			}
+			record := []string{u.Name, k.ID, k.Status, k.CreateDate.Format(time.RFC3339), lastUsedDate, k.LastUsedService, k.LastUsedRegion}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return a.writer.WriteRawFile("iam_access_key_report.csv", buf.Bytes())
+}
+
+func (a *App) processLambda(ctx context.Context, options RunOptions) error {
+	functions, err := a.lambdaService.ListFunctions(ctx, options.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	// 同じ実行でIAMロールも出力する場合は、実行ロールへの参照にします。
+	roleRefs, err := a.iamRoleReferences(ctx, options)
+	if err != nil {
+		return err
+	}
+	refs := hcl.LambdaReferences{Roles: roleRefs}
+
+	// aws_lambda_permission で表現できないステートメントは出力せず、手動で管理できるよう通知します。
+	for _, fn := range functions {
+		for _, p := range fn.SkippedPermissions {
+			target := fn.Name
+			if p.Qualifier != "" {
+				target += ":" + p.Qualifier
+			}
+			fmt.Printf("Skipped permission %s of lambda function %s: %s\n", p.StatementID, target, p.Reason)
+		}
+	}
+
+	hclFile, importFile, err := a.generator.GenerateLambdaBlocks(functions, refs, options.LambdaCodeBucket)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("lambda_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("lambda_import.tf", importFile)
+}
+
+func (a *App) processApiGateway(ctx context.Context, options RunOptions) error {
+	apis, err := a.apigwService.ListRestAPIs(ctx, options.ResourceName, options.ApiGatewayOpenAPIBody, options.ApiGatewayExportStage)
+	if err != nil {
+		return err
+	}
+
+	var apiIDs []string
+	for _, api := range apis {
+		apiIDs = append(apiIDs, api.ID)
+	}
+	var related hcl.ApiGatewayRelatedResources
+	related.UsagePlans, err = a.apigwService.ListUsagePlans(ctx, apiIDs)
+	if err != nil {
+		return err
+	}
+	var keyIDs []string
+	for _, plan := range related.UsagePlans {
+		for _, k := range plan.Keys {
+			keyIDs = append(keyIDs, k.ID)
+		}
+	}
+	related.ApiKeys, err = a.apigwService.ListApiKeys(ctx, keyIDs)
+	if err != nil {
+		return err
+	}
+	related.DomainNames, err = a.apigwService.ListDomainNames(ctx, apiIDs)
+	if err != nil {
+		return err
+	}
+
+	hclFile, importFile, err := a.generator.GenerateApiGatewayBlocks(apis, related)
+	if err != nil {
+		return err
+	}
+
+	for _, api := range apis {
+		if len(api.Body) == 0 {
+			continue
+		}
+		if err := a.writer.WriteRawFile(a.generator.RestAPIBodyPath(api.ID), api.Body); err != nil {
+			return err
+		}
+	}
+
+	err = a.writer.WriteFile("api_gateway_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("api_gateway_import.tf", importFile)
+}
+
+func (a *App) processApiGatewayV2(ctx context.Context, resourceName string) error {
+	apis, err := a.apigwv2Service.ListAPIs(ctx, resourceName)
+	if err != nil {
+		return err
+	}
+
+	var apiIDs []string
+	for _, api := range apis {
+		apiIDs = append(apiIDs, api.ID)
+	}
+	domains, err := a.apigwv2Service.ListDomainNames(ctx, apiIDs)
+	if err != nil {
+		return err
+	}
+
+	hclFile, importFile, err := a.generator.GenerateApiGatewayV2Blocks(apis, domains)
+	if err != nil {
+		return err
+	}
+	err = a.writer.WriteFile("apigatewayv2_generated.tf", hclFile)
+	if err != nil {
+		return err
+	}
+	return a.writer.WriteFile("apigatewayv2_import.tf", importFile)
+}
+
+func containsResourceType(resourceTypes []string, resourceType string) bool {
+	for _, t := range resourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
 // BuildApp は依存関係を解決してAppを構築します。
 func BuildApp(ctx context.Context) (*App, error) {
 	awsCfg, err := aws.NewConfig(ctx)
@@ -386,7 +1483,7 @@ func BuildApp(ctx context.Context) (*App, error) {
 
 	// EC2
 	ec2Client := aws.NewEC2Client(awsCfg)
-	ec2Repo := ec2.NewEC2Repository(ec2Client)
+	ec2Repo := ec2.NewEC2Repository(ec2Client, aws.NewSTSClient(awsCfg))
 	ec2Service := ec2.NewEC2Service(ec2Repo)
 
 	// ECS
@@ -397,7 +1494,8 @@ func BuildApp(ctx context.Context) (*App, error) {
 	// ELBv2
 	elbv2Client := aws.NewELBV2Client(awsCfg)
 	elbv2Repo := elbv2.NewELBV2Repository(elbv2Client)
-	elbService := elbv2.NewELBV2Service(elbv2Repo)
+	classicElbRepo := elbv2.NewClassicELBRepository(aws.NewClassicELBClient(awsCfg))
+	elbService := elbv2.NewELBV2Service(elbv2Repo, classicElbRepo)
 
 	// IAM
 	iamClient := aws.NewIAMClient(awsCfg)
@@ -409,10 +1507,38 @@ func BuildApp(ctx context.Context) (*App, error) {
 	rdsRepo := rds.NewRDSRepository(rdsClient)
 	rdsService := rds.NewRDSService(rdsRepo)
 
+	// Auto Scaling
+	asgClient := aws.NewAutoScalingClient(awsCfg)
+	asgRepo := autoscaling.NewAutoScalingRepository(asgClient)
+	asgService := autoscaling.NewAutoScalingService(asgRepo)
+
+	// Application Auto Scaling
+	aasClient := aws.NewApplicationAutoScalingClient(awsCfg)
+	aasRepo := applicationautoscaling.NewApplicationAutoScalingRepository(aasClient)
+	aasService := applicationautoscaling.NewApplicationAutoScalingService(aasRepo)
+
+	// Cloud Map
+	sdClient := aws.NewServiceDiscoveryClient(awsCfg)
+	route53Client := aws.NewRoute53Client(awsCfg)
+	sdRepo := servicediscovery.NewServiceDiscoveryRepository(sdClient, route53Client)
+	sdService := servicediscovery.NewServiceDiscoveryService(sdRepo)
+
+	// Lambda
+	lambdaClient := aws.NewLambdaClient(awsCfg)
+	logsClient := aws.NewCloudWatchLogsClient(awsCfg)
+	lambdaRepo := lambda.NewLambdaRepository(lambdaClient, logsClient)
+	lambdaService := lambda.NewLambdaService(lambdaRepo)
+
+	// API Gateway
+	apigwRepo := apigateway.NewAPIGatewayRepository(aws.NewAPIGatewayClient(awsCfg))
+	apigwService := apigateway.NewAPIGatewayService(apigwRepo)
+	apigwv2Repo := apigatewayv2.NewAPIGatewayV2Repository(aws.NewAPIGatewayV2Client(awsCfg))
+	apigwv2Service := apigatewayv2.NewAPIGatewayV2Service(apigwv2Repo)
+
 	writer := writer.NewFileWriter()
 	generator := hcl.NewHCLGenerator()
 
-	app := NewApp(s3Service, ec2Service, ecsService, elbService, iamService, rdsService, writer, generator)
+	app := NewApp(s3Service, ec2Service, ecsService, elbService, iamService, rdsService, asgService, aasService, sdService, lambdaService, apigwService, apigwv2Service, writer, generator)
 
 	return app, nil
-} 
+}
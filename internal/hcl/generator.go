@@ -1,16 +1,27 @@
 package hcl
 
 import (
+	"encoding/json"
 	"fmt"
+	"path"
+	"sort"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/Haussmann000/tfimport/internal/aws/apigateway"
+	"github.com/Haussmann000/tfimport/internal/aws/apigatewayv2"
+	"github.com/Haussmann000/tfimport/internal/aws/applicationautoscaling"
+	"github.com/Haussmann000/tfimport/internal/aws/autoscaling"
 	"github.com/Haussmann000/tfimport/internal/aws/ec2"
 	"github.com/Haussmann000/tfimport/internal/aws/ecs"
 	"github.com/Haussmann000/tfimport/internal/aws/elbv2"
 	"github.com/Haussmann000/tfimport/internal/aws/iam"
+	"github.com/Haussmann000/tfimport/internal/aws/lambda"
 	"github.com/Haussmann000/tfimport/internal/aws/rds"
 	"github.com/Haussmann000/tfimport/internal/aws/s3"
+	"github.com/Haussmann000/tfimport/internal/aws/servicediscovery"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -23,172 +34,1676 @@ func NewHCLGenerator() *HCLGenerator {
 	return &HCLGenerator{}
 }
 
-// GenerateVpcBlocks はVPCリソースのresourceブロックとimportブロックを生成します。
-func (g *HCLGenerator) GenerateVpcBlocks(vpcs []ec2.Vpc) (*hclwrite.File, *hclwrite.File, error) {
+// GenerateVpcBlocks はVPCとそのネットワーク構成(サブネット、ルートテーブル、ゲートウェイ等)の
+// resourceブロックとimportブロックを生成します。
+// networkAclRuleResourcesがtrueの場合、ネットワークACLのルールはaws_network_acl_ruleとして出力します。
+func (g *HCLGenerator) GenerateVpcBlocks(vpcs []ec2.Vpc, networkAclRuleResources bool) (*hclwrite.File, *hclwrite.File, error) {
 	resourceFile := hclwrite.NewEmptyFile()
 	importFile := hclwrite.NewEmptyFile()
 	resourceBody := resourceFile.Body()
 	importBody := importFile.Body()
 
+	// DHCPオプションセットは複数のVPCで共有できるため、IDごとにまとめて最後に出力します。
+	vpcRefs := make(map[string]string)
+	var dhcpOptions []ec2.DhcpOptions
+	dhcpOptionsIndex := make(map[string]int)
+
 	for i, vpc := range vpcs {
 		resourceName := fmt.Sprintf("vpc_%d", i)
 		resourceType := "aws_vpc"
+		vpcRefs[vpc.ID] = resourceName
+		if opts := vpc.DhcpOptions; opts != nil {
+			if j, ok := dhcpOptionsIndex[opts.ID]; ok {
+				dhcpOptions[j].VpcIDs = append(dhcpOptions[j].VpcIDs, opts.VpcIDs...)
+			} else {
+				dhcpOptionsIndex[opts.ID] = len(dhcpOptions)
+				dhcpOptions = append(dhcpOptions, *opts)
+			}
+		}
 
 		// importブロックの生成
-		importBlock := importBody.AppendNewBlock("import", nil)
-		importBody.AppendNewline()
-		importBlock.Body().SetAttributeValue("to", cty.StringVal(resourceType+"."+resourceName))
-		importBlock.Body().SetAttributeValue("id", cty.StringVal(vpc.ID))
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, vpc.ID)
 
 		// resourceブロックの生成
-		vpcBlock := resourceBody.AppendNewBlock("resource", []string{resourceType, resourceName})
-		resourceBody.AppendNewline()
+		vpcBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
 		vpcBlock.Body().SetAttributeValue("cidr_block", cty.StringVal(vpc.CidrBlock))
-
+		if vpc.InstanceTenancy != "" {
+			vpcBlock.Body().SetAttributeValue("instance_tenancy", cty.StringVal(vpc.InstanceTenancy))
+		}
+		vpcBlock.Body().SetAttributeValue("enable_dns_support", cty.BoolVal(vpc.EnableDnsSupport))
+		vpcBlock.Body().SetAttributeValue("enable_dns_hostnames", cty.BoolVal(vpc.EnableDnsHostnames))
 		if len(vpc.Tags) > 0 {
-			tagMap := make(map[string]cty.Value)
-			for k, v := range vpc.Tags {
-				tagMap[k] = cty.StringVal(v)
+			g.appendTags(vpcBlock.Body(), vpc.Tags)
+		}
+
+		g.appendVpcNetworkBlocks(resourceBody, importBody, vpc, resourceName, networkAclRuleResources)
+	}
+
+	for _, opts := range dhcpOptions {
+		g.appendDhcpOptions(resourceBody, importBody, opts, vpcRefs)
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// appendVpcNetworkBlocks はVPCに属するネットワークリソースを親VPCへの参照付きで生成します。
+func (g *HCLGenerator) appendVpcNetworkBlocks(resourceBody, importBody *hclwrite.Body, vpc ec2.Vpc, vpcResourceName string, networkAclRuleResources bool) {
+	vpcIDRef := g.reference("aws_vpc", vpcResourceName, "id")
+	vpcRefs := map[string]string{vpc.ID: vpcResourceName}
+
+	// Secondary CIDR blocks
+	for _, assoc := range vpc.CidrBlockAssociations {
+		resourceType := "aws_vpc_ipv4_cidr_block_association"
+		resourceName := g.sanitize(assoc.AssociationID)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, assoc.AssociationID)
+		assocBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		assocBlock.Body().SetAttributeRaw("vpc_id", vpcIDRef)
+		assocBlock.Body().SetAttributeValue("cidr_block", cty.StringVal(assoc.CidrBlock))
+	}
+
+	// Subnets
+	subnetRefs := make(map[string]string)
+	for _, subnet := range vpc.Subnets {
+		resourceType := "aws_subnet"
+		resourceName := g.sanitize(subnet.ID)
+		subnetRefs[subnet.ID] = resourceName
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, subnet.ID)
+		subnetBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		subnetBlock.Body().SetAttributeRaw("vpc_id", vpcIDRef)
+		subnetBlock.Body().SetAttributeValue("cidr_block", cty.StringVal(subnet.CidrBlock))
+		subnetBlock.Body().SetAttributeValue("availability_zone", cty.StringVal(subnet.AvailabilityZone))
+		subnetBlock.Body().SetAttributeValue("map_public_ip_on_launch", cty.BoolVal(subnet.MapPublicIpOnLaunch))
+		if len(subnet.Tags) > 0 {
+			g.appendTags(subnetBlock.Body(), subnet.Tags)
+		}
+	}
+
+	// Internet Gateways
+	igwRefs := make(map[string]string)
+	for _, igw := range vpc.InternetGateways {
+		resourceType := "aws_internet_gateway"
+		resourceName := g.sanitize(igw.ID)
+		igwRefs[igw.ID] = resourceName
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, igw.ID)
+		igwBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		igwBlock.Body().SetAttributeRaw("vpc_id", vpcIDRef)
+		if len(igw.Tags) > 0 {
+			g.appendTags(igwBlock.Body(), igw.Tags)
+		}
+	}
+
+	// NAT Gateways and their EIPs
+	natRefs := make(map[string]string)
+	for _, ngw := range vpc.NatGateways {
+		resourceType := "aws_nat_gateway"
+		resourceName := g.sanitize(ngw.ID)
+		natRefs[ngw.ID] = resourceName
+
+		var eipResourceName string
+		if ngw.Eip != nil {
+			eipResourceType := "aws_eip"
+			eipResourceName = g.sanitize(ngw.Eip.AllocationID)
+			g.appendImportBlock(importBody, eipResourceType+"."+eipResourceName, ngw.Eip.AllocationID)
+			eipBlock := g.appendResourceBlock(resourceBody, eipResourceType, eipResourceName)
+			eipBlock.Body().SetAttributeValue("domain", cty.StringVal("vpc"))
+			if len(ngw.Eip.Tags) > 0 {
+				g.appendTags(eipBlock.Body(), ngw.Eip.Tags)
+			}
+		}
+
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, ngw.ID)
+		ngwBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		if eipResourceName != "" {
+			ngwBlock.Body().SetAttributeRaw("allocation_id", g.reference("aws_eip", eipResourceName, "id"))
+		}
+		g.setReferenceOrValue(ngwBlock.Body(), "subnet_id", subnetRefs, "aws_subnet", ngw.SubnetID)
+		if ngw.ConnectivityType != "" {
+			ngwBlock.Body().SetAttributeValue("connectivity_type", cty.StringVal(ngw.ConnectivityType))
+		}
+		if len(ngw.Tags) > 0 {
+			g.appendTags(ngwBlock.Body(), ngw.Tags)
+		}
+	}
+
+	// Route Tables
+	for _, rt := range vpc.RouteTables {
+		var rtBlock *hclwrite.Block
+		var rtResourceType, rtResourceName string
+		if rt.Main {
+			// メインルートテーブルはVPCと共に作成されるため、aws_default_route_tableとして管理します。
+			rtResourceType = "aws_default_route_table"
+			rtResourceName = vpcResourceName
+			g.appendImportBlock(importBody, rtResourceType+"."+rtResourceName, vpc.ID)
+			rtBlock = g.appendResourceBlock(resourceBody, rtResourceType, rtResourceName)
+			rtBlock.Body().SetAttributeRaw("default_route_table_id", g.reference("aws_vpc", vpcResourceName, "default_route_table_id"))
+		} else {
+			rtResourceType = "aws_route_table"
+			rtResourceName = g.sanitize(rt.ID)
+			g.appendImportBlock(importBody, rtResourceType+"."+rtResourceName, rt.ID)
+			rtBlock = g.appendResourceBlock(resourceBody, rtResourceType, rtResourceName)
+			rtBlock.Body().SetAttributeRaw("vpc_id", vpcIDRef)
+		}
+
+		for _, route := range rt.Routes {
+			routeBlock := rtBlock.Body().AppendNewBlock("route", nil)
+			if route.DestinationCidrBlock != "" {
+				routeBlock.Body().SetAttributeValue("cidr_block", cty.StringVal(route.DestinationCidrBlock))
+			}
+			if route.DestinationIpv6CidrBlock != "" {
+				routeBlock.Body().SetAttributeValue("ipv6_cidr_block", cty.StringVal(route.DestinationIpv6CidrBlock))
+			}
+			if route.DestinationPrefixListID != "" {
+				routeBlock.Body().SetAttributeValue("destination_prefix_list_id", cty.StringVal(route.DestinationPrefixListID))
+			}
+			if route.GatewayID != "" {
+				g.setReferenceOrValue(routeBlock.Body(), "gateway_id", igwRefs, "aws_internet_gateway", route.GatewayID)
+			}
+			if route.NatGatewayID != "" {
+				g.setReferenceOrValue(routeBlock.Body(), "nat_gateway_id", natRefs, "aws_nat_gateway", route.NatGatewayID)
+			}
+			if route.TransitGatewayID != "" {
+				routeBlock.Body().SetAttributeValue("transit_gateway_id", cty.StringVal(route.TransitGatewayID))
+			}
+			if route.VpcPeeringConnectionID != "" {
+				routeBlock.Body().SetAttributeValue("vpc_peering_connection_id", cty.StringVal(route.VpcPeeringConnectionID))
+			}
+			if route.NetworkInterfaceID != "" {
+				routeBlock.Body().SetAttributeValue("network_interface_id", cty.StringVal(route.NetworkInterfaceID))
+			}
+		}
+
+		if len(rt.Tags) > 0 {
+			g.appendTags(rtBlock.Body(), rt.Tags)
+		}
+
+		// Route Table Associations
+		for _, assoc := range rt.Associations {
+			assocResourceType := "aws_route_table_association"
+			assocResourceName := g.sanitize(assoc.ID)
+			target := assoc.SubnetID
+			if target == "" {
+				target = assoc.GatewayID
 			}
-			vpcBlock.Body().SetAttributeValue("tags", cty.MapVal(tagMap))
+			g.appendImportBlock(importBody, assocResourceType+"."+assocResourceName, fmt.Sprintf("%s/%s", target, rt.ID))
+			assocBlock := g.appendResourceBlock(resourceBody, assocResourceType, assocResourceName)
+			if assoc.SubnetID != "" {
+				g.setReferenceOrValue(assocBlock.Body(), "subnet_id", subnetRefs, "aws_subnet", assoc.SubnetID)
+			} else {
+				g.setReferenceOrValue(assocBlock.Body(), "gateway_id", igwRefs, "aws_internet_gateway", assoc.GatewayID)
+			}
+			assocBlock.Body().SetAttributeRaw("route_table_id", g.reference(rtResourceType, rtResourceName, "id"))
+		}
+	}
+
+	// Network ACLs
+	for _, acl := range vpc.NetworkAcls {
+		g.appendNetworkAcl(resourceBody, importBody, acl, vpcRefs, subnetRefs, networkAclRuleResources)
+	}
+
+	// Flow Logs
+	for _, fl := range vpc.FlowLogs {
+		g.appendFlowLog(resourceBody, importBody, fl, vpcRefs, subnetRefs)
+	}
+
+	// Default Network ACL
+	if acl := vpc.DefaultNetworkAcl; acl != nil {
+		resourceType := "aws_default_network_acl"
+		resourceName := vpcResourceName
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, acl.ID)
+		aclBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		aclBlock.Body().SetAttributeRaw("default_network_acl_id", g.reference("aws_vpc", vpcResourceName, "default_network_acl_id"))
+		if len(acl.SubnetIDs) > 0 {
+			aclBlock.Body().SetAttributeRaw("subnet_ids", g.referenceList(acl.SubnetIDs, subnetRefs, "aws_subnet", "id"))
+		}
+		g.appendNetworkAclEntries(aclBlock.Body(), "ingress", acl.Ingress)
+		g.appendNetworkAclEntries(aclBlock.Body(), "egress", acl.Egress)
+		if len(acl.Tags) > 0 {
+			g.appendTags(aclBlock.Body(), acl.Tags)
+		}
+	}
+
+	// Default Security Group
+	if sg := vpc.DefaultSecurityGroup; sg != nil {
+		resourceType := "aws_default_security_group"
+		resourceName := vpcResourceName
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, sg.ID)
+		sgBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		sgBlock.Body().SetAttributeRaw("vpc_id", vpcIDRef)
+		g.appendIpPermissions(sgBlock.Body(), "ingress", sg.Ingress)
+		g.appendIpPermissions(sgBlock.Body(), "egress", sg.Egress)
+		if len(sg.Tags) > 0 {
+			g.appendTags(sgBlock.Body(), sg.Tags)
+		}
+	}
+}
+
+// GenerateNetworkAclBlocks はネットワークACLのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateNetworkAclBlocks(acls []ec2.NetworkAcl, ruleResources bool) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+
+	for _, acl := range acls {
+		g.appendNetworkAcl(resourceFile.Body(), importFile.Body(), acl, nil, nil, ruleResources)
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateDhcpOptionsBlocks はDHCPオプションセットとVPCへの関連付けのresourceブロックとimportブロックを生成します。
+// emitted に含まれるIDのDHCPオプションセットは GenerateVpcBlocks が出力するため、関連付けのみ出力します。
+func (g *HCLGenerator) GenerateDhcpOptionsBlocks(dhcpOptions []ec2.DhcpOptions, emitted map[string]struct{}) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+
+	for _, opts := range dhcpOptions {
+		if _, ok := emitted[opts.ID]; ok {
+			g.appendDhcpOptionsAssociations(resourceFile.Body(), importFile.Body(), opts, nil)
+			continue
 		}
+		g.appendDhcpOptions(resourceFile.Body(), importFile.Body(), opts, nil)
 	}
 
 	return resourceFile, importFile, nil
 }
 
+// GenerateFlowLogBlocks はフローログのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateFlowLogBlocks(flowLogs []ec2.FlowLog) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+
+	for _, fl := range flowLogs {
+		g.appendFlowLog(resourceFile.Body(), importFile.Body(), fl, nil, nil)
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// appendNetworkAcl はネットワークACLを生成します。vpcRefs/subnetRefsに含まれるIDは参照として出力します。
+func (g *HCLGenerator) appendNetworkAcl(resourceBody, importBody *hclwrite.Body, acl ec2.NetworkAcl, vpcRefs, subnetRefs map[string]string, ruleResources bool) {
+	resourceType := "aws_network_acl"
+	resourceName := g.sanitize(acl.ID)
+	g.appendImportBlock(importBody, resourceType+"."+resourceName, acl.ID)
+	aclBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+	g.setReferenceOrValue(aclBlock.Body(), "vpc_id", vpcRefs, "aws_vpc", acl.VpcID)
+	if len(acl.SubnetIDs) > 0 {
+		aclBlock.Body().SetAttributeRaw("subnet_ids", g.referenceList(acl.SubnetIDs, subnetRefs, "aws_subnet", "id"))
+	}
+	if !ruleResources {
+		g.appendNetworkAclEntries(aclBlock.Body(), "ingress", acl.Ingress)
+		g.appendNetworkAclEntries(aclBlock.Body(), "egress", acl.Egress)
+	}
+	if len(acl.Tags) > 0 {
+		g.appendTags(aclBlock.Body(), acl.Tags)
+	}
+
+	if !ruleResources {
+		return
+	}
+
+	appendRules := func(entries []ec2.NetworkAclEntry, egress bool) {
+		direction := "ingress"
+		if egress {
+			direction = "egress"
+		}
+		for _, e := range entries {
+			ruleResourceType := "aws_network_acl_rule"
+			ruleResourceName := fmt.Sprintf("%s_%s_%d", resourceName, direction, e.RuleNumber)
+			g.appendImportBlock(importBody, ruleResourceType+"."+ruleResourceName, fmt.Sprintf("%s:%d:%s:%t", acl.ID, e.RuleNumber, e.Protocol, egress))
+			ruleBlock := g.appendResourceBlock(resourceBody, ruleResourceType, ruleResourceName)
+			ruleBlock.Body().SetAttributeRaw("network_acl_id", g.reference(resourceType, resourceName, "id"))
+			ruleBlock.Body().SetAttributeValue("rule_number", cty.NumberIntVal(int64(e.RuleNumber)))
+			ruleBlock.Body().SetAttributeValue("egress", cty.BoolVal(egress))
+			ruleBlock.Body().SetAttributeValue("protocol", cty.StringVal(e.Protocol))
+			ruleBlock.Body().SetAttributeValue("rule_action", cty.StringVal(e.Action))
+			if e.CidrBlock != "" {
+				ruleBlock.Body().SetAttributeValue("cidr_block", cty.StringVal(e.CidrBlock))
+			}
+			if e.Ipv6CidrBlock != "" {
+				ruleBlock.Body().SetAttributeValue("ipv6_cidr_block", cty.StringVal(e.Ipv6CidrBlock))
+			}
+			if e.Protocol == "1" || e.Protocol == "58" {
+				ruleBlock.Body().SetAttributeValue("icmp_type", cty.NumberIntVal(int64(e.IcmpType)))
+				ruleBlock.Body().SetAttributeValue("icmp_code", cty.NumberIntVal(int64(e.IcmpCode)))
+			} else if e.Protocol != "-1" {
+				ruleBlock.Body().SetAttributeValue("from_port", cty.NumberIntVal(int64(e.FromPort)))
+				ruleBlock.Body().SetAttributeValue("to_port", cty.NumberIntVal(int64(e.ToPort)))
+			}
+		}
+	}
+	appendRules(acl.Ingress, false)
+	appendRules(acl.Egress, true)
+}
+
+// appendDhcpOptions はDHCPオプションセットと、関連付けられたVPCごとのaws_vpc_dhcp_options_associationを生成します。
+func (g *HCLGenerator) appendDhcpOptions(resourceBody, importBody *hclwrite.Body, opts ec2.DhcpOptions, vpcRefs map[string]string) {
+	resourceType := "aws_vpc_dhcp_options"
+	resourceName := g.sanitize(opts.ID)
+	g.appendImportBlock(importBody, resourceType+"."+resourceName, opts.ID)
+	optsBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+	if opts.DomainName != "" {
+		optsBlock.Body().SetAttributeValue("domain_name", cty.StringVal(opts.DomainName))
+	}
+	if len(opts.DomainNameServers) > 0 {
+		optsBlock.Body().SetAttributeValue("domain_name_servers", g.stringList(opts.DomainNameServers))
+	}
+	if len(opts.NtpServers) > 0 {
+		optsBlock.Body().SetAttributeValue("ntp_servers", g.stringList(opts.NtpServers))
+	}
+	if len(opts.NetbiosNameServers) > 0 {
+		optsBlock.Body().SetAttributeValue("netbios_name_servers", g.stringList(opts.NetbiosNameServers))
+	}
+	if opts.NetbiosNodeType != "" {
+		optsBlock.Body().SetAttributeValue("netbios_node_type", cty.StringVal(opts.NetbiosNodeType))
+	}
+	if len(opts.Tags) > 0 {
+		g.appendTags(optsBlock.Body(), opts.Tags)
+	}
+
+	g.appendDhcpOptionsAssociations(resourceBody, importBody, opts, vpcRefs)
+}
+
+// appendDhcpOptionsAssociations はDHCPオプションセットのVPCへの関連付けを生成します。
+func (g *HCLGenerator) appendDhcpOptionsAssociations(resourceBody, importBody *hclwrite.Body, opts ec2.DhcpOptions, vpcRefs map[string]string) {
+	for _, vpcID := range opts.VpcIDs {
+		assocResourceType := "aws_vpc_dhcp_options_association"
+		assocResourceName := g.sanitize(vpcID)
+		if name, ok := vpcRefs[vpcID]; ok {
+			assocResourceName = name
+		}
+		// aws_vpc_dhcp_options_associationのimport IDはVPC IDです。
+		g.appendImportBlock(importBody, assocResourceType+"."+assocResourceName, vpcID)
+		assocBlock := g.appendResourceBlock(resourceBody, assocResourceType, assocResourceName)
+		g.setReferenceOrValue(assocBlock.Body(), "vpc_id", vpcRefs, "aws_vpc", vpcID)
+		assocBlock.Body().SetAttributeRaw("dhcp_options_id", g.reference("aws_vpc_dhcp_options", g.sanitize(opts.ID), "id"))
+	}
+}
+
+// appendFlowLog はフローログを生成します。対象リソースの種類はIDの接頭辞から判定します。
+func (g *HCLGenerator) appendFlowLog(resourceBody, importBody *hclwrite.Body, fl ec2.FlowLog, vpcRefs, subnetRefs map[string]string) {
+	resourceType := "aws_flow_log"
+	resourceName := g.sanitize(fl.ID)
+	g.appendImportBlock(importBody, resourceType+"."+resourceName, fl.ID)
+	flBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+	switch {
+	case strings.HasPrefix(fl.ResourceID, "vpc-"):
+		g.setReferenceOrValue(flBlock.Body(), "vpc_id", vpcRefs, "aws_vpc", fl.ResourceID)
+	case strings.HasPrefix(fl.ResourceID, "subnet-"):
+		g.setReferenceOrValue(flBlock.Body(), "subnet_id", subnetRefs, "aws_subnet", fl.ResourceID)
+	case strings.HasPrefix(fl.ResourceID, "eni-"):
+		flBlock.Body().SetAttributeValue("eni_id", cty.StringVal(fl.ResourceID))
+	case strings.HasPrefix(fl.ResourceID, "tgw-attach-"):
+		flBlock.Body().SetAttributeValue("transit_gateway_attachment_id", cty.StringVal(fl.ResourceID))
+	case strings.HasPrefix(fl.ResourceID, "tgw-"):
+		flBlock.Body().SetAttributeValue("transit_gateway_id", cty.StringVal(fl.ResourceID))
+	}
+	flBlock.Body().SetAttributeValue("traffic_type", cty.StringVal(fl.TrafficType))
+	flBlock.Body().SetAttributeValue("log_destination_type", cty.StringVal(fl.LogDestinationType))
+	flBlock.Body().SetAttributeValue("log_destination", cty.StringVal(fl.LogDestination))
+	if fl.DeliverLogsPermission != "" {
+		flBlock.Body().SetAttributeValue("iam_role_arn", cty.StringVal(fl.DeliverLogsPermission))
+	}
+	if fl.LogFormat != "" {
+		flBlock.Body().SetAttributeValue("log_format", cty.StringVal(fl.LogFormat))
+	}
+	if fl.MaxAggregationInterval != 0 {
+		flBlock.Body().SetAttributeValue("max_aggregation_interval", cty.NumberIntVal(int64(fl.MaxAggregationInterval)))
+	}
+	if len(fl.Tags) > 0 {
+		g.appendTags(flBlock.Body(), fl.Tags)
+	}
+}
+
+func (g *HCLGenerator) appendNetworkAclEntries(body *hclwrite.Body, blockType string, entries []ec2.NetworkAclEntry) {
+	for _, e := range entries {
+		entryBlock := body.AppendNewBlock(blockType, nil)
+		entryBlock.Body().SetAttributeValue("rule_no", cty.NumberIntVal(int64(e.RuleNumber)))
+		entryBlock.Body().SetAttributeValue("protocol", cty.StringVal(e.Protocol))
+		entryBlock.Body().SetAttributeValue("action", cty.StringVal(e.Action))
+		if e.CidrBlock != "" {
+			entryBlock.Body().SetAttributeValue("cidr_block", cty.StringVal(e.CidrBlock))
+		}
+		if e.Ipv6CidrBlock != "" {
+			entryBlock.Body().SetAttributeValue("ipv6_cidr_block", cty.StringVal(e.Ipv6CidrBlock))
+		}
+		entryBlock.Body().SetAttributeValue("from_port", cty.NumberIntVal(int64(e.FromPort)))
+		entryBlock.Body().SetAttributeValue("to_port", cty.NumberIntVal(int64(e.ToPort)))
+		if e.Protocol == "1" || e.Protocol == "58" {
+			entryBlock.Body().SetAttributeValue("icmp_type", cty.NumberIntVal(int64(e.IcmpType)))
+			entryBlock.Body().SetAttributeValue("icmp_code", cty.NumberIntVal(int64(e.IcmpCode)))
+		}
+	}
+}
+
+func (g *HCLGenerator) appendIpPermissions(body *hclwrite.Body, blockType string, perms []ec2.IpPermission) {
+	for _, p := range perms {
+		permBlock := body.AppendNewBlock(blockType, nil)
+		permBlock.Body().SetAttributeValue("protocol", cty.StringVal(p.Protocol))
+		permBlock.Body().SetAttributeValue("from_port", cty.NumberIntVal(int64(p.FromPort)))
+		permBlock.Body().SetAttributeValue("to_port", cty.NumberIntVal(int64(p.ToPort)))
+		if len(p.CidrBlocks) > 0 {
+			permBlock.Body().SetAttributeValue("cidr_blocks", g.stringList(p.CidrBlocks))
+		}
+		if len(p.Ipv6CidrBlocks) > 0 {
+			permBlock.Body().SetAttributeValue("ipv6_cidr_blocks", g.stringList(p.Ipv6CidrBlocks))
+		}
+		if len(p.PrefixListIDs) > 0 {
+			permBlock.Body().SetAttributeValue("prefix_list_ids", g.stringList(p.PrefixListIDs))
+		}
+		if len(p.SecurityGroups) > 0 {
+			permBlock.Body().SetAttributeValue("security_groups", g.stringList(p.SecurityGroups))
+		}
+		if p.Self {
+			permBlock.Body().SetAttributeValue("self", cty.True)
+		}
+		if p.Description != "" {
+			permBlock.Body().SetAttributeValue("description", cty.StringVal(p.Description))
+		}
+	}
+}
+
 // GenerateSecurityGroupBlocks はSecurity Groupリソースのresourceブロックとimportブロックを生成します。
-func (g *HCLGenerator) GenerateSecurityGroupBlocks(sgs []ec2.SecurityGroup) (*hclwrite.File, *hclwrite.File, error) {
+// inlineRulesがtrueの場合はルールをaws_security_group内のingress/egressブロックとして出力し、
+// falseの場合はaws_vpc_security_group_ingress_rule/egress_ruleとして1ルールずつ出力します。
+func (g *HCLGenerator) GenerateSecurityGroupBlocks(sgs []ec2.SecurityGroup, inlineRules bool) (*hclwrite.File, *hclwrite.File, error) {
 	resourceFile := hclwrite.NewEmptyFile()
 	importFile := hclwrite.NewEmptyFile()
 	resourceBody := resourceFile.Body()
 	importBody := importFile.Body()
 
+	sgRefs := make(map[string]string)
 	for i, sg := range sgs {
-		resourceName := fmt.Sprintf("sg_%d", i)
+		sgRefs[sg.ID] = fmt.Sprintf("sg_%d", i)
+	}
+
+	for _, sg := range sgs {
+		resourceName := sgRefs[sg.ID]
 		resourceType := "aws_security_group"
 
 		// importブロックの生成
-		importBlock := importBody.AppendNewBlock("import", nil)
-		importBody.AppendNewline()
-		importBlock.Body().SetAttributeValue("to", cty.StringVal(resourceType+"."+resourceName))
-		importBlock.Body().SetAttributeValue("id", cty.StringVal(sg.ID))
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, sg.ID)
 
 		// resourceブロックの生成
-		sgBlock := resourceBody.AppendNewBlock("resource", []string{resourceType, resourceName})
-		resourceBody.AppendNewline()
+		sgBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
 		sgBlock.Body().SetAttributeValue("name", cty.StringVal(sg.Name))
 		sgBlock.Body().SetAttributeValue("description", cty.StringVal(sg.Description))
+		if sg.VpcID != "" {
+			sgBlock.Body().SetAttributeValue("vpc_id", cty.StringVal(sg.VpcID))
+		}
+
+		if inlineRules {
+			g.appendIpPermissions(sgBlock.Body(), "ingress", sg.Ingress)
+			g.appendIpPermissions(sgBlock.Body(), "egress", sg.Egress)
+		}
 
 		if len(sg.Tags) > 0 {
-			tagMap := make(map[string]cty.Value)
-			for k, v := range sg.Tags {
-				tagMap[k] = cty.StringVal(v)
+			g.appendTags(sgBlock.Body(), sg.Tags)
+		}
+
+		if inlineRules {
+			continue
+		}
+
+		// Security Group Rules
+		for _, rule := range sg.Rules {
+			ruleResourceType := "aws_vpc_security_group_ingress_rule"
+			if rule.IsEgress {
+				ruleResourceType = "aws_vpc_security_group_egress_rule"
+			}
+			ruleResourceName := g.sanitize(rule.ID)
+			g.appendImportBlock(importBody, ruleResourceType+"."+ruleResourceName, rule.ID)
+			ruleBlock := g.appendResourceBlock(resourceBody, ruleResourceType, ruleResourceName)
+			ruleBlock.Body().SetAttributeRaw("security_group_id", g.reference(resourceType, resourceName, "id"))
+			ruleBlock.Body().SetAttributeValue("ip_protocol", cty.StringVal(rule.IpProtocol))
+			// ip_protocolが-1(全て)の場合、ポートは指定できません。
+			if rule.IpProtocol != "-1" {
+				ruleBlock.Body().SetAttributeValue("from_port", cty.NumberIntVal(int64(rule.FromPort)))
+				ruleBlock.Body().SetAttributeValue("to_port", cty.NumberIntVal(int64(rule.ToPort)))
+			}
+			if rule.CidrIpv4 != "" {
+				ruleBlock.Body().SetAttributeValue("cidr_ipv4", cty.StringVal(rule.CidrIpv4))
+			}
+			if rule.CidrIpv6 != "" {
+				ruleBlock.Body().SetAttributeValue("cidr_ipv6", cty.StringVal(rule.CidrIpv6))
+			}
+			if rule.PrefixListID != "" {
+				ruleBlock.Body().SetAttributeValue("prefix_list_id", cty.StringVal(rule.PrefixListID))
+			}
+			if rule.ReferencedSecurityGroupID != "" {
+				g.setReferenceOrValue(ruleBlock.Body(), "referenced_security_group_id", sgRefs, resourceType, rule.ReferencedSecurityGroupID)
+			}
+			if rule.Description != "" {
+				ruleBlock.Body().SetAttributeValue("description", cty.StringVal(rule.Description))
+			}
+			if len(rule.Tags) > 0 {
+				g.appendTags(ruleBlock.Body(), rule.Tags)
 			}
-			sgBlock.Body().SetAttributeValue("tags", cty.MapVal(tagMap))
 		}
 	}
 
 	return resourceFile, importFile, nil
 }
 
-// GenerateS3BucketBlocks はS3バケットリソースのresourceブロックとimportブロックを生成します。
-func (g *HCLGenerator) GenerateS3BucketBlocks(buckets []s3.Bucket) (*hclwrite.File, *hclwrite.File, error) {
+// GenerateVpcEndpointBlocks はVPCエンドポイントのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateVpcEndpointBlocks(endpoints []ec2.VpcEndpoint) (*hclwrite.File, *hclwrite.File, error) {
 	resourceFile := hclwrite.NewEmptyFile()
 	importFile := hclwrite.NewEmptyFile()
 	resourceBody := resourceFile.Body()
 	importBody := importFile.Body()
 
-	for _, bucket := range buckets {
-		resourceName := bucket.Name
-		resourceType := "aws_s3_bucket"
+	for _, e := range endpoints {
+		resourceType := "aws_vpc_endpoint"
+		resourceName := g.sanitize(e.ID)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, e.ID)
+		endpointBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		endpointBlock.Body().SetAttributeValue("vpc_id", cty.StringVal(e.VpcID))
+		endpointBlock.Body().SetAttributeValue("service_name", cty.StringVal(e.ServiceName))
+		endpointBlock.Body().SetAttributeValue("vpc_endpoint_type", cty.StringVal(e.Type))
+		if len(e.RouteTableIDs) > 0 {
+			endpointBlock.Body().SetAttributeValue("route_table_ids", g.stringList(e.RouteTableIDs))
+		}
+		if len(e.SubnetIDs) > 0 {
+			endpointBlock.Body().SetAttributeValue("subnet_ids", g.stringList(e.SubnetIDs))
+		}
+		if len(e.SecurityGroupIDs) > 0 {
+			endpointBlock.Body().SetAttributeValue("security_group_ids", g.stringList(e.SecurityGroupIDs))
+		}
+		if e.Type == "Interface" {
+			endpointBlock.Body().SetAttributeValue("private_dns_enabled", cty.BoolVal(e.PrivateDnsEnabled))
+		}
+		if e.PolicyDocument != "" {
+			endpointBlock.Body().SetAttributeValue("policy", cty.StringVal(e.PolicyDocument))
+		}
+		if len(e.Tags) > 0 {
+			g.appendTags(endpointBlock.Body(), e.Tags)
+		}
+	}
 
-		// importブロックの生成
-		importBlock := importBody.AppendNewBlock("import", nil)
-		importBody.AppendNewline()
-		importBlock.Body().SetAttributeValue("to", cty.StringVal(resourceType+"."+resourceName))
-		importBlock.Body().SetAttributeValue("id", cty.StringVal(bucket.Name))
+	return resourceFile, importFile, nil
+}
 
-		// resourceブロックの生成
-		bucketBlock := resourceBody.AppendNewBlock("resource", []string{resourceType, resourceName})
-		resourceBody.AppendNewline()
-		bucketBlock.Body().SetAttributeValue("bucket", cty.StringVal(bucket.Name))
+// GenerateVpcPeeringBlocks はVPCピアリング接続のresourceブロックとimportブロックを生成します。
+// 実行中のアカウント・リージョンがアクセプタ側の接続は aws_vpc_peering_connection_accepter として生成します。
+// リクエスタとアクセプタが同一アカウント・同一リージョンの場合は、auto_accept で承認まで管理します。
+func (g *HCLGenerator) GenerateVpcPeeringBlocks(peerings []ec2.VpcPeeringConnection) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, p := range peerings {
+		resourceName := g.sanitize(p.ID)
+		if p.Accepter {
+			accepterResourceType := "aws_vpc_peering_connection_accepter"
+			g.appendImportBlock(importBody, accepterResourceType+"."+resourceName, p.ID)
+			accepterBlock := g.appendResourceBlock(resourceBody, accepterResourceType, resourceName)
+			accepterBlock.Body().SetAttributeValue("vpc_peering_connection_id", cty.StringVal(p.ID))
+			accepterBlock.Body().SetAttributeValue("auto_accept", cty.True)
+			if len(p.Tags) > 0 {
+				g.appendTags(accepterBlock.Body(), p.Tags)
+			}
+			continue
+		}
+
+		resourceType := "aws_vpc_peering_connection"
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, p.ID)
+		peeringBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		peeringBlock.Body().SetAttributeValue("vpc_id", cty.StringVal(p.RequesterVpcID))
+		peeringBlock.Body().SetAttributeValue("peer_vpc_id", cty.StringVal(p.AccepterVpcID))
+		if p.AccepterOwnerID != "" && p.AccepterOwnerID != p.RequesterOwnerID {
+			peeringBlock.Body().SetAttributeValue("peer_owner_id", cty.StringVal(p.AccepterOwnerID))
+		}
+		if p.AccepterRegion != "" && p.AccepterRegion != p.RequesterRegion {
+			peeringBlock.Body().SetAttributeValue("peer_region", cty.StringVal(p.AccepterRegion))
+		}
+		// auto_accept は同一アカウント・同一リージョンのピアリングでのみ指定できます。
+		if p.AccepterOwnerID == p.RequesterOwnerID && p.AccepterRegion == p.RequesterRegion {
+			peeringBlock.Body().SetAttributeValue("auto_accept", cty.True)
+		}
+		if len(p.Tags) > 0 {
+			g.appendTags(peeringBlock.Body(), p.Tags)
+		}
 	}
 
 	return resourceFile, importFile, nil
 }
 
-// GenerateEcsBlocks はECSリソースのresourceブロックとimportブロックを生成します。
-func (g *HCLGenerator) GenerateEcsBlocks(clusters []ecs.Cluster) (*hclwrite.File, *hclwrite.File, error) {
+// GenerateTransitGatewayBlocks はTransit Gatewayと、そのVPCアタッチメント、ルートテーブル、
+// 関連付け、伝播、静的ルートのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateTransitGatewayBlocks(tgws []ec2.TransitGateway) (*hclwrite.File, *hclwrite.File, error) {
 	resourceFile := hclwrite.NewEmptyFile()
 	importFile := hclwrite.NewEmptyFile()
 	resourceBody := resourceFile.Body()
 	importBody := importFile.Body()
 
-	for _, cluster := range clusters {
-		// Cluster
-		clusterResourceType := "aws_ecs_cluster"
-		clusterResourceName := cluster.Name
-		g.appendImportBlock(importBody, clusterResourceType+"."+clusterResourceName, cluster.Name)
-		clusterBlock := g.appendResourceBlock(resourceBody, clusterResourceType, clusterResourceName)
-		clusterBlock.Body().SetAttributeValue("name", cty.StringVal(cluster.Name))
-		if len(cluster.Tags) > 0 {
-			tagMap := make(map[string]cty.Value)
-			for k, v := range cluster.Tags {
-				tagMap[k] = cty.StringVal(v)
-			}
-			clusterBlock.Body().SetAttributeValue("tags", cty.MapVal(tagMap))
+	for _, tgw := range tgws {
+		tgwResourceType := "aws_ec2_transit_gateway"
+		tgwResourceName := g.sanitize(tgw.ID)
+		g.appendImportBlock(importBody, tgwResourceType+"."+tgwResourceName, tgw.ID)
+		tgwBlock := g.appendResourceBlock(resourceBody, tgwResourceType, tgwResourceName)
+		if tgw.Description != "" {
+			tgwBlock.Body().SetAttributeValue("description", cty.StringVal(tgw.Description))
+		}
+		tgwBlock.Body().SetAttributeValue("amazon_side_asn", cty.NumberIntVal(tgw.AmazonSideAsn))
+		tgwBlock.Body().SetAttributeValue("auto_accept_shared_attachments", cty.StringVal(tgw.AutoAcceptSharedAttachments))
+		tgwBlock.Body().SetAttributeValue("default_route_table_association", cty.StringVal(tgw.DefaultRouteTableAssociation))
+		tgwBlock.Body().SetAttributeValue("default_route_table_propagation", cty.StringVal(tgw.DefaultRouteTablePropagation))
+		tgwBlock.Body().SetAttributeValue("dns_support", cty.StringVal(tgw.DnsSupport))
+		tgwBlock.Body().SetAttributeValue("vpn_ecmp_support", cty.StringVal(tgw.VpnEcmpSupport))
+		if len(tgw.Tags) > 0 {
+			g.appendTags(tgwBlock.Body(), tgw.Tags)
 		}
+		tgwIDRef := g.reference(tgwResourceType, tgwResourceName, "id")
 
-		// Services
-		for _, service := range cluster.Services {
-			serviceResourceType := "aws_ecs_service"
-			serviceResourceName := service.Name
-			importId := fmt.Sprintf("%s/%s", cluster.Name, service.Name)
-			g.appendImportBlock(importBody, serviceResourceType+"."+serviceResourceName, importId)
-			serviceBlock := g.appendResourceBlock(resourceBody, serviceResourceType, serviceResourceName)
-			serviceBlock.Body().SetAttributeValue("name", cty.StringVal(service.Name))
-			serviceBlock.Body().SetAttributeValue("task_definition", cty.StringVal(service.TaskDefinitionArn))
-			serviceBlock.Body().SetAttributeValue("desired_count", cty.NumberIntVal(int64(service.DesiredCount)))
-			serviceBlock.Body().SetAttributeValue("enable_ecs_managed_tags", cty.BoolVal(service.EnableEcsManagedTags))
-			serviceBlock.Body().SetAttributeValue("enable_execute_command", cty.BoolVal(service.EnableExecuteCommand))
-			serviceBlock.Body().SetAttributeValue("health_check_grace_period_seconds", cty.NumberIntVal(int64(service.HealthCheckGracePeriodSeconds)))
-			serviceBlock.Body().SetAttributeValue("propagate_tags", cty.StringVal(service.PropagateTags))
-			serviceBlock.Body().SetAttributeValue("platform_version", cty.StringVal(service.PlatformVersion))
-			serviceBlock.Body().SetAttributeValue("scheduling_strategy", cty.StringVal(service.SchedulingStrategy))
+		// デフォルトルートテーブルへの関連付け・伝播は、アタッチメント側の属性で管理します。
+		defaultAssociated := make(map[string]bool)
+		defaultPropagated := make(map[string]bool)
+		for _, rt := range tgw.RouteTables {
+			if rt.ID == tgw.AssociationDefaultRouteTableID {
+				for _, id := range rt.AssociationIDs {
+					defaultAssociated[id] = true
+				}
+			}
+			if rt.ID == tgw.PropagationDefaultRouteTableID {
+				for _, id := range rt.PropagationIDs {
+					defaultPropagated[id] = true
+				}
+			}
+		}
 
-			// Deployment Circuit Breaker
-			if service.DeploymentCircuitBreaker != nil {
-				breakerBlock := serviceBlock.Body().AppendNewBlock("deployment_circuit_breaker", nil)
-				breakerBlock.Body().SetAttributeValue("enable", cty.BoolVal(service.DeploymentCircuitBreaker.Enable))
-				breakerBlock.Body().SetAttributeValue("rollback", cty.BoolVal(service.DeploymentCircuitBreaker.Rollback))
+		// VPC Attachments
+		attachmentRefs := make(map[string]string)
+		for _, a := range tgw.VpcAttachments {
+			resourceType := "aws_ec2_transit_gateway_vpc_attachment"
+			resourceName := g.sanitize(a.ID)
+			attachmentRefs[a.ID] = resourceName
+			g.appendImportBlock(importBody, resourceType+"."+resourceName, a.ID)
+			attachmentBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+			attachmentBlock.Body().SetAttributeRaw("transit_gateway_id", tgwIDRef)
+			attachmentBlock.Body().SetAttributeValue("vpc_id", cty.StringVal(a.VpcID))
+			attachmentBlock.Body().SetAttributeValue("subnet_ids", g.stringList(a.SubnetIDs))
+			if a.DnsSupport != "" {
+				attachmentBlock.Body().SetAttributeValue("dns_support", cty.StringVal(a.DnsSupport))
+			}
+			if a.Ipv6Support != "" {
+				attachmentBlock.Body().SetAttributeValue("ipv6_support", cty.StringVal(a.Ipv6Support))
 			}
+			if a.ApplianceModeSupport != "" {
+				attachmentBlock.Body().SetAttributeValue("appliance_mode_support", cty.StringVal(a.ApplianceModeSupport))
+			}
+			attachmentBlock.Body().SetAttributeValue("transit_gateway_default_route_table_association", cty.BoolVal(defaultAssociated[a.ID]))
+			attachmentBlock.Body().SetAttributeValue("transit_gateway_default_route_table_propagation", cty.BoolVal(defaultPropagated[a.ID]))
+			if len(a.Tags) > 0 {
+				g.appendTags(attachmentBlock.Body(), a.Tags)
+			}
+		}
 
-			// Network Configuration
-			if service.NetworkConfiguration != nil {
-				netBlock := serviceBlock.Body().AppendNewBlock("network_configuration", nil)
-				var subnetVals []cty.Value
-				for _, subnet := range service.NetworkConfiguration.Subnets {
-					subnetVals = append(subnetVals, cty.StringVal(subnet))
-				}
-				netBlock.Body().SetAttributeValue("subnets", cty.ListVal(subnetVals)) // Use ListVal, TF can handle it.
-				if len(service.NetworkConfiguration.SecurityGroups) > 0 {
-					var sgVals []cty.Value
-					for _, sg := range service.NetworkConfiguration.SecurityGroups {
-						sgVals = append(sgVals, cty.StringVal(sg))
-					}
-					netBlock.Body().SetAttributeValue("security_groups", cty.ListVal(sgVals))
+		// Route Tables
+		for _, rt := range tgw.RouteTables {
+			var rtIDRef hclwrite.Tokens
+			isAssociationDefault := rt.ID == tgw.AssociationDefaultRouteTableID
+			isPropagationDefault := rt.ID == tgw.PropagationDefaultRouteTableID
+			if isAssociationDefault {
+				// デフォルトルートテーブルはTransit Gatewayと共に作成されるため、属性経由で参照します。
+				rtIDRef = g.reference(tgwResourceType, tgwResourceName, "association_default_route_table_id")
+			} else if isPropagationDefault {
+				rtIDRef = g.reference(tgwResourceType, tgwResourceName, "propagation_default_route_table_id")
+			} else {
+				resourceType := "aws_ec2_transit_gateway_route_table"
+				resourceName := g.sanitize(rt.ID)
+				g.appendImportBlock(importBody, resourceType+"."+resourceName, rt.ID)
+				rtBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+				rtBlock.Body().SetAttributeRaw("transit_gateway_id", tgwIDRef)
+				if len(rt.Tags) > 0 {
+					g.appendTags(rtBlock.Body(), rt.Tags)
 				}
-				netBlock.Body().SetAttributeValue("assign_public_ip", cty.BoolVal(service.NetworkConfiguration.AssignPublicIp))
+				rtIDRef = g.reference(resourceType, resourceName, "id")
 			}
 
-			// Tags
-			if len(service.Tags) > 0 {
-				tagMap := make(map[string]cty.Value)
-				for k, v := range service.Tags {
-					tagMap[k] = cty.StringVal(v)
+			if !isAssociationDefault {
+				for _, attachmentID := range rt.AssociationIDs {
+					resourceType := "aws_ec2_transit_gateway_route_table_association"
+					resourceName := g.sanitize(rt.ID + "_" + attachmentID)
+					g.appendImportBlock(importBody, resourceType+"."+resourceName, rt.ID+"_"+attachmentID)
+					assocBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+					g.setReferenceOrValue(assocBlock.Body(), "transit_gateway_attachment_id", attachmentRefs, "aws_ec2_transit_gateway_vpc_attachment", attachmentID)
+					assocBlock.Body().SetAttributeRaw("transit_gateway_route_table_id", rtIDRef)
 				}
-				serviceBlock.Body().SetAttributeValue("tags", cty.MapVal(tagMap))
+			}
+
+			if !isPropagationDefault {
+				for _, attachmentID := range rt.PropagationIDs {
+					resourceType := "aws_ec2_transit_gateway_route_table_propagation"
+					resourceName := g.sanitize(rt.ID + "_" + attachmentID)
+					g.appendImportBlock(importBody, resourceType+"."+resourceName, rt.ID+"_"+attachmentID)
+					propBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+					g.setReferenceOrValue(propBlock.Body(), "transit_gateway_attachment_id", attachmentRefs, "aws_ec2_transit_gateway_vpc_attachment", attachmentID)
+					propBlock.Body().SetAttributeRaw("transit_gateway_route_table_id", rtIDRef)
+				}
+			}
+
+			for _, route := range rt.Routes {
+				resourceType := "aws_ec2_transit_gateway_route"
+				resourceName := g.sanitize(rt.ID + "_" + strings.NewReplacer(".", "_", "/", "_").Replace(route.DestinationCidrBlock))
+				g.appendImportBlock(importBody, resourceType+"."+resourceName, rt.ID+"_"+route.DestinationCidrBlock)
+				routeBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+				routeBlock.Body().SetAttributeValue("destination_cidr_block", cty.StringVal(route.DestinationCidrBlock))
+				routeBlock.Body().SetAttributeRaw("transit_gateway_route_table_id", rtIDRef)
+				if route.Blackhole {
+					routeBlock.Body().SetAttributeValue("blackhole", cty.True)
+				} else if route.AttachmentID != "" {
+					g.setReferenceOrValue(routeBlock.Body(), "transit_gateway_attachment_id", attachmentRefs, "aws_ec2_transit_gateway_vpc_attachment", route.AttachmentID)
+				}
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// UserDataPath はインスタンスのユーザーデータを書き出すファイルのパスを返します。
+func (g *HCLGenerator) UserDataPath(instanceID string) string {
+	return path.Join("user_data", instanceID+".sh")
+}
+
+// isBinaryUserData はユーザーデータがgzip圧縮されているかUTF-8として不正で、file()では読めないかを返します。
+func (g *HCLGenerator) isBinaryUserData(userData string) bool {
+	return strings.HasPrefix(userData, "\x1f\x8b") || !utf8.ValidString(userData)
+}
+
+// GenerateEc2InstanceBlocks はEC2インスタンスと、そのEBSボリューム、セカンダリENI、Elastic IPの
+// resourceブロックとimportブロックを生成します。ユーザーデータはUserDataPathのファイルをfile()で参照し、
+// gzip圧縮されたものやUTF-8でないものは user_data_base64 にfilebase64()で指定します。
+func (g *HCLGenerator) GenerateEc2InstanceBlocks(instances []ec2.Instance) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, inst := range instances {
+		resourceType := "aws_instance"
+		resourceName := g.sanitize(inst.ID)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, inst.ID)
+		instBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		instBlock.Body().SetAttributeValue("ami", cty.StringVal(inst.AmiID))
+		instBlock.Body().SetAttributeValue("instance_type", cty.StringVal(inst.InstanceType))
+		instBlock.Body().SetAttributeValue("subnet_id", cty.StringVal(inst.SubnetID))
+		instBlock.Body().SetAttributeValue("private_ip", cty.StringVal(inst.PrivateIp))
+		if len(inst.SecurityGroupIDs) > 0 {
+			instBlock.Body().SetAttributeValue("vpc_security_group_ids", g.stringList(inst.SecurityGroupIDs))
+		}
+		if inst.KeyName != "" {
+			instBlock.Body().SetAttributeValue("key_name", cty.StringVal(inst.KeyName))
+		}
+		if inst.IamInstanceProfile != "" {
+			instBlock.Body().SetAttributeValue("iam_instance_profile", cty.StringVal(inst.IamInstanceProfile))
+		}
+		instBlock.Body().SetAttributeValue("ebs_optimized", cty.BoolVal(inst.EbsOptimized))
+		instBlock.Body().SetAttributeValue("monitoring", cty.BoolVal(inst.Monitoring))
+		instBlock.Body().SetAttributeValue("source_dest_check", cty.BoolVal(inst.SourceDestCheck))
+		if inst.UserData != "" {
+			if g.isBinaryUserData(inst.UserData) {
+				instBlock.Body().SetAttributeRaw("user_data_base64", g.fileFunction("filebase64", g.UserDataPath(inst.ID)))
+			} else {
+				instBlock.Body().SetAttributeRaw("user_data", g.fileFunction("file", g.UserDataPath(inst.ID)))
+			}
+		}
+
+		if rbd := inst.RootBlockDevice; rbd != nil {
+			rbdBlock := instBlock.Body().AppendNewBlock("root_block_device", nil)
+			g.appendEbsAttributes(rbdBlock.Body(), *rbd, "volume_size", "volume_type")
+			rbdBlock.Body().SetAttributeValue("delete_on_termination", cty.BoolVal(rbd.DeleteOnTermination))
+		}
+
+		if mo := inst.MetadataOptions; mo != nil {
+			moBlock := instBlock.Body().AppendNewBlock("metadata_options", nil)
+			moBlock.Body().SetAttributeValue("http_endpoint", cty.StringVal(mo.HttpEndpoint))
+			moBlock.Body().SetAttributeValue("http_tokens", cty.StringVal(mo.HttpTokens))
+			moBlock.Body().SetAttributeValue("http_put_response_hop_limit", cty.NumberIntVal(int64(mo.HttpPutResponseHopLimit)))
+			moBlock.Body().SetAttributeValue("instance_metadata_tags", cty.StringVal(mo.InstanceMetadataTags))
+		}
+
+		if len(inst.Tags) > 0 {
+			g.appendTags(instBlock.Body(), inst.Tags)
+		}
+		instanceIDRef := g.reference(resourceType, resourceName, "id")
+
+		// EBS Volumes
+		for _, vol := range inst.EbsVolumes {
+			volResourceType := "aws_ebs_volume"
+			volResourceName := g.sanitize(vol.ID)
+			g.appendImportBlock(importBody, volResourceType+"."+volResourceName, vol.ID)
+			volBlock := g.appendResourceBlock(resourceBody, volResourceType, volResourceName)
+			volBlock.Body().SetAttributeValue("availability_zone", cty.StringVal(vol.AvailabilityZone))
+			g.appendEbsAttributes(volBlock.Body(), vol, "size", "type")
+			if vol.SnapshotID != "" {
+				volBlock.Body().SetAttributeValue("snapshot_id", cty.StringVal(vol.SnapshotID))
+			}
+			if len(vol.Tags) > 0 {
+				g.appendTags(volBlock.Body(), vol.Tags)
+			}
+
+			attachResourceType := "aws_volume_attachment"
+			g.appendImportBlock(importBody, attachResourceType+"."+volResourceName, fmt.Sprintf("%s:%s:%s", vol.DeviceName, vol.ID, inst.ID))
+			attachBlock := g.appendResourceBlock(resourceBody, attachResourceType, volResourceName)
+			attachBlock.Body().SetAttributeValue("device_name", cty.StringVal(vol.DeviceName))
+			attachBlock.Body().SetAttributeRaw("volume_id", g.reference(volResourceType, volResourceName, "id"))
+			attachBlock.Body().SetAttributeRaw("instance_id", instanceIDRef)
+		}
+
+		// Secondary Network Interfaces
+		eniRefs := make(map[string]string)
+		for _, eni := range inst.NetworkInterfaces {
+			eniResourceType := "aws_network_interface"
+			eniResourceName := g.sanitize(eni.ID)
+			eniRefs[eni.ID] = eniResourceName
+			g.appendImportBlock(importBody, eniResourceType+"."+eniResourceName, eni.ID)
+			eniBlock := g.appendResourceBlock(resourceBody, eniResourceType, eniResourceName)
+			eniBlock.Body().SetAttributeValue("subnet_id", cty.StringVal(eni.SubnetID))
+			if eni.Description != "" {
+				eniBlock.Body().SetAttributeValue("description", cty.StringVal(eni.Description))
+			}
+			eniBlock.Body().SetAttributeValue("private_ips", g.stringList(eni.PrivateIps))
+			if len(eni.SecurityGroupIDs) > 0 {
+				eniBlock.Body().SetAttributeValue("security_groups", g.stringList(eni.SecurityGroupIDs))
+			}
+			eniBlock.Body().SetAttributeValue("source_dest_check", cty.BoolVal(eni.SourceDestCheck))
+			attachmentBlock := eniBlock.Body().AppendNewBlock("attachment", nil)
+			attachmentBlock.Body().SetAttributeRaw("instance", instanceIDRef)
+			attachmentBlock.Body().SetAttributeValue("device_index", cty.NumberIntVal(int64(eni.DeviceIndex)))
+			if len(eni.Tags) > 0 {
+				g.appendTags(eniBlock.Body(), eni.Tags)
+			}
+		}
+
+		// Elastic IPs
+		for _, eip := range inst.Eips {
+			eipResourceType := "aws_eip"
+			eipResourceName := g.sanitize(eip.AllocationID)
+			g.appendImportBlock(importBody, eipResourceType+"."+eipResourceName, eip.AllocationID)
+			eipBlock := g.appendResourceBlock(resourceBody, eipResourceType, eipResourceName)
+			eipBlock.Body().SetAttributeValue("domain", cty.StringVal("vpc"))
+			if len(eip.Tags) > 0 {
+				g.appendTags(eipBlock.Body(), eip.Tags)
+			}
+
+			if eip.AssociationID == "" {
+				continue
+			}
+			assocResourceType := "aws_eip_association"
+			g.appendImportBlock(importBody, assocResourceType+"."+eipResourceName, eip.AssociationID)
+			assocBlock := g.appendResourceBlock(resourceBody, assocResourceType, eipResourceName)
+			assocBlock.Body().SetAttributeRaw("allocation_id", g.reference(eipResourceType, eipResourceName, "id"))
+			if _, ok := eniRefs[eip.NetworkInterfaceID]; ok {
+				g.setReferenceOrValue(assocBlock.Body(), "network_interface_id", eniRefs, "aws_network_interface", eip.NetworkInterfaceID)
+				assocBlock.Body().SetAttributeValue("private_ip_address", cty.StringVal(eip.PrivateIpAddress))
+			} else {
+				assocBlock.Body().SetAttributeRaw("instance_id", instanceIDRef)
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// appendEbsAttributes はaws_ebs_volumeとroot_block_deviceで共通のボリューム属性を設定します。
+// サイズと種類の属性名はリソースによって異なるため引数で受け取ります。
+func (g *HCLGenerator) appendEbsAttributes(body *hclwrite.Body, vol ec2.EbsVolume, sizeAttr, typeAttr string) {
+	body.SetAttributeValue(sizeAttr, cty.NumberIntVal(int64(vol.Size)))
+	body.SetAttributeValue(typeAttr, cty.StringVal(vol.Type))
+	if vol.Type == "io1" || vol.Type == "io2" || vol.Type == "gp3" {
+		body.SetAttributeValue("iops", cty.NumberIntVal(int64(vol.Iops)))
+	}
+	if vol.Type == "gp3" {
+		body.SetAttributeValue("throughput", cty.NumberIntVal(int64(vol.Throughput)))
+	}
+	body.SetAttributeValue("encrypted", cty.BoolVal(vol.Encrypted))
+	if vol.KmsKeyID != "" {
+		body.SetAttributeValue("kms_key_id", cty.StringVal(vol.KmsKeyID))
+	}
+}
+
+// GenerateLaunchTemplateBlocks は起動テンプレートのresourceブロックとimportブロックを生成します。
+// ユーザーデータはUserDataPathのファイルをfilebase64()で参照します。
+func (g *HCLGenerator) GenerateLaunchTemplateBlocks(templates []ec2.LaunchTemplate) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+
+	g.appendLaunchTemplates(resourceFile.Body(), importFile.Body(), templates)
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateAutoScalingBlocks はAuto Scalingグループと、そのスケーリングポリシー、ライフサイクルフック、
+// スケジュールアクション、ターゲットグループへのアタッチメントのブロックを生成します。
+// templatesにはグループが参照する起動テンプレートを渡し、同じファイルに出力して参照で結びます。
+// emitted に含まれるIDの起動テンプレートは GenerateLaunchTemplateBlocks が出力するため、参照のみにします。
+func (g *HCLGenerator) GenerateAutoScalingBlocks(asgs []autoscaling.AutoScalingGroup, templates []ec2.LaunchTemplate, emitted map[string]struct{}) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	ltRefs := make(map[string]string)
+	var ltTemplates []ec2.LaunchTemplate
+	for _, lt := range templates {
+		if _, ok := emitted[lt.ID]; ok {
+			ltRefs[lt.ID] = g.sanitize(lt.ID)
+			continue
+		}
+		ltTemplates = append(ltTemplates, lt)
+	}
+	for id, name := range g.appendLaunchTemplates(resourceBody, importBody, ltTemplates) {
+		ltRefs[id] = name
+	}
+
+	for _, asg := range asgs {
+		resourceType := "aws_autoscaling_group"
+		resourceName := g.sanitize(asg.Name)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, asg.Name)
+		asgBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		asgBlock.Body().SetAttributeValue("name", cty.StringVal(asg.Name))
+		asgBlock.Body().SetAttributeValue("min_size", cty.NumberIntVal(int64(asg.MinSize)))
+		asgBlock.Body().SetAttributeValue("max_size", cty.NumberIntVal(int64(asg.MaxSize)))
+		asgBlock.Body().SetAttributeValue("desired_capacity", cty.NumberIntVal(int64(asg.DesiredCapacity)))
+		asgBlock.Body().SetAttributeValue("default_cooldown", cty.NumberIntVal(int64(asg.DefaultCooldown)))
+		asgBlock.Body().SetAttributeValue("health_check_type", cty.StringVal(asg.HealthCheckType))
+		asgBlock.Body().SetAttributeValue("health_check_grace_period", cty.NumberIntVal(int64(asg.HealthCheckGracePeriod)))
+		if len(asg.SubnetIDs) > 0 {
+			asgBlock.Body().SetAttributeValue("vpc_zone_identifier", g.stringList(asg.SubnetIDs))
+		} else {
+			asgBlock.Body().SetAttributeValue("availability_zones", g.stringList(asg.AvailabilityZones))
+		}
+		if len(asg.TerminationPolicies) > 0 {
+			asgBlock.Body().SetAttributeValue("termination_policies", g.stringList(asg.TerminationPolicies))
+		}
+
+		if asg.LaunchTemplateID != "" || asg.LaunchTemplateName != "" {
+			ltBlock := asgBlock.Body().AppendNewBlock("launch_template", nil)
+			if asg.LaunchTemplateID != "" {
+				g.setReferenceOrValue(ltBlock.Body(), "id", ltRefs, "aws_launch_template", asg.LaunchTemplateID)
+			} else {
+				ltBlock.Body().SetAttributeValue("name", cty.StringVal(asg.LaunchTemplateName))
+			}
+			if asg.LaunchTemplateVersion != "" {
+				ltBlock.Body().SetAttributeValue("version", cty.StringVal(asg.LaunchTemplateVersion))
+			}
+		}
+		if mip := asg.MixedInstancesPolicy; mip != nil {
+			g.appendMixedInstancesPolicy(asgBlock.Body(), *mip, ltRefs)
+		}
+
+		for _, tag := range asg.Tags {
+			tagBlock := asgBlock.Body().AppendNewBlock("tag", nil)
+			tagBlock.Body().SetAttributeValue("key", cty.StringVal(tag.Key))
+			tagBlock.Body().SetAttributeValue("value", cty.StringVal(tag.Value))
+			tagBlock.Body().SetAttributeValue("propagate_at_launch", cty.BoolVal(tag.PropagateAtLaunch))
+		}
+
+		// アタッチメントを別リソースで管理するため、グループ側の属性は無視します。
+		if len(asg.TargetGroupARNs) > 0 || len(asg.LoadBalancerNames) > 0 {
+			g.appendIgnoreChanges(asgBlock.Body(), g.ignoreAttrs("load_balancers", "target_group_arns")...)
+		}
+		asgNameRef := g.reference(resourceType, resourceName, "name")
+
+		// Target group attachments (importには対応していないため、resourceブロックのみ出力します)
+		for i, tgArn := range asg.TargetGroupARNs {
+			attachBlock := g.appendResourceBlock(resourceBody, "aws_autoscaling_attachment", fmt.Sprintf("%s_tg_%d", resourceName, i))
+			attachBlock.Body().SetAttributeRaw("autoscaling_group_name", asgNameRef)
+			attachBlock.Body().SetAttributeValue("lb_target_group_arn", cty.StringVal(tgArn))
+		}
+		for i, lbName := range asg.LoadBalancerNames {
+			attachBlock := g.appendResourceBlock(resourceBody, "aws_autoscaling_attachment", fmt.Sprintf("%s_elb_%d", resourceName, i))
+			attachBlock.Body().SetAttributeRaw("autoscaling_group_name", asgNameRef)
+			attachBlock.Body().SetAttributeValue("elb", cty.StringVal(lbName))
+		}
+
+		// Scaling policies
+		for _, policy := range asg.Policies {
+			policyResourceType := "aws_autoscaling_policy"
+			policyResourceName := g.sanitize(asg.Name + "_" + policy.Name)
+			g.appendImportBlock(importBody, policyResourceType+"."+policyResourceName, asg.Name+"/"+policy.Name)
+			policyBlock := g.appendResourceBlock(resourceBody, policyResourceType, policyResourceName)
+			policyBlock.Body().SetAttributeValue("name", cty.StringVal(policy.Name))
+			policyBlock.Body().SetAttributeRaw("autoscaling_group_name", asgNameRef)
+			policyBlock.Body().SetAttributeValue("policy_type", cty.StringVal(policy.PolicyType))
+			if policy.AdjustmentType != "" {
+				policyBlock.Body().SetAttributeValue("adjustment_type", cty.StringVal(policy.AdjustmentType))
+			}
+			if policy.ScalingAdjustment != nil {
+				policyBlock.Body().SetAttributeValue("scaling_adjustment", cty.NumberIntVal(int64(*policy.ScalingAdjustment)))
+			}
+			if policy.Cooldown != nil {
+				policyBlock.Body().SetAttributeValue("cooldown", cty.NumberIntVal(int64(*policy.Cooldown)))
+			}
+			if policy.EstimatedInstanceWarmup != nil {
+				policyBlock.Body().SetAttributeValue("estimated_instance_warmup", cty.NumberIntVal(int64(*policy.EstimatedInstanceWarmup)))
+			}
+			if policy.MinAdjustmentMagnitude != nil {
+				policyBlock.Body().SetAttributeValue("min_adjustment_magnitude", cty.NumberIntVal(int64(*policy.MinAdjustmentMagnitude)))
+			}
+			if policy.MetricAggregationType != "" {
+				policyBlock.Body().SetAttributeValue("metric_aggregation_type", cty.StringVal(policy.MetricAggregationType))
+			}
+			for _, step := range policy.StepAdjustments {
+				stepBlock := policyBlock.Body().AppendNewBlock("step_adjustment", nil)
+				stepBlock.Body().SetAttributeValue("scaling_adjustment", cty.NumberIntVal(int64(step.ScalingAdjustment)))
+				if step.MetricIntervalLowerBound != nil {
+					stepBlock.Body().SetAttributeValue("metric_interval_lower_bound", cty.NumberFloatVal(*step.MetricIntervalLowerBound))
+				}
+				if step.MetricIntervalUpperBound != nil {
+					stepBlock.Body().SetAttributeValue("metric_interval_upper_bound", cty.NumberFloatVal(*step.MetricIntervalUpperBound))
+				}
+			}
+			if tt := policy.TargetTracking; tt != nil {
+				ttBlock := policyBlock.Body().AppendNewBlock("target_tracking_configuration", nil)
+				ttBlock.Body().SetAttributeValue("target_value", cty.NumberFloatVal(tt.TargetValue))
+				ttBlock.Body().SetAttributeValue("disable_scale_in", cty.BoolVal(tt.DisableScaleIn))
+				if tt.PredefinedMetricType != "" {
+					pmBlock := ttBlock.Body().AppendNewBlock("predefined_metric_specification", nil)
+					pmBlock.Body().SetAttributeValue("predefined_metric_type", cty.StringVal(tt.PredefinedMetricType))
+					if tt.ResourceLabel != "" {
+						pmBlock.Body().SetAttributeValue("resource_label", cty.StringVal(tt.ResourceLabel))
+					}
+				}
+				if cm := tt.CustomizedMetric; cm != nil {
+					g.appendAsgCustomizedMetricSpecification(ttBlock.Body(), *cm)
+				}
+			}
+		}
+
+		// Lifecycle hooks
+		for _, hook := range asg.LifecycleHooks {
+			hookResourceType := "aws_autoscaling_lifecycle_hook"
+			hookResourceName := g.sanitize(asg.Name + "_" + hook.Name)
+			g.appendImportBlock(importBody, hookResourceType+"."+hookResourceName, asg.Name+"/"+hook.Name)
+			hookBlock := g.appendResourceBlock(resourceBody, hookResourceType, hookResourceName)
+			hookBlock.Body().SetAttributeValue("name", cty.StringVal(hook.Name))
+			hookBlock.Body().SetAttributeRaw("autoscaling_group_name", asgNameRef)
+			hookBlock.Body().SetAttributeValue("lifecycle_transition", cty.StringVal(hook.LifecycleTransition))
+			hookBlock.Body().SetAttributeValue("default_result", cty.StringVal(hook.DefaultResult))
+			hookBlock.Body().SetAttributeValue("heartbeat_timeout", cty.NumberIntVal(int64(hook.HeartbeatTimeout)))
+			if hook.NotificationMetadata != "" {
+				hookBlock.Body().SetAttributeValue("notification_metadata", cty.StringVal(hook.NotificationMetadata))
+			}
+			if hook.NotificationTargetARN != "" {
+				hookBlock.Body().SetAttributeValue("notification_target_arn", cty.StringVal(hook.NotificationTargetARN))
+			}
+			if hook.RoleARN != "" {
+				hookBlock.Body().SetAttributeValue("role_arn", cty.StringVal(hook.RoleARN))
+			}
+		}
+
+		// Scheduled actions
+		for _, action := range asg.ScheduledActions {
+			scheduleResourceType := "aws_autoscaling_schedule"
+			scheduleResourceName := g.sanitize(asg.Name + "_" + action.Name)
+			g.appendImportBlock(importBody, scheduleResourceType+"."+scheduleResourceName, asg.Name+"/"+action.Name)
+			scheduleBlock := g.appendResourceBlock(resourceBody, scheduleResourceType, scheduleResourceName)
+			scheduleBlock.Body().SetAttributeValue("scheduled_action_name", cty.StringVal(action.Name))
+			scheduleBlock.Body().SetAttributeRaw("autoscaling_group_name", asgNameRef)
+			if action.MinSize != nil {
+				scheduleBlock.Body().SetAttributeValue("min_size", cty.NumberIntVal(int64(*action.MinSize)))
+			}
+			if action.MaxSize != nil {
+				scheduleBlock.Body().SetAttributeValue("max_size", cty.NumberIntVal(int64(*action.MaxSize)))
+			}
+			if action.DesiredCapacity != nil {
+				scheduleBlock.Body().SetAttributeValue("desired_capacity", cty.NumberIntVal(int64(*action.DesiredCapacity)))
+			}
+			if action.Recurrence != "" {
+				scheduleBlock.Body().SetAttributeValue("recurrence", cty.StringVal(action.Recurrence))
+			}
+			if action.StartTime != "" {
+				scheduleBlock.Body().SetAttributeValue("start_time", cty.StringVal(action.StartTime))
+			}
+			if action.EndTime != "" {
+				scheduleBlock.Body().SetAttributeValue("end_time", cty.StringVal(action.EndTime))
+			}
+			if action.TimeZone != "" {
+				scheduleBlock.Body().SetAttributeValue("time_zone", cty.StringVal(action.TimeZone))
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// appendMixedInstancesPolicy は Auto Scalingグループに mixed_instances_policy ブロックを追加します。
+func (g *HCLGenerator) appendMixedInstancesPolicy(body *hclwrite.Body, mip autoscaling.MixedInstancesPolicy, ltRefs map[string]string) {
+	mipBlock := body.AppendNewBlock("mixed_instances_policy", nil)
+
+	ltBlock := mipBlock.Body().AppendNewBlock("launch_template", nil)
+	specBlock := ltBlock.Body().AppendNewBlock("launch_template_specification", nil)
+	g.appendLaunchTemplateSpecification(specBlock.Body(), mip.LaunchTemplate, ltRefs)
+	for _, o := range mip.Overrides {
+		overrideBlock := ltBlock.Body().AppendNewBlock("override", nil)
+		if o.InstanceType != "" {
+			overrideBlock.Body().SetAttributeValue("instance_type", cty.StringVal(o.InstanceType))
+		}
+		if o.WeightedCapacity != "" {
+			overrideBlock.Body().SetAttributeValue("weighted_capacity", cty.StringVal(o.WeightedCapacity))
+		}
+		if o.LaunchTemplate != nil {
+			overrideSpecBlock := overrideBlock.Body().AppendNewBlock("launch_template_specification", nil)
+			g.appendLaunchTemplateSpecification(overrideSpecBlock.Body(), *o.LaunchTemplate, ltRefs)
+		}
+	}
+
+	d := mip.InstancesDistribution
+	if d == nil {
+		return
+	}
+	distBlock := mipBlock.Body().AppendNewBlock("instances_distribution", nil)
+	if d.OnDemandAllocationStrategy != "" {
+		distBlock.Body().SetAttributeValue("on_demand_allocation_strategy", cty.StringVal(d.OnDemandAllocationStrategy))
+	}
+	if d.OnDemandBaseCapacity != nil {
+		distBlock.Body().SetAttributeValue("on_demand_base_capacity", cty.NumberIntVal(int64(*d.OnDemandBaseCapacity)))
+	}
+	if d.OnDemandPercentageAboveBaseCapacity != nil {
+		distBlock.Body().SetAttributeValue("on_demand_percentage_above_base_capacity", cty.NumberIntVal(int64(*d.OnDemandPercentageAboveBaseCapacity)))
+	}
+	if d.SpotAllocationStrategy != "" {
+		distBlock.Body().SetAttributeValue("spot_allocation_strategy", cty.StringVal(d.SpotAllocationStrategy))
+	}
+	if d.SpotInstancePools != nil {
+		distBlock.Body().SetAttributeValue("spot_instance_pools", cty.NumberIntVal(int64(*d.SpotInstancePools)))
+	}
+	if d.SpotMaxPrice != "" {
+		distBlock.Body().SetAttributeValue("spot_max_price", cty.StringVal(d.SpotMaxPrice))
+	}
+}
+
+// appendLaunchTemplateSpecification は launch_template_specification ブロックに起動テンプレートの
+// launch_template_id (出力済みなら参照) または launch_template_name と version を設定します。
+func (g *HCLGenerator) appendLaunchTemplateSpecification(body *hclwrite.Body, spec autoscaling.LaunchTemplateSpecification, ltRefs map[string]string) {
+	if spec.ID != "" {
+		g.setReferenceOrValue(body, "launch_template_id", ltRefs, "aws_launch_template", spec.ID)
+	} else {
+		body.SetAttributeValue("launch_template_name", cty.StringVal(spec.Name))
+	}
+	if spec.Version != "" {
+		body.SetAttributeValue("version", cty.StringVal(spec.Version))
+	}
+}
+
+// appendAsgCustomizedMetricSpecification は aws_autoscaling_policy の customized_metric_specification ブロックを追加します。
+func (g *HCLGenerator) appendAsgCustomizedMetricSpecification(body *hclwrite.Body, cm autoscaling.CustomizedMetricSpecification) {
+	cmBlock := body.AppendNewBlock("customized_metric_specification", nil)
+	g.appendMetricAttributes(cmBlock.Body(), cm.MetricName, cm.Namespace, cm.Statistic, cm.Unit)
+	for _, d := range cm.Dimensions {
+		dBlock := cmBlock.Body().AppendNewBlock("metric_dimension", nil)
+		dBlock.Body().SetAttributeValue("name", cty.StringVal(d.Name))
+		dBlock.Body().SetAttributeValue("value", cty.StringVal(d.Value))
+	}
+	for _, q := range cm.Metrics {
+		qBlock := cmBlock.Body().AppendNewBlock("metrics", nil)
+		g.appendMetricDataQueryAttributes(qBlock.Body(), q.ID, q.Expression, q.Label, q.ReturnData)
+		if ms := q.MetricStat; ms != nil {
+			msBlock := qBlock.Body().AppendNewBlock("metric_stat", nil)
+			msBlock.Body().SetAttributeValue("stat", cty.StringVal(ms.Stat))
+			if ms.Unit != "" {
+				msBlock.Body().SetAttributeValue("unit", cty.StringVal(ms.Unit))
+			}
+			mBlock := msBlock.Body().AppendNewBlock("metric", nil)
+			mBlock.Body().SetAttributeValue("metric_name", cty.StringVal(ms.MetricName))
+			mBlock.Body().SetAttributeValue("namespace", cty.StringVal(ms.Namespace))
+			for _, d := range ms.Dimensions {
+				dBlock := mBlock.Body().AppendNewBlock("dimensions", nil)
+				dBlock.Body().SetAttributeValue("name", cty.StringVal(d.Name))
+				dBlock.Body().SetAttributeValue("value", cty.StringVal(d.Value))
+			}
+		}
+	}
+}
+
+// appendAppCustomizedMetricSpecification は aws_appautoscaling_policy の customized_metric_specification ブロックを追加します。
+func (g *HCLGenerator) appendAppCustomizedMetricSpecification(body *hclwrite.Body, cm applicationautoscaling.CustomizedMetricSpecification) {
+	cmBlock := body.AppendNewBlock("customized_metric_specification", nil)
+	g.appendMetricAttributes(cmBlock.Body(), cm.MetricName, cm.Namespace, cm.Statistic, cm.Unit)
+	for _, d := range cm.Dimensions {
+		dBlock := cmBlock.Body().AppendNewBlock("dimensions", nil)
+		dBlock.Body().SetAttributeValue("name", cty.StringVal(d.Name))
+		dBlock.Body().SetAttributeValue("value", cty.StringVal(d.Value))
+	}
+	for _, q := range cm.Metrics {
+		qBlock := cmBlock.Body().AppendNewBlock("metrics", nil)
+		g.appendMetricDataQueryAttributes(qBlock.Body(), q.ID, q.Expression, q.Label, q.ReturnData)
+		if ms := q.MetricStat; ms != nil {
+			msBlock := qBlock.Body().AppendNewBlock("metric_stat", nil)
+			msBlock.Body().SetAttributeValue("stat", cty.StringVal(ms.Stat))
+			if ms.Unit != "" {
+				msBlock.Body().SetAttributeValue("unit", cty.StringVal(ms.Unit))
+			}
+			mBlock := msBlock.Body().AppendNewBlock("metric", nil)
+			mBlock.Body().SetAttributeValue("metric_name", cty.StringVal(ms.MetricName))
+			mBlock.Body().SetAttributeValue("namespace", cty.StringVal(ms.Namespace))
+			for _, d := range ms.Dimensions {
+				dBlock := mBlock.Body().AppendNewBlock("dimensions", nil)
+				dBlock.Body().SetAttributeValue("name", cty.StringVal(d.Name))
+				dBlock.Body().SetAttributeValue("value", cty.StringVal(d.Value))
+			}
+		}
+	}
+}
+
+// appendMetricAttributes は単一のメトリクスを使うカスタムメトリクスの属性を設定します。メトリクス計算の場合は何も設定しません。
+func (g *HCLGenerator) appendMetricAttributes(body *hclwrite.Body, metricName, namespace, statistic, unit string) {
+	if metricName == "" {
+		return
+	}
+	body.SetAttributeValue("metric_name", cty.StringVal(metricName))
+	body.SetAttributeValue("namespace", cty.StringVal(namespace))
+	body.SetAttributeValue("statistic", cty.StringVal(statistic))
+	if unit != "" {
+		body.SetAttributeValue("unit", cty.StringVal(unit))
+	}
+}
+
+// appendMetricDataQueryAttributes はメトリクス計算のクエリの属性を設定します。
+func (g *HCLGenerator) appendMetricDataQueryAttributes(body *hclwrite.Body, id, expression, label string, returnData *bool) {
+	body.SetAttributeValue("id", cty.StringVal(id))
+	if expression != "" {
+		body.SetAttributeValue("expression", cty.StringVal(expression))
+	}
+	if label != "" {
+		body.SetAttributeValue("label", cty.StringVal(label))
+	}
+	if returnData != nil {
+		body.SetAttributeValue("return_data", cty.BoolVal(*returnData))
+	}
+}
+
+// appendLaunchTemplates は起動テンプレートのブロックを追加し、IDからリソース名への対応を返します。
+func (g *HCLGenerator) appendLaunchTemplates(resourceBody, importBody *hclwrite.Body, templates []ec2.LaunchTemplate) map[string]string {
+	ltRefs := make(map[string]string)
+	for _, lt := range templates {
+		resourceType := "aws_launch_template"
+		resourceName := g.sanitize(lt.ID)
+		ltRefs[lt.ID] = resourceName
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, lt.ID)
+		ltBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		ltBlock.Body().SetAttributeValue("name", cty.StringVal(lt.Name))
+		if lt.Description != "" {
+			ltBlock.Body().SetAttributeValue("description", cty.StringVal(lt.Description))
+		}
+		if lt.ImageID != "" {
+			ltBlock.Body().SetAttributeValue("image_id", cty.StringVal(lt.ImageID))
+		}
+		if lt.InstanceType != "" {
+			ltBlock.Body().SetAttributeValue("instance_type", cty.StringVal(lt.InstanceType))
+		}
+		if lt.KeyName != "" {
+			ltBlock.Body().SetAttributeValue("key_name", cty.StringVal(lt.KeyName))
+		}
+		if len(lt.SecurityGroupIDs) > 0 {
+			ltBlock.Body().SetAttributeValue("vpc_security_group_ids", g.stringList(lt.SecurityGroupIDs))
+		}
+		if lt.EbsOptimized != nil {
+			ltBlock.Body().SetAttributeValue("ebs_optimized", cty.StringVal(fmt.Sprintf("%t", *lt.EbsOptimized)))
+		}
+		if lt.UserData != "" {
+			ltBlock.Body().SetAttributeRaw("user_data", g.fileFunction("filebase64", g.UserDataPath(lt.ID)))
+		}
+		if lt.IamInstanceProfile != "" {
+			profileBlock := ltBlock.Body().AppendNewBlock("iam_instance_profile", nil)
+			profileBlock.Body().SetAttributeValue("name", cty.StringVal(lt.IamInstanceProfile))
+		}
+		if lt.Monitoring {
+			monitoringBlock := ltBlock.Body().AppendNewBlock("monitoring", nil)
+			monitoringBlock.Body().SetAttributeValue("enabled", cty.True)
+		}
+
+		for _, bdm := range lt.BlockDeviceMappings {
+			bdmBlock := ltBlock.Body().AppendNewBlock("block_device_mappings", nil)
+			bdmBlock.Body().SetAttributeValue("device_name", cty.StringVal(bdm.DeviceName))
+			ebsBlock := bdmBlock.Body().AppendNewBlock("ebs", nil)
+			if bdm.VolumeSize > 0 {
+				ebsBlock.Body().SetAttributeValue("volume_size", cty.NumberIntVal(int64(bdm.VolumeSize)))
+			}
+			if bdm.VolumeType != "" {
+				ebsBlock.Body().SetAttributeValue("volume_type", cty.StringVal(bdm.VolumeType))
+			}
+			if bdm.Iops > 0 {
+				ebsBlock.Body().SetAttributeValue("iops", cty.NumberIntVal(int64(bdm.Iops)))
+			}
+			if bdm.Throughput > 0 {
+				ebsBlock.Body().SetAttributeValue("throughput", cty.NumberIntVal(int64(bdm.Throughput)))
+			}
+			if bdm.Encrypted != nil {
+				ebsBlock.Body().SetAttributeValue("encrypted", cty.StringVal(fmt.Sprintf("%t", *bdm.Encrypted)))
+			}
+			if bdm.KmsKeyID != "" {
+				ebsBlock.Body().SetAttributeValue("kms_key_id", cty.StringVal(bdm.KmsKeyID))
+			}
+			if bdm.SnapshotID != "" {
+				ebsBlock.Body().SetAttributeValue("snapshot_id", cty.StringVal(bdm.SnapshotID))
+			}
+			if bdm.DeleteOnTermination != nil {
+				ebsBlock.Body().SetAttributeValue("delete_on_termination", cty.StringVal(fmt.Sprintf("%t", *bdm.DeleteOnTermination)))
+			}
+		}
+
+		for _, ni := range lt.NetworkInterfaces {
+			niBlock := ltBlock.Body().AppendNewBlock("network_interfaces", nil)
+			niBlock.Body().SetAttributeValue("device_index", cty.NumberIntVal(int64(ni.DeviceIndex)))
+			if ni.SubnetID != "" {
+				niBlock.Body().SetAttributeValue("subnet_id", cty.StringVal(ni.SubnetID))
+			}
+			if ni.Description != "" {
+				niBlock.Body().SetAttributeValue("description", cty.StringVal(ni.Description))
+			}
+			if len(ni.SecurityGroupIDs) > 0 {
+				niBlock.Body().SetAttributeValue("security_groups", g.stringList(ni.SecurityGroupIDs))
+			}
+			if ni.AssociatePublicIpAddress != nil {
+				niBlock.Body().SetAttributeValue("associate_public_ip_address", cty.StringVal(fmt.Sprintf("%t", *ni.AssociatePublicIpAddress)))
+			}
+			if ni.DeleteOnTermination != nil {
+				niBlock.Body().SetAttributeValue("delete_on_termination", cty.StringVal(fmt.Sprintf("%t", *ni.DeleteOnTermination)))
+			}
+		}
+
+		if mo := lt.MetadataOptions; mo != nil {
+			moBlock := ltBlock.Body().AppendNewBlock("metadata_options", nil)
+			if mo.HttpEndpoint != "" {
+				moBlock.Body().SetAttributeValue("http_endpoint", cty.StringVal(mo.HttpEndpoint))
+			}
+			if mo.HttpTokens != "" {
+				moBlock.Body().SetAttributeValue("http_tokens", cty.StringVal(mo.HttpTokens))
+			}
+			if mo.HttpPutResponseHopLimit > 0 {
+				moBlock.Body().SetAttributeValue("http_put_response_hop_limit", cty.NumberIntVal(int64(mo.HttpPutResponseHopLimit)))
+			}
+			if mo.InstanceMetadataTags != "" {
+				moBlock.Body().SetAttributeValue("instance_metadata_tags", cty.StringVal(mo.InstanceMetadataTags))
+			}
+		}
+
+		for _, ts := range lt.TagSpecifications {
+			tsBlock := ltBlock.Body().AppendNewBlock("tag_specifications", nil)
+			tsBlock.Body().SetAttributeValue("resource_type", cty.StringVal(ts.ResourceType))
+			if len(ts.Tags) > 0 {
+				g.appendTags(tsBlock.Body(), ts.Tags)
+			}
+		}
+
+		if len(lt.Tags) > 0 {
+			g.appendTags(ltBlock.Body(), lt.Tags)
+		}
+	}
+	return ltRefs
+}
+
+// GenerateS3BucketBlocks はS3バケットリソースのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateS3BucketBlocks(buckets []s3.Bucket) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, bucket := range buckets {
+		resourceName := bucket.Name
+		resourceType := "aws_s3_bucket"
+
+		// importブロックの生成
+		importBlock := importBody.AppendNewBlock("import", nil)
+		importBody.AppendNewline()
+		importBlock.Body().SetAttributeValue("to", cty.StringVal(resourceType+"."+resourceName))
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(bucket.Name))
+
+		// resourceブロックの生成
+		bucketBlock := resourceBody.AppendNewBlock("resource", []string{resourceType, resourceName})
+		resourceBody.AppendNewline()
+		bucketBlock.Body().SetAttributeValue("bucket", cty.StringVal(bucket.Name))
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// EcsReferences はECSリソースから参照する、同じ実行で生成される他リソースの情報を保持します。
+type EcsReferences struct {
+	// Roles はIAMロールのARNからロール名への対応です。
+	Roles map[string]string
+	// AutoScalingGroups はAuto Scalingグループ名の集合です。
+	AutoScalingGroups map[string]struct{}
+}
+
+// EcsRelatedResources はECSリソースと同じファイルに出力する関連リソースを保持します。
+type EcsRelatedResources struct {
+	// ScalableTargets はサービスのApplication Auto Scalingターゲットです。
+	ScalableTargets []applicationautoscaling.ScalableTarget
+	// Namespaces はService Connectやサービス検出で使うCloud Map名前空間です。
+	Namespaces []servicediscovery.Namespace
+	// DiscoveryServices はサービスのserviceRegistriesが参照するCloud Mapサービスです。
+	DiscoveryServices []servicediscovery.Service
+}
+
+// GenerateEcsBlocks はECSリソースのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateEcsBlocks(clusters []ecs.Cluster, refs EcsReferences, related EcsRelatedResources) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	// Task Definitions (複数のサービスで共有されるため、ARNごとに1つだけ出力します)
+	taskDefRefs := make(map[string]string)
+	taskDefNames := make(map[string]struct{})
+	for _, cluster := range clusters {
+		for _, service := range cluster.Services {
+			td := service.TaskDefinition
+			if td == nil {
+				continue
+			}
+			if _, ok := taskDefRefs[td.Arn]; ok {
+				continue
+			}
+			resourceName := g.sanitize(td.Family)
+			if _, ok := taskDefNames[resourceName]; ok {
+				resourceName = fmt.Sprintf("%s_%d", resourceName, td.Revision)
+			}
+			taskDefNames[resourceName] = struct{}{}
+			taskDefRefs[td.Arn] = resourceName
+			g.appendTaskDefinition(resourceBody, importBody, *td, resourceName, refs.Roles)
+		}
+	}
+
+	namespaceRefs, discoveryServiceRefs := g.appendServiceDiscovery(resourceBody, importBody, related.Namespaces, related.DiscoveryServices)
+
+	targetsByResourceID := make(map[string]applicationautoscaling.ScalableTarget)
+	for _, target := range related.ScalableTargets {
+		targetsByResourceID[target.ResourceID] = target
+	}
+
+	serviceNames := make(map[string]struct{})
+	for _, cluster := range clusters {
+		// Cluster
+		clusterResourceType := "aws_ecs_cluster"
+		clusterResourceName := g.sanitize(cluster.Name)
+		g.appendImportBlock(importBody, clusterResourceType+"."+clusterResourceName, cluster.Name)
+		clusterBlock := g.appendResourceBlock(resourceBody, clusterResourceType, clusterResourceName)
+		clusterBlock.Body().SetAttributeValue("name", cty.StringVal(cluster.Name))
+		if len(cluster.Tags) > 0 {
+			tagMap := make(map[string]cty.Value)
+			for k, v := range cluster.Tags {
+				tagMap[k] = cty.StringVal(v)
+			}
+			clusterBlock.Body().SetAttributeValue("tags", cty.MapVal(tagMap))
+		}
+		if cluster.ContainerInsights != "" {
+			settingBlock := clusterBlock.Body().AppendNewBlock("setting", nil)
+			settingBlock.Body().SetAttributeValue("name", cty.StringVal("containerInsights"))
+			settingBlock.Body().SetAttributeValue("value", cty.StringVal(cluster.ContainerInsights))
+		}
+		if ecc := cluster.ExecuteCommandConfiguration; ecc != nil {
+			configBlock := clusterBlock.Body().AppendNewBlock("configuration", nil)
+			eccBlock := configBlock.Body().AppendNewBlock("execute_command_configuration", nil)
+			if ecc.KmsKeyID != "" {
+				eccBlock.Body().SetAttributeValue("kms_key_id", cty.StringVal(ecc.KmsKeyID))
+			}
+			if ecc.Logging != "" {
+				eccBlock.Body().SetAttributeValue("logging", cty.StringVal(ecc.Logging))
+			}
+			if lc := ecc.LogConfiguration; lc != nil {
+				lcBlock := eccBlock.Body().AppendNewBlock("log_configuration", nil)
+				if lc.CloudWatchLogGroupName != "" {
+					lcBlock.Body().SetAttributeValue("cloud_watch_log_group_name", cty.StringVal(lc.CloudWatchLogGroupName))
+					lcBlock.Body().SetAttributeValue("cloud_watch_encryption_enabled", cty.BoolVal(lc.CloudWatchEncryptionEnabled))
+				}
+				if lc.S3BucketName != "" {
+					lcBlock.Body().SetAttributeValue("s3_bucket_name", cty.StringVal(lc.S3BucketName))
+					lcBlock.Body().SetAttributeValue("s3_bucket_encryption_enabled", cty.BoolVal(lc.S3EncryptionEnabled))
+					if lc.S3KeyPrefix != "" {
+						lcBlock.Body().SetAttributeValue("s3_key_prefix", cty.StringVal(lc.S3KeyPrefix))
+					}
+				}
+			}
+		}
+		if cluster.ServiceConnectNamespace != "" {
+			scBlock := clusterBlock.Body().AppendNewBlock("service_connect_defaults", nil)
+			g.setNamespaceArn(scBlock.Body(), namespaceRefs, cluster.ServiceConnectNamespace)
+		}
+
+		// Capacity Providers
+		capacityProviderRefs := make(map[string]string)
+		for _, cp := range cluster.CapacityProviderDetails {
+			cpResourceType := "aws_ecs_capacity_provider"
+			cpResourceName := g.sanitize(cp.Name)
+			capacityProviderRefs[cp.Name] = cpResourceName
+			g.appendImportBlock(importBody, cpResourceType+"."+cpResourceName, cp.Name)
+			cpBlock := g.appendResourceBlock(resourceBody, cpResourceType, cpResourceName)
+			cpBlock.Body().SetAttributeValue("name", cty.StringVal(cp.Name))
+			asgpBlock := cpBlock.Body().AppendNewBlock("auto_scaling_group_provider", nil)
+			if _, ok := refs.AutoScalingGroups[cp.AutoScalingGroupName]; ok {
+				asgpBlock.Body().SetAttributeRaw("auto_scaling_group_arn", g.reference("aws_autoscaling_group", g.sanitize(cp.AutoScalingGroupName), "arn"))
+			} else {
+				asgpBlock.Body().SetAttributeValue("auto_scaling_group_arn", cty.StringVal(cp.AutoScalingGroupArn))
+			}
+			if cp.ManagedTerminationProtection != "" {
+				asgpBlock.Body().SetAttributeValue("managed_termination_protection", cty.StringVal(cp.ManagedTerminationProtection))
+			}
+			if cp.ManagedDraining != "" {
+				asgpBlock.Body().SetAttributeValue("managed_draining", cty.StringVal(cp.ManagedDraining))
+			}
+			if ms := cp.ManagedScaling; ms != nil {
+				msBlock := asgpBlock.Body().AppendNewBlock("managed_scaling", nil)
+				msBlock.Body().SetAttributeValue("status", cty.StringVal(ms.Status))
+				msBlock.Body().SetAttributeValue("target_capacity", cty.NumberIntVal(int64(ms.TargetCapacity)))
+				msBlock.Body().SetAttributeValue("minimum_scaling_step_size", cty.NumberIntVal(int64(ms.MinimumScalingStepSize)))
+				msBlock.Body().SetAttributeValue("maximum_scaling_step_size", cty.NumberIntVal(int64(ms.MaximumScalingStepSize)))
+				msBlock.Body().SetAttributeValue("instance_warmup_period", cty.NumberIntVal(int64(ms.InstanceWarmupPeriod)))
+			}
+			if len(cp.Tags) > 0 {
+				g.appendTags(cpBlock.Body(), cp.Tags)
+			}
+		}
+
+		if len(cluster.CapacityProviders) > 0 || len(cluster.DefaultCapacityProviderStrategy) > 0 {
+			ccpResourceType := "aws_ecs_cluster_capacity_providers"
+			g.appendImportBlock(importBody, ccpResourceType+"."+clusterResourceName, cluster.Name)
+			ccpBlock := g.appendResourceBlock(resourceBody, ccpResourceType, clusterResourceName)
+			ccpBlock.Body().SetAttributeRaw("cluster_name", g.reference(clusterResourceType, clusterResourceName, "name"))
+			ccpBlock.Body().SetAttributeRaw("capacity_providers", g.referenceList(cluster.CapacityProviders, capacityProviderRefs, "aws_ecs_capacity_provider", "name"))
+			g.appendCapacityProviderStrategy(ccpBlock.Body(), "default_capacity_provider_strategy", cluster.DefaultCapacityProviderStrategy, capacityProviderRefs)
+		}
+
+		// Services
+		for _, service := range cluster.Services {
+			serviceResourceType := "aws_ecs_service"
+			serviceResourceName := g.sanitize(service.Name)
+			// 複数クラスターに同名のサービスがある場合はクラスター名を前置します。
+			if _, ok := serviceNames[serviceResourceName]; ok {
+				serviceResourceName = g.sanitize(cluster.Name + "_" + service.Name)
+			}
+			serviceNames[serviceResourceName] = struct{}{}
+			importId := fmt.Sprintf("%s/%s", cluster.Name, service.Name)
+			g.appendImportBlock(importBody, serviceResourceType+"."+serviceResourceName, importId)
+			serviceBlock := g.appendResourceBlock(resourceBody, serviceResourceType, serviceResourceName)
+			serviceBlock.Body().SetAttributeValue("name", cty.StringVal(service.Name))
+			if taskDefResourceName, ok := taskDefRefs[service.TaskDefinitionArn]; ok {
+				serviceBlock.Body().SetAttributeRaw("task_definition", g.reference("aws_ecs_task_definition", taskDefResourceName, "arn"))
+			} else {
+				serviceBlock.Body().SetAttributeValue("task_definition", cty.StringVal(service.TaskDefinitionArn))
+			}
+			serviceBlock.Body().SetAttributeValue("desired_count", cty.NumberIntVal(int64(service.DesiredCount)))
+			serviceBlock.Body().SetAttributeValue("enable_ecs_managed_tags", cty.BoolVal(service.EnableEcsManagedTags))
+			serviceBlock.Body().SetAttributeValue("enable_execute_command", cty.BoolVal(service.EnableExecuteCommand))
+			serviceBlock.Body().SetAttributeValue("health_check_grace_period_seconds", cty.NumberIntVal(int64(service.HealthCheckGracePeriodSeconds)))
+			serviceBlock.Body().SetAttributeValue("propagate_tags", cty.StringVal(service.PropagateTags))
+			if service.PlatformVersion != "" {
+				serviceBlock.Body().SetAttributeValue("platform_version", cty.StringVal(service.PlatformVersion))
+			}
+			serviceBlock.Body().SetAttributeValue("scheduling_strategy", cty.StringVal(service.SchedulingStrategy))
+
+			g.appendCapacityProviderStrategy(serviceBlock.Body(), "capacity_provider_strategy", service.CapacityProviderStrategy, capacityProviderRefs)
+			for _, ps := range service.OrderedPlacementStrategy {
+				psBlock := serviceBlock.Body().AppendNewBlock("ordered_placement_strategy", nil)
+				psBlock.Body().SetAttributeValue("type", cty.StringVal(ps.Type))
+				if ps.Field != "" {
+					psBlock.Body().SetAttributeValue("field", cty.StringVal(ps.Field))
+				}
+			}
+			for _, pc := range service.PlacementConstraints {
+				pcBlock := serviceBlock.Body().AppendNewBlock("placement_constraints", nil)
+				pcBlock.Body().SetAttributeValue("type", cty.StringVal(pc.Type))
+				if pc.Expression != "" {
+					pcBlock.Body().SetAttributeValue("expression", cty.StringVal(pc.Expression))
+				}
+			}
+			if service.DeploymentController != "" {
+				dcBlock := serviceBlock.Body().AppendNewBlock("deployment_controller", nil)
+				dcBlock.Body().SetAttributeValue("type", cty.StringVal(service.DeploymentController))
+			}
+			for _, sr := range service.ServiceRegistries {
+				srBlock := serviceBlock.Body().AppendNewBlock("service_registries", nil)
+				if resourceName, ok := discoveryServiceRefs[sr.RegistryArn]; ok {
+					srBlock.Body().SetAttributeRaw("registry_arn", g.reference("aws_service_discovery_service", resourceName, "arn"))
+				} else {
+					srBlock.Body().SetAttributeValue("registry_arn", cty.StringVal(sr.RegistryArn))
+				}
+				if sr.Port > 0 {
+					srBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(sr.Port)))
+				}
+				if sr.ContainerName != "" {
+					srBlock.Body().SetAttributeValue("container_name", cty.StringVal(sr.ContainerName))
+				}
+				if sr.ContainerPort > 0 {
+					srBlock.Body().SetAttributeValue("container_port", cty.NumberIntVal(int64(sr.ContainerPort)))
+				}
+			}
+
+			// Deployment Circuit Breaker
+			if service.DeploymentCircuitBreaker != nil {
+				breakerBlock := serviceBlock.Body().AppendNewBlock("deployment_circuit_breaker", nil)
+				breakerBlock.Body().SetAttributeValue("enable", cty.BoolVal(service.DeploymentCircuitBreaker.Enable))
+				breakerBlock.Body().SetAttributeValue("rollback", cty.BoolVal(service.DeploymentCircuitBreaker.Rollback))
+			}
+
+			// Network Configuration
+			if service.NetworkConfiguration != nil {
+				netBlock := serviceBlock.Body().AppendNewBlock("network_configuration", nil)
+				var subnetVals []cty.Value
+				for _, subnet := range service.NetworkConfiguration.Subnets {
+					subnetVals = append(subnetVals, cty.StringVal(subnet))
+				}
+				netBlock.Body().SetAttributeValue("subnets", cty.ListVal(subnetVals)) // Use ListVal, TF can handle it.
+				if len(service.NetworkConfiguration.SecurityGroups) > 0 {
+					var sgVals []cty.Value
+					for _, sg := range service.NetworkConfiguration.SecurityGroups {
+						sgVals = append(sgVals, cty.StringVal(sg))
+					}
+					netBlock.Body().SetAttributeValue("security_groups", cty.ListVal(sgVals))
+				}
+				netBlock.Body().SetAttributeValue("assign_public_ip", cty.BoolVal(service.NetworkConfiguration.AssignPublicIp))
+			}
+
+			// Tags
+			if len(service.Tags) > 0 {
+				tagMap := make(map[string]cty.Value)
+				for k, v := range service.Tags {
+					tagMap[k] = cty.StringVal(v)
+				}
+				serviceBlock.Body().SetAttributeValue("tags", cty.MapVal(tagMap))
 			}
 
 			// Load Balancers
@@ -201,171 +1716,2337 @@ func (g *HCLGenerator) GenerateEcsBlocks(clusters []ecs.Cluster) (*hclwrite.File
 				}
 			}
 
-			// Cluster Reference
-			clusterRefParts := strings.SplitN("aws_ecs_cluster."+clusterResourceName, ".", 2)
-			clusterTraversal := hcl.Traversal{
-				hcl.TraverseRoot{Name: clusterRefParts[0]},
-				hcl.TraverseAttr{Name: clusterRefParts[1]},
-				hcl.TraverseAttr{Name: "arn"},
+			if sc := service.ServiceConnectConfiguration; sc != nil {
+				g.appendServiceConnectConfiguration(serviceBlock.Body(), *sc, namespaceRefs)
+			}
+
+			// Cluster Reference
+			clusterRefParts := strings.SplitN("aws_ecs_cluster."+clusterResourceName, ".", 2)
+			clusterTraversal := hcl.Traversal{
+				hcl.TraverseRoot{Name: clusterRefParts[0]},
+				hcl.TraverseAttr{Name: clusterRefParts[1]},
+				hcl.TraverseAttr{Name: "arn"},
+			}
+			serviceBlock.Body().SetAttributeRaw("cluster", hclwrite.TokensForTraversal(clusterTraversal))
+
+			// Application Auto Scaling
+			if target, ok := targetsByResourceID[ecs.ServiceResourceID(cluster.Name, service.Name)]; ok {
+				// desired_countはオートスケーリングが変更するため、差分として扱いません。
+				g.appendIgnoreChanges(serviceBlock.Body(), g.ignoreAttrs("desired_count")...)
+				g.appendScalableTarget(resourceBody, importBody, target, serviceResourceName)
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// namespaceRef はCloud Map名前空間のリソース種別と名前を保持します。
+type namespaceRef struct {
+	resourceType string
+	resourceName string
+}
+
+// appendServiceDiscovery はCloud Mapの名前空間とサービスのブロックを追加し、それぞれへの参照情報を返します。
+// 名前空間はARN・ID・名前のいずれでも引けるようにします。
+func (g *HCLGenerator) appendServiceDiscovery(resourceBody, importBody *hclwrite.Body, namespaces []servicediscovery.Namespace, services []servicediscovery.Service) (map[string]namespaceRef, map[string]string) {
+	namespaceRefs := make(map[string]namespaceRef)
+	for _, ns := range namespaces {
+		var resourceType, importID string
+		switch ns.Type {
+		case "DNS_PRIVATE":
+			resourceType = "aws_service_discovery_private_dns_namespace"
+			importID = ns.ID + ":" + ns.VpcID
+		case "DNS_PUBLIC":
+			resourceType = "aws_service_discovery_public_dns_namespace"
+			importID = ns.ID
+		default:
+			resourceType = "aws_service_discovery_http_namespace"
+			importID = ns.ID
+		}
+		resourceName := g.sanitize(ns.ID)
+		ref := namespaceRef{resourceType: resourceType, resourceName: resourceName}
+		namespaceRefs[ns.Arn] = ref
+		namespaceRefs[ns.ID] = ref
+		namespaceRefs[ns.Name] = ref
+
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, importID)
+		nsBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		nsBlock.Body().SetAttributeValue("name", cty.StringVal(ns.Name))
+		if ns.Type == "DNS_PRIVATE" {
+			nsBlock.Body().SetAttributeValue("vpc", cty.StringVal(ns.VpcID))
+		}
+		if ns.Description != "" {
+			nsBlock.Body().SetAttributeValue("description", cty.StringVal(ns.Description))
+		}
+		if len(ns.Tags) > 0 {
+			g.appendTags(nsBlock.Body(), ns.Tags)
+		}
+	}
+
+	serviceRefs := make(map[string]string)
+	for _, svc := range services {
+		resourceType := "aws_service_discovery_service"
+		resourceName := g.sanitize(svc.ID)
+		serviceRefs[svc.Arn] = resourceName
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, svc.ID)
+		svcBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		svcBlock.Body().SetAttributeValue("name", cty.StringVal(svc.Name))
+		if svc.Description != "" {
+			svcBlock.Body().SetAttributeValue("description", cty.StringVal(svc.Description))
+		}
+
+		nsRef, nsFound := namespaceRefs[svc.NamespaceID]
+		setNamespaceID := func(body *hclwrite.Body) {
+			if nsFound {
+				body.SetAttributeRaw("namespace_id", g.reference(nsRef.resourceType, nsRef.resourceName, "id"))
+			} else {
+				body.SetAttributeValue("namespace_id", cty.StringVal(svc.NamespaceID))
+			}
+		}
+		if len(svc.DnsRecords) > 0 {
+			dnsBlock := svcBlock.Body().AppendNewBlock("dns_config", nil)
+			setNamespaceID(dnsBlock.Body())
+			if svc.RoutingPolicy != "" {
+				dnsBlock.Body().SetAttributeValue("routing_policy", cty.StringVal(svc.RoutingPolicy))
+			}
+			for _, record := range svc.DnsRecords {
+				recordBlock := dnsBlock.Body().AppendNewBlock("dns_records", nil)
+				recordBlock.Body().SetAttributeValue("ttl", cty.NumberIntVal(record.TTL))
+				recordBlock.Body().SetAttributeValue("type", cty.StringVal(record.Type))
+			}
+		} else {
+			setNamespaceID(svcBlock.Body())
+		}
+
+		if hc := svc.HealthCheckConfig; hc != nil {
+			hcBlock := svcBlock.Body().AppendNewBlock("health_check_config", nil)
+			hcBlock.Body().SetAttributeValue("type", cty.StringVal(hc.Type))
+			if hc.ResourcePath != "" {
+				hcBlock.Body().SetAttributeValue("resource_path", cty.StringVal(hc.ResourcePath))
+			}
+			hcBlock.Body().SetAttributeValue("failure_threshold", cty.NumberIntVal(int64(hc.FailureThreshold)))
+		}
+		if hcc := svc.HealthCheckCustomConfig; hcc != nil {
+			hccBlock := svcBlock.Body().AppendNewBlock("health_check_custom_config", nil)
+			if hcc.FailureThreshold > 0 {
+				hccBlock.Body().SetAttributeValue("failure_threshold", cty.NumberIntVal(int64(hcc.FailureThreshold)))
+			}
+		}
+		if len(svc.Tags) > 0 {
+			g.appendTags(svcBlock.Body(), svc.Tags)
+		}
+	}
+
+	return namespaceRefs, serviceRefs
+}
+
+// setNamespaceArn は名前空間が同じ実行で生成される場合はARNの参照を、そうでなければ値をnamespaceに設定します。
+func (g *HCLGenerator) setNamespaceArn(body *hclwrite.Body, namespaceRefs map[string]namespaceRef, namespace string) {
+	if ref, ok := namespaceRefs[namespace]; ok {
+		body.SetAttributeRaw("namespace", g.reference(ref.resourceType, ref.resourceName, "arn"))
+		return
+	}
+	body.SetAttributeValue("namespace", cty.StringVal(namespace))
+}
+
+// appendServiceConnectConfiguration はaws_ecs_serviceのservice_connect_configurationブロックを追加します。
+func (g *HCLGenerator) appendServiceConnectConfiguration(body *hclwrite.Body, sc ecs.ServiceConnectConfiguration, namespaceRefs map[string]namespaceRef) {
+	scBlock := body.AppendNewBlock("service_connect_configuration", nil)
+	scBlock.Body().SetAttributeValue("enabled", cty.BoolVal(sc.Enabled))
+	if sc.Namespace != "" {
+		g.setNamespaceArn(scBlock.Body(), namespaceRefs, sc.Namespace)
+	}
+	if sc.LogDriver != "" {
+		logBlock := scBlock.Body().AppendNewBlock("log_configuration", nil)
+		logBlock.Body().SetAttributeValue("log_driver", cty.StringVal(sc.LogDriver))
+		if len(sc.LogOptions) > 0 {
+			logBlock.Body().SetAttributeValue("options", g.stringMap(sc.LogOptions))
+		}
+	}
+	for _, svc := range sc.Services {
+		svcBlock := scBlock.Body().AppendNewBlock("service", nil)
+		svcBlock.Body().SetAttributeValue("port_name", cty.StringVal(svc.PortName))
+		if svc.DiscoveryName != "" {
+			svcBlock.Body().SetAttributeValue("discovery_name", cty.StringVal(svc.DiscoveryName))
+		}
+		if svc.IngressPortOverride > 0 {
+			svcBlock.Body().SetAttributeValue("ingress_port_override", cty.NumberIntVal(int64(svc.IngressPortOverride)))
+		}
+		for _, alias := range svc.ClientAliases {
+			aliasBlock := svcBlock.Body().AppendNewBlock("client_alias", nil)
+			aliasBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(alias.Port)))
+			if alias.DnsName != "" {
+				aliasBlock.Body().SetAttributeValue("dns_name", cty.StringVal(alias.DnsName))
+			}
+		}
+		if svc.IdleTimeoutSeconds != nil || svc.PerRequestTimeoutSeconds != nil {
+			timeoutBlock := svcBlock.Body().AppendNewBlock("timeout", nil)
+			if svc.IdleTimeoutSeconds != nil {
+				timeoutBlock.Body().SetAttributeValue("idle_timeout_seconds", cty.NumberIntVal(int64(*svc.IdleTimeoutSeconds)))
+			}
+			if svc.PerRequestTimeoutSeconds != nil {
+				timeoutBlock.Body().SetAttributeValue("per_request_timeout_seconds", cty.NumberIntVal(int64(*svc.PerRequestTimeoutSeconds)))
+			}
+		}
+		if svc.TlsAwsPcaAuthorityArn != "" {
+			tlsBlock := svcBlock.Body().AppendNewBlock("tls", nil)
+			caBlock := tlsBlock.Body().AppendNewBlock("issuer_cert_authority", nil)
+			caBlock.Body().SetAttributeValue("aws_pca_authority_arn", cty.StringVal(svc.TlsAwsPcaAuthorityArn))
+			if svc.TlsKmsKey != "" {
+				tlsBlock.Body().SetAttributeValue("kms_key", cty.StringVal(svc.TlsKmsKey))
+			}
+			if svc.TlsRoleArn != "" {
+				tlsBlock.Body().SetAttributeValue("role_arn", cty.StringVal(svc.TlsRoleArn))
+			}
+		}
+	}
+}
+
+// appendScalableTarget はaws_appautoscaling_targetと、そのポリシーとスケジュールアクションのブロックを追加します。
+func (g *HCLGenerator) appendScalableTarget(resourceBody, importBody *hclwrite.Body, target applicationautoscaling.ScalableTarget, resourceName string) {
+	resourceType := "aws_appautoscaling_target"
+	importPrefix := fmt.Sprintf("%s/%s/%s", target.ServiceNamespace, target.ResourceID, target.ScalableDimension)
+	g.appendImportBlock(importBody, resourceType+"."+resourceName, importPrefix)
+	targetBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+	targetBlock.Body().SetAttributeValue("service_namespace", cty.StringVal(target.ServiceNamespace))
+	targetBlock.Body().SetAttributeValue("resource_id", cty.StringVal(target.ResourceID))
+	targetBlock.Body().SetAttributeValue("scalable_dimension", cty.StringVal(target.ScalableDimension))
+	targetBlock.Body().SetAttributeValue("min_capacity", cty.NumberIntVal(int64(target.MinCapacity)))
+	targetBlock.Body().SetAttributeValue("max_capacity", cty.NumberIntVal(int64(target.MaxCapacity)))
+	if target.DynamicScalingInSuspended || target.DynamicScalingOutSuspended || target.ScheduledScalingSuspended {
+		ssBlock := targetBlock.Body().AppendNewBlock("suspended_state", nil)
+		ssBlock.Body().SetAttributeValue("dynamic_scaling_in_suspended", cty.BoolVal(target.DynamicScalingInSuspended))
+		ssBlock.Body().SetAttributeValue("dynamic_scaling_out_suspended", cty.BoolVal(target.DynamicScalingOutSuspended))
+		ssBlock.Body().SetAttributeValue("scheduled_scaling_suspended", cty.BoolVal(target.ScheduledScalingSuspended))
+	}
+
+	setTargetRefs := func(body *hclwrite.Body) {
+		body.SetAttributeRaw("service_namespace", g.reference(resourceType, resourceName, "service_namespace"))
+		body.SetAttributeRaw("resource_id", g.reference(resourceType, resourceName, "resource_id"))
+		body.SetAttributeRaw("scalable_dimension", g.reference(resourceType, resourceName, "scalable_dimension"))
+	}
+
+	for _, policy := range target.Policies {
+		policyResourceType := "aws_appautoscaling_policy"
+		policyResourceName := g.sanitize(resourceName + "_" + policy.Name)
+		g.appendImportBlock(importBody, policyResourceType+"."+policyResourceName, importPrefix+"/"+policy.Name)
+		policyBlock := g.appendResourceBlock(resourceBody, policyResourceType, policyResourceName)
+		policyBlock.Body().SetAttributeValue("name", cty.StringVal(policy.Name))
+		policyBlock.Body().SetAttributeValue("policy_type", cty.StringVal(policy.PolicyType))
+		setTargetRefs(policyBlock.Body())
+
+		if sc := policy.StepScaling; sc != nil {
+			scBlock := policyBlock.Body().AppendNewBlock("step_scaling_policy_configuration", nil)
+			if sc.AdjustmentType != "" {
+				scBlock.Body().SetAttributeValue("adjustment_type", cty.StringVal(sc.AdjustmentType))
+			}
+			if sc.Cooldown != nil {
+				scBlock.Body().SetAttributeValue("cooldown", cty.NumberIntVal(int64(*sc.Cooldown)))
+			}
+			if sc.MetricAggregationType != "" {
+				scBlock.Body().SetAttributeValue("metric_aggregation_type", cty.StringVal(sc.MetricAggregationType))
+			}
+			if sc.MinAdjustmentMagnitude != nil {
+				scBlock.Body().SetAttributeValue("min_adjustment_magnitude", cty.NumberIntVal(int64(*sc.MinAdjustmentMagnitude)))
+			}
+			for _, step := range sc.StepAdjustments {
+				stepBlock := scBlock.Body().AppendNewBlock("step_adjustment", nil)
+				stepBlock.Body().SetAttributeValue("scaling_adjustment", cty.NumberIntVal(int64(step.ScalingAdjustment)))
+				if step.MetricIntervalLowerBound != nil {
+					stepBlock.Body().SetAttributeValue("metric_interval_lower_bound", cty.StringVal(fmt.Sprint(*step.MetricIntervalLowerBound)))
+				}
+				if step.MetricIntervalUpperBound != nil {
+					stepBlock.Body().SetAttributeValue("metric_interval_upper_bound", cty.StringVal(fmt.Sprint(*step.MetricIntervalUpperBound)))
+				}
+			}
+		}
+
+		if tt := policy.TargetTracking; tt != nil {
+			ttBlock := policyBlock.Body().AppendNewBlock("target_tracking_scaling_policy_configuration", nil)
+			ttBlock.Body().SetAttributeValue("target_value", cty.NumberFloatVal(tt.TargetValue))
+			ttBlock.Body().SetAttributeValue("disable_scale_in", cty.BoolVal(tt.DisableScaleIn))
+			if tt.ScaleInCooldown != nil {
+				ttBlock.Body().SetAttributeValue("scale_in_cooldown", cty.NumberIntVal(int64(*tt.ScaleInCooldown)))
+			}
+			if tt.ScaleOutCooldown != nil {
+				ttBlock.Body().SetAttributeValue("scale_out_cooldown", cty.NumberIntVal(int64(*tt.ScaleOutCooldown)))
+			}
+			if tt.PredefinedMetricType != "" {
+				pmBlock := ttBlock.Body().AppendNewBlock("predefined_metric_specification", nil)
+				pmBlock.Body().SetAttributeValue("predefined_metric_type", cty.StringVal(tt.PredefinedMetricType))
+				if tt.ResourceLabel != "" {
+					pmBlock.Body().SetAttributeValue("resource_label", cty.StringVal(tt.ResourceLabel))
+				}
+			}
+			if cm := tt.CustomizedMetric; cm != nil {
+				g.appendAppCustomizedMetricSpecification(ttBlock.Body(), *cm)
+			}
+		}
+	}
+
+	// Scheduled actions (importには対応していないため、resourceブロックのみ出力します)
+	for _, action := range target.ScheduledActions {
+		actionBlock := g.appendResourceBlock(resourceBody, "aws_appautoscaling_scheduled_action", g.sanitize(resourceName+"_"+action.Name))
+		actionBlock.Body().SetAttributeValue("name", cty.StringVal(action.Name))
+		setTargetRefs(actionBlock.Body())
+		actionBlock.Body().SetAttributeValue("schedule", cty.StringVal(action.Schedule))
+		if action.Timezone != "" {
+			actionBlock.Body().SetAttributeValue("timezone", cty.StringVal(action.Timezone))
+		}
+		if action.StartTime != "" {
+			actionBlock.Body().SetAttributeValue("start_time", cty.StringVal(action.StartTime))
+		}
+		if action.EndTime != "" {
+			actionBlock.Body().SetAttributeValue("end_time", cty.StringVal(action.EndTime))
+		}
+		if action.MinCapacity != nil || action.MaxCapacity != nil {
+			staBlock := actionBlock.Body().AppendNewBlock("scalable_target_action", nil)
+			if action.MinCapacity != nil {
+				staBlock.Body().SetAttributeValue("min_capacity", cty.StringVal(fmt.Sprint(*action.MinCapacity)))
+			}
+			if action.MaxCapacity != nil {
+				staBlock.Body().SetAttributeValue("max_capacity", cty.StringVal(fmt.Sprint(*action.MaxCapacity)))
+			}
+		}
+	}
+}
+
+// appendCapacityProviderStrategy はキャパシティプロバイダー戦略のブロックを追加します。
+// 同じ実行で生成されるキャパシティプロバイダーは名前の参照にします。
+func (g *HCLGenerator) appendCapacityProviderStrategy(body *hclwrite.Body, blockType string, strategy []ecs.CapacityProviderStrategyItem, capacityProviderRefs map[string]string) {
+	for _, item := range strategy {
+		itemBlock := body.AppendNewBlock(blockType, nil)
+		if resourceName, ok := capacityProviderRefs[item.CapacityProvider]; ok {
+			itemBlock.Body().SetAttributeRaw("capacity_provider", g.reference("aws_ecs_capacity_provider", resourceName, "name"))
+		} else {
+			itemBlock.Body().SetAttributeValue("capacity_provider", cty.StringVal(item.CapacityProvider))
+		}
+		itemBlock.Body().SetAttributeValue("weight", cty.NumberIntVal(int64(item.Weight)))
+		itemBlock.Body().SetAttributeValue("base", cty.NumberIntVal(int64(item.Base)))
+	}
+}
+
+// appendTaskDefinition はaws_ecs_task_definitionのブロックを追加します。
+// コンテナ定義はjsonencode()にHCLのオブジェクト構文で渡す形で出力します。
+func (g *HCLGenerator) appendTaskDefinition(resourceBody, importBody *hclwrite.Body, td ecs.TaskDefinition, resourceName string, roleRefs map[string]string) {
+	resourceType := "aws_ecs_task_definition"
+	g.appendImportBlock(importBody, resourceType+"."+resourceName, td.Arn)
+	tdBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+	tdBlock.Body().SetAttributeValue("family", cty.StringVal(td.Family))
+	if td.NetworkMode != "" {
+		tdBlock.Body().SetAttributeValue("network_mode", cty.StringVal(td.NetworkMode))
+	}
+	if len(td.RequiresCompatibilities) > 0 {
+		tdBlock.Body().SetAttributeValue("requires_compatibilities", g.stringList(td.RequiresCompatibilities))
+	}
+	if td.Cpu != "" {
+		tdBlock.Body().SetAttributeValue("cpu", cty.StringVal(td.Cpu))
+	}
+	if td.Memory != "" {
+		tdBlock.Body().SetAttributeValue("memory", cty.StringVal(td.Memory))
+	}
+	if td.ExecutionRoleArn != "" {
+		g.setRoleArn(tdBlock.Body(), "execution_role_arn", roleRefs, td.ExecutionRoleArn)
+	}
+	if td.TaskRoleArn != "" {
+		g.setRoleArn(tdBlock.Body(), "task_role_arn", roleRefs, td.TaskRoleArn)
+	}
+	if td.PidMode != "" {
+		tdBlock.Body().SetAttributeValue("pid_mode", cty.StringVal(td.PidMode))
+	}
+	if td.IpcMode != "" {
+		tdBlock.Body().SetAttributeValue("ipc_mode", cty.StringVal(td.IpcMode))
+	}
+	tdBlock.Body().SetAttributeRaw("container_definitions", hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(g.documentValue(td.ContainerDefinitions))))
+
+	if td.OperatingSystemFamily != "" || td.CpuArchitecture != "" {
+		rpBlock := tdBlock.Body().AppendNewBlock("runtime_platform", nil)
+		if td.OperatingSystemFamily != "" {
+			rpBlock.Body().SetAttributeValue("operating_system_family", cty.StringVal(td.OperatingSystemFamily))
+		}
+		if td.CpuArchitecture != "" {
+			rpBlock.Body().SetAttributeValue("cpu_architecture", cty.StringVal(td.CpuArchitecture))
+		}
+	}
+	if td.EphemeralStorageGiB > 0 {
+		esBlock := tdBlock.Body().AppendNewBlock("ephemeral_storage", nil)
+		esBlock.Body().SetAttributeValue("size_in_gib", cty.NumberIntVal(int64(td.EphemeralStorageGiB)))
+	}
+
+	for _, v := range td.Volumes {
+		volBlock := tdBlock.Body().AppendNewBlock("volume", nil)
+		volBlock.Body().SetAttributeValue("name", cty.StringVal(v.Name))
+		if v.HostPath != "" {
+			volBlock.Body().SetAttributeValue("host_path", cty.StringVal(v.HostPath))
+		}
+		if efs := v.EfsVolumeConfiguration; efs != nil {
+			efsBlock := volBlock.Body().AppendNewBlock("efs_volume_configuration", nil)
+			efsBlock.Body().SetAttributeValue("file_system_id", cty.StringVal(efs.FileSystemID))
+			if efs.RootDirectory != "" {
+				efsBlock.Body().SetAttributeValue("root_directory", cty.StringVal(efs.RootDirectory))
+			}
+			if efs.TransitEncryption != "" {
+				efsBlock.Body().SetAttributeValue("transit_encryption", cty.StringVal(efs.TransitEncryption))
+			}
+			if efs.TransitEncryptionPort > 0 {
+				efsBlock.Body().SetAttributeValue("transit_encryption_port", cty.NumberIntVal(int64(efs.TransitEncryptionPort)))
+			}
+			if efs.AccessPointID != "" || efs.Iam != "" {
+				authBlock := efsBlock.Body().AppendNewBlock("authorization_config", nil)
+				if efs.AccessPointID != "" {
+					authBlock.Body().SetAttributeValue("access_point_id", cty.StringVal(efs.AccessPointID))
+				}
+				if efs.Iam != "" {
+					authBlock.Body().SetAttributeValue("iam", cty.StringVal(efs.Iam))
+				}
+			}
+		}
+		if docker := v.DockerVolumeConfiguration; docker != nil {
+			dockerBlock := volBlock.Body().AppendNewBlock("docker_volume_configuration", nil)
+			if docker.Scope != "" {
+				dockerBlock.Body().SetAttributeValue("scope", cty.StringVal(docker.Scope))
+			}
+			if docker.Autoprovision != nil {
+				dockerBlock.Body().SetAttributeValue("autoprovision", cty.BoolVal(*docker.Autoprovision))
+			}
+			if docker.Driver != "" {
+				dockerBlock.Body().SetAttributeValue("driver", cty.StringVal(docker.Driver))
+			}
+			if len(docker.DriverOpts) > 0 {
+				dockerBlock.Body().SetAttributeValue("driver_opts", g.stringMap(docker.DriverOpts))
+			}
+			if len(docker.Labels) > 0 {
+				dockerBlock.Body().SetAttributeValue("labels", g.stringMap(docker.Labels))
+			}
+		}
+	}
+
+	if len(td.Tags) > 0 {
+		g.appendTags(tdBlock.Body(), td.Tags)
+	}
+}
+
+// setRoleArn はロールが同じ実行で生成される場合は aws_iam_role.<name>.arn を、そうでなければARNの文字列を設定します。
+func (g *HCLGenerator) setRoleArn(body *hclwrite.Body, name string, roleRefs map[string]string, arn string) {
+	if roleName, ok := roleRefs[arn]; ok {
+		body.SetAttributeRaw(name, g.reference("aws_iam_role", g.sanitize(roleName), "arn"))
+		return
+	}
+	body.SetAttributeValue(name, cty.StringVal(arn))
+}
+
+// GenerateElbBlocks はELBv2リソースのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateElbBlocks(lbs []*elbv2.LoadBalancer) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	// ターゲットグループは複数のロードバランサーで共有できるため、一度だけ出力します。
+	tgRefs := make(map[string]hcl.Traversal)
+	for _, lb := range lbs {
+		// Target Groups
+		for _, tg := range lb.TargetGroups {
+			if _, ok := tgRefs[tg.Arn]; ok {
+				continue
+			}
+			tgRefs[tg.Arn] = g.appendLbTargetGroup(resourceBody, importBody, tg)
+		}
+
+		// Load Balancer
+		lbResourceType := "aws_lb"
+		lbResourceName := g.sanitize(lb.Name)
+		g.appendImportBlock(importBody, lbResourceType+"."+lbResourceName, lb.Arn)
+		lbBlock := g.appendResourceBlock(resourceBody, lbResourceType, lbResourceName)
+		lbBlock.Body().SetAttributeValue("name", cty.StringVal(lb.Name))
+		lbBlock.Body().SetAttributeValue("load_balancer_type", cty.StringVal(string(lb.Type)))
+		lbBlock.Body().SetAttributeValue("internal", cty.BoolVal(lb.Internal))
+		if lb.IpAddressType != "" {
+			lbBlock.Body().SetAttributeValue("ip_address_type", cty.StringVal(lb.IpAddressType))
+		}
+		if len(lb.SecurityGroups) > 0 {
+			lbBlock.Body().SetAttributeValue("security_groups", g.stringList(lb.SecurityGroups))
+		}
+		if len(lb.SubnetMappings) > 0 {
+			// EIP などが割り当てられた NLB は subnets ではなく subnet_mapping で表現します。
+			for _, mapping := range lb.SubnetMappings {
+				mappingBlock := lbBlock.Body().AppendNewBlock("subnet_mapping", nil)
+				mappingBlock.Body().SetAttributeValue("subnet_id", cty.StringVal(mapping.SubnetID))
+				if mapping.AllocationID != "" {
+					mappingBlock.Body().SetAttributeValue("allocation_id", cty.StringVal(mapping.AllocationID))
+				}
+				if mapping.PrivateIPv4Address != "" {
+					mappingBlock.Body().SetAttributeValue("private_ipv4_address", cty.StringVal(mapping.PrivateIPv4Address))
+				}
+				if mapping.IPv6Address != "" {
+					mappingBlock.Body().SetAttributeValue("ipv6_address", cty.StringVal(mapping.IPv6Address))
+				}
+			}
+		} else {
+			subnetVals := []cty.Value{}
+			for _, subnet := range lb.Subnets {
+				subnetVals = append(subnetVals, cty.StringVal(subnet))
+			}
+			lbBlock.Body().SetAttributeValue("subnets", cty.ListVal(subnetVals))
+		}
+		g.appendLoadBalancerAttributes(lbBlock.Body(), lb.Attributes)
+
+		lbRefParts := strings.SplitN(lbResourceType+"."+lbResourceName, ".", 2)
+		lbTraversal := hcl.Traversal{
+			hcl.TraverseRoot{Name: lbRefParts[0]},
+			hcl.TraverseAttr{Name: lbRefParts[1]},
+		}
+
+		// Listeners
+		listenerRefs := make(map[string]hcl.Traversal)
+		for _, listener := range lb.Listeners {
+			listenerResourceType := "aws_lb_listener"
+			listenerResourceName := fmt.Sprintf("%s_%d", lbResourceName, listener.Port)
+			g.appendImportBlock(importBody, listenerResourceType+"."+listenerResourceName, listener.Arn)
+			listenerBlock := g.appendResourceBlock(resourceBody, listenerResourceType, listenerResourceName)
+
+			listenerRefParts := strings.SplitN(listenerResourceType+"."+listenerResourceName, ".", 2)
+			listenerTraversal := hcl.Traversal{
+				hcl.TraverseRoot{Name: listenerRefParts[0]},
+				hcl.TraverseAttr{Name: listenerRefParts[1]},
+			}
+			listenerRefs[listener.Arn] = listenerTraversal
+
+			listenerBlock.Body().SetAttributeRaw("load_balancer_arn", hclwrite.TokensForTraversal(append(lbTraversal, hcl.TraverseAttr{Name: "arn"})))
+			listenerBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(listener.Port)))
+			listenerBlock.Body().SetAttributeValue("protocol", cty.StringVal(string(listener.Protocol)))
+			if listener.CertificateArn != nil {
+				listenerBlock.Body().SetAttributeValue("certificate_arn", cty.StringVal(*listener.CertificateArn))
+			}
+
+			// Default Action
+			g.appendIgnoreChanges(listenerBlock.Body(), g.appendLbActions(listenerBlock.Body(), "default_action", listener.DefaultActions, tgRefs)...)
+
+			// Additional Certificates
+			for i, certificateArn := range listener.AdditionalCertificateArns {
+				certResourceType := "aws_lb_listener_certificate"
+				certResourceName := fmt.Sprintf("%s_cert_%d", listenerResourceName, i+1)
+				// import ID は listener_arn と certificate_arn を "_" で連結した形式です。
+				g.appendImportBlock(importBody, certResourceType+"."+certResourceName, listener.Arn+"_"+certificateArn)
+				certBlock := g.appendResourceBlock(resourceBody, certResourceType, certResourceName)
+				certBlock.Body().SetAttributeRaw("listener_arn", hclwrite.TokensForTraversal(append(listenerTraversal, hcl.TraverseAttr{Name: "arn"})))
+				certBlock.Body().SetAttributeValue("certificate_arn", cty.StringVal(certificateArn))
+			}
+
+			// Listener Rules
+			for _, rule := range listener.Rules {
+				ruleResourceType := "aws_lb_listener_rule"
+				// A bit of a hack to create a unique name. A better approach might be needed.
+				ruleResourceName := fmt.Sprintf("%s_rule_%s", listenerResourceName, rule.Priority)
+				g.appendImportBlock(importBody, ruleResourceType+"."+ruleResourceName, rule.Arn)
+				ruleBlock := g.appendResourceBlock(resourceBody, ruleResourceType, ruleResourceName)
+				listenerRef := listenerRefs[listener.Arn]
+				ruleBlock.Body().SetAttributeRaw("listener_arn", hclwrite.TokensForTraversal(append(listenerRef, hcl.TraverseAttr{Name: "arn"})))
+				ruleBlock.Body().SetAttributeValue("priority", cty.StringVal(rule.Priority))
+
+				ignoreTraversals := g.appendLbActions(ruleBlock.Body(), "action", rule.Actions, tgRefs)
+				g.appendLbConditions(ruleBlock.Body(), rule.Conditions)
+				g.appendIgnoreChanges(ruleBlock.Body(), ignoreTraversals...)
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateTargetGroupBlocks はロードバランサーとは独立してターゲットグループと
+// その登録済みターゲット (aws_lb_target_group_attachment) のブロックを生成します。
+// emitted に含まれるARNのターゲットグループは GenerateElbBlocks が出力するため、登録済みターゲットのみ出力します。
+func (g *HCLGenerator) GenerateTargetGroupBlocks(tgs []elbv2.TargetGroup, emitted map[string]struct{}) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, tg := range tgs {
+		var tgTraversal hcl.Traversal
+		if _, ok := emitted[tg.Arn]; ok {
+			tgTraversal = hcl.Traversal{hcl.TraverseRoot{Name: "aws_lb_target_group"}, hcl.TraverseAttr{Name: g.sanitize(tg.Name)}}
+		} else {
+			tgTraversal = g.appendLbTargetGroup(resourceBody, importBody, tg)
+		}
+
+		// Target attachments (importには対応していないため、resourceブロックのみ出力します)
+		for _, target := range tg.Targets {
+			attachmentName := g.sanitize(tg.Name, target.ID)
+			if target.Port != nil {
+				attachmentName = fmt.Sprintf("%s_%d", attachmentName, *target.Port)
+			}
+			attachmentBlock := g.appendResourceBlock(resourceBody, "aws_lb_target_group_attachment", attachmentName)
+			attachmentBlock.Body().SetAttributeRaw("target_group_arn", hclwrite.TokensForTraversal(append(tgTraversal, hcl.TraverseAttr{Name: "arn"})))
+			attachmentBlock.Body().SetAttributeValue("target_id", cty.StringVal(target.ID))
+			if target.Port != nil {
+				attachmentBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(*target.Port)))
+			}
+			if target.AvailabilityZone != "" {
+				attachmentBlock.Body().SetAttributeValue("availability_zone", cty.StringVal(target.AvailabilityZone))
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateClassicElbBlocks は Classic Load Balancer (aws_elb) とそのポリシーのブロックを生成します。
+func (g *HCLGenerator) GenerateClassicElbBlocks(lbs []*elbv2.ClassicLoadBalancer) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, lb := range lbs {
+		lbResourceName := g.sanitize(lb.Name)
+		g.appendImportBlock(importBody, "aws_elb."+lbResourceName, lb.Name)
+		lbBlock := g.appendResourceBlock(resourceBody, "aws_elb", lbResourceName)
+		lbBody := lbBlock.Body()
+		lbBody.SetAttributeValue("name", cty.StringVal(lb.Name))
+		if len(lb.Subnets) > 0 {
+			lbBody.SetAttributeValue("subnets", g.stringList(lb.Subnets))
+		} else {
+			lbBody.SetAttributeValue("availability_zones", g.stringList(lb.AvailabilityZones))
+		}
+		if len(lb.SecurityGroups) > 0 {
+			lbBody.SetAttributeValue("security_groups", g.stringList(lb.SecurityGroups))
+		}
+		lbBody.SetAttributeValue("internal", cty.BoolVal(lb.Internal))
+		if len(lb.Instances) > 0 {
+			lbBody.SetAttributeValue("instances", g.stringList(lb.Instances))
+		}
+		lbBody.SetAttributeValue("cross_zone_load_balancing", cty.BoolVal(lb.CrossZoneLoadBalancing))
+		if lb.IdleTimeout != nil {
+			lbBody.SetAttributeValue("idle_timeout", cty.NumberIntVal(int64(*lb.IdleTimeout)))
+		}
+		lbBody.SetAttributeValue("connection_draining", cty.BoolVal(lb.ConnectionDraining))
+		if lb.ConnectionDrainingTimeout != nil {
+			lbBody.SetAttributeValue("connection_draining_timeout", cty.NumberIntVal(int64(*lb.ConnectionDrainingTimeout)))
+		}
+
+		for _, listener := range lb.Listeners {
+			listenerBlock := lbBody.AppendNewBlock("listener", nil)
+			listenerBlock.Body().SetAttributeValue("instance_port", cty.NumberIntVal(int64(listener.InstancePort)))
+			listenerBlock.Body().SetAttributeValue("instance_protocol", cty.StringVal(listener.InstanceProtocol))
+			listenerBlock.Body().SetAttributeValue("lb_port", cty.NumberIntVal(int64(listener.LBPort)))
+			listenerBlock.Body().SetAttributeValue("lb_protocol", cty.StringVal(listener.LBProtocol))
+			if listener.SSLCertificateID != "" {
+				listenerBlock.Body().SetAttributeValue("ssl_certificate_id", cty.StringVal(listener.SSLCertificateID))
+			}
+		}
+
+		if lb.HealthCheck != nil {
+			hcBlock := lbBody.AppendNewBlock("health_check", nil)
+			hcBlock.Body().SetAttributeValue("target", cty.StringVal(lb.HealthCheck.Target))
+			hcBlock.Body().SetAttributeValue("interval", cty.NumberIntVal(int64(lb.HealthCheck.Interval)))
+			hcBlock.Body().SetAttributeValue("timeout", cty.NumberIntVal(int64(lb.HealthCheck.Timeout)))
+			hcBlock.Body().SetAttributeValue("healthy_threshold", cty.NumberIntVal(int64(lb.HealthCheck.HealthyThreshold)))
+			hcBlock.Body().SetAttributeValue("unhealthy_threshold", cty.NumberIntVal(int64(lb.HealthCheck.UnhealthyThreshold)))
+		}
+
+		if lb.AccessLogs != nil {
+			accessLogsBlock := lbBody.AppendNewBlock("access_logs", nil)
+			accessLogsBlock.Body().SetAttributeValue("bucket", cty.StringVal(lb.AccessLogs.Bucket))
+			if lb.AccessLogs.Prefix != "" {
+				accessLogsBlock.Body().SetAttributeValue("bucket_prefix", cty.StringVal(lb.AccessLogs.Prefix))
+			}
+			if lb.AccessLogs.Interval != 0 {
+				accessLogsBlock.Body().SetAttributeValue("interval", cty.NumberIntVal(int64(lb.AccessLogs.Interval)))
+			}
+			accessLogsBlock.Body().SetAttributeValue("enabled", cty.BoolVal(lb.AccessLogs.Enabled))
+		}
+
+		if len(lb.Tags) > 0 {
+			g.appendTags(lbBody, lb.Tags)
+		}
+
+		lbNameTokens := g.reference("aws_elb", lbResourceName, "name")
+
+		// Stickiness policies
+		for _, policy := range lb.LBCookieStickinessPolicies {
+			// importには対応していないため、resourceブロックのみ出力します
+			policyBlock := g.appendResourceBlock(resourceBody, "aws_lb_cookie_stickiness_policy", lbResourceName+"_"+g.classicPolicyResourceName(policy.Name))
+			policyBlock.Body().SetAttributeValue("name", cty.StringVal(policy.Name))
+			policyBlock.Body().SetAttributeRaw("load_balancer", lbNameTokens)
+			policyBlock.Body().SetAttributeValue("lb_port", cty.NumberIntVal(int64(policy.LBPort)))
+			if policy.CookieExpirationPeriod != nil {
+				policyBlock.Body().SetAttributeValue("cookie_expiration_period", cty.NumberIntVal(*policy.CookieExpirationPeriod))
+			}
+		}
+		for _, policy := range lb.AppCookieStickinessPolicies {
+			policyResourceName := lbResourceName + "_" + g.classicPolicyResourceName(policy.Name)
+			g.appendImportBlock(importBody, "aws_app_cookie_stickiness_policy."+policyResourceName, fmt.Sprintf("%s:%d:%s", lb.Name, policy.LBPort, policy.Name))
+			policyBlock := g.appendResourceBlock(resourceBody, "aws_app_cookie_stickiness_policy", policyResourceName)
+			policyBlock.Body().SetAttributeValue("name", cty.StringVal(policy.Name))
+			policyBlock.Body().SetAttributeRaw("load_balancer", lbNameTokens)
+			policyBlock.Body().SetAttributeValue("lb_port", cty.NumberIntVal(int64(policy.LBPort)))
+			policyBlock.Body().SetAttributeValue("cookie_name", cty.StringVal(policy.CookieName))
+		}
+
+		// Other policies (importには対応していないため、resourceブロックのみ出力します)
+		policyRefs := make(map[string]string)
+		for _, policy := range lb.Policies {
+			policyResourceName := lbResourceName + "_" + g.classicPolicyResourceName(policy.Name)
+			policyRefs[policy.Name] = policyResourceName
+			policyBlock := g.appendResourceBlock(resourceBody, "aws_load_balancer_policy", policyResourceName)
+			policyBlock.Body().SetAttributeRaw("load_balancer_name", lbNameTokens)
+			policyBlock.Body().SetAttributeValue("policy_name", cty.StringVal(policy.Name))
+			policyBlock.Body().SetAttributeValue("policy_type_name", cty.StringVal(policy.TypeName))
+			for _, attr := range policy.Attributes {
+				attrBlock := policyBlock.Body().AppendNewBlock("policy_attribute", nil)
+				attrBlock.Body().SetAttributeValue("name", cty.StringVal(attr.Name))
+				attrBlock.Body().SetAttributeValue("value", cty.StringVal(attr.Value))
+			}
+		}
+
+		for _, listener := range lb.Listeners {
+			if len(listener.PolicyNames) == 0 {
+				continue
+			}
+			policyBlock := g.appendResourceBlock(resourceBody, "aws_load_balancer_listener_policy", fmt.Sprintf("%s_%d", lbResourceName, listener.LBPort))
+			policyBlock.Body().SetAttributeRaw("load_balancer_name", lbNameTokens)
+			policyBlock.Body().SetAttributeValue("load_balancer_port", cty.NumberIntVal(int64(listener.LBPort)))
+			policyBlock.Body().SetAttributeRaw("policy_names", g.referenceList(listener.PolicyNames, policyRefs, "aws_load_balancer_policy", "policy_name"))
+		}
+		for _, backend := range lb.BackendServerPolicies {
+			if len(backend.PolicyNames) == 0 {
+				continue
+			}
+			policyBlock := g.appendResourceBlock(resourceBody, "aws_load_balancer_backend_server_policy", fmt.Sprintf("%s_%d", lbResourceName, backend.InstancePort))
+			policyBlock.Body().SetAttributeRaw("load_balancer_name", lbNameTokens)
+			policyBlock.Body().SetAttributeValue("instance_port", cty.NumberIntVal(int64(backend.InstancePort)))
+			policyBlock.Body().SetAttributeRaw("policy_names", g.referenceList(backend.PolicyNames, policyRefs, "aws_load_balancer_policy", "policy_name"))
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// classicPolicyResourceName は Classic Load Balancer のポリシー名をリソース名として使える形に変換します。
+func (g *HCLGenerator) classicPolicyResourceName(policyName string) string {
+	return g.sanitize(strings.ReplaceAll(policyName, ".", "_"))
+}
+
+// appendLbTargetGroup は aws_lb_target_group のresourceブロックとimportブロックを追加し、参照用のトラバーサルを返します。
+func (g *HCLGenerator) appendLbTargetGroup(resourceBody, importBody *hclwrite.Body, tg elbv2.TargetGroup) hcl.Traversal {
+	tgResourceType := "aws_lb_target_group"
+	tgResourceName := g.sanitize(tg.Name)
+	g.appendImportBlock(importBody, tgResourceType+"."+tgResourceName, tg.Arn)
+	tgBlock := g.appendResourceBlock(resourceBody, tgResourceType, tgResourceName)
+	tgBlock.Body().SetAttributeValue("name", cty.StringVal(tg.Name))
+	// Lambda ターゲットグループには port, protocol, vpc_id がありません。
+	if tg.TargetType != "lambda" {
+		tgBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(tg.Port)))
+		tgBlock.Body().SetAttributeValue("protocol", cty.StringVal(string(tg.Protocol)))
+		tgBlock.Body().SetAttributeValue("vpc_id", cty.StringVal(tg.VpcId))
+	}
+	tgBlock.Body().SetAttributeValue("target_type", cty.StringVal(tg.TargetType))
+	g.appendTargetGroupAttributes(tgBlock.Body(), tg.Attributes)
+
+	if tg.HealthCheck != nil {
+		hcBlock := tgBlock.Body().AppendNewBlock("health_check", nil)
+		hcBlock.Body().SetAttributeValue("enabled", cty.BoolVal(tg.HealthCheck.Enabled))
+		hcBlock.Body().SetAttributeValue("path", cty.StringVal(tg.HealthCheck.Path))
+		hcBlock.Body().SetAttributeValue("port", cty.StringVal(tg.HealthCheck.Port))
+		hcBlock.Body().SetAttributeValue("protocol", cty.StringVal(string(tg.HealthCheck.Protocol)))
+		hcBlock.Body().SetAttributeValue("interval", cty.NumberIntVal(int64(tg.HealthCheck.Interval)))
+		hcBlock.Body().SetAttributeValue("timeout", cty.NumberIntVal(int64(tg.HealthCheck.Timeout)))
+		hcBlock.Body().SetAttributeValue("healthy_threshold", cty.NumberIntVal(int64(tg.HealthCheck.HealthyThreshold)))
+		hcBlock.Body().SetAttributeValue("unhealthy_threshold", cty.NumberIntVal(int64(tg.HealthCheck.UnhealthyThreshold)))
+		hcBlock.Body().SetAttributeValue("matcher", cty.StringVal(tg.HealthCheck.Matcher))
+	}
+
+	if len(tg.Tags) > 0 {
+		g.appendTags(tgBlock.Body(), tg.Tags)
+	}
+
+	tgRefParts := strings.SplitN(tgResourceType+"."+tgResourceName, ".", 2)
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: tgRefParts[0]},
+		hcl.TraverseAttr{Name: tgRefParts[1]},
+	}
+}
+
+// appendLbActions はリスナーの default_action またはリスナールールの action ブロックを追加します。
+// authenticate-oidc の client_secret は API から取得できないため、プレースホルダーを設定し
+// ignore_changes に追加すべき属性のトラバーサルを返します。
+func (g *HCLGenerator) appendLbActions(body *hclwrite.Body, blockName string, actions []elbv2.DefaultAction, tgRefs map[string]hcl.Traversal) []hcl.Traversal {
+	var ignoreTraversals []hcl.Traversal
+	for i, action := range actions {
+		actionBlock := body.AppendNewBlock(blockName, nil)
+		actionBody := actionBlock.Body()
+		actionBody.SetAttributeValue("type", cty.StringVal(string(action.Type)))
+		if len(actions) > 1 && action.Order != nil {
+			actionBody.SetAttributeValue("order", cty.NumberIntVal(int64(*action.Order)))
+		}
+
+		switch {
+		case action.Forward != nil:
+			// 重みやスティッキーセッションのない単一ターゲットグループは target_group_arn で表現します。
+			if len(action.Forward.TargetGroups) == 1 && action.Forward.TargetGroups[0].Weight == nil && (action.Forward.Stickiness == nil || !action.Forward.Stickiness.Enabled) {
+				actionBody.SetAttributeRaw("target_group_arn", g.lbTargetGroupArnTokens(tgRefs, action.Forward.TargetGroups[0].Arn))
+				break
+			}
+			forwardBlock := actionBody.AppendNewBlock("forward", nil)
+			for _, tg := range action.Forward.TargetGroups {
+				tgBlock := forwardBlock.Body().AppendNewBlock("target_group", nil)
+				tgBlock.Body().SetAttributeRaw("arn", g.lbTargetGroupArnTokens(tgRefs, tg.Arn))
+				if tg.Weight != nil {
+					tgBlock.Body().SetAttributeValue("weight", cty.NumberIntVal(*tg.Weight))
+				}
+			}
+			if action.Forward.Stickiness != nil {
+				stickinessBlock := forwardBlock.Body().AppendNewBlock("stickiness", nil)
+				stickinessBlock.Body().SetAttributeValue("enabled", cty.BoolVal(action.Forward.Stickiness.Enabled))
+				stickinessBlock.Body().SetAttributeValue("duration", cty.NumberIntVal(int64(action.Forward.Stickiness.Duration)))
+			}
+		case action.TargetGroupArn != "":
+			actionBody.SetAttributeRaw("target_group_arn", g.lbTargetGroupArnTokens(tgRefs, action.TargetGroupArn))
+		case action.Redirect != nil:
+			redirectBlock := actionBody.AppendNewBlock("redirect", nil)
+			if action.Redirect.Host != "" {
+				redirectBlock.Body().SetAttributeValue("host", cty.StringVal(action.Redirect.Host))
+			}
+			if action.Redirect.Path != "" {
+				redirectBlock.Body().SetAttributeValue("path", cty.StringVal(action.Redirect.Path))
+			}
+			redirectBlock.Body().SetAttributeValue("port", cty.StringVal(action.Redirect.Port))
+			redirectBlock.Body().SetAttributeValue("protocol", cty.StringVal(action.Redirect.Protocol))
+			if action.Redirect.Query != "" {
+				redirectBlock.Body().SetAttributeValue("query", cty.StringVal(action.Redirect.Query))
+			}
+			redirectBlock.Body().SetAttributeValue("status_code", cty.StringVal(action.Redirect.StatusCode))
+		case action.FixedResponse != nil:
+			fixedResponseBlock := actionBody.AppendNewBlock("fixed_response", nil)
+			fixedResponseBlock.Body().SetAttributeValue("content_type", cty.StringVal(action.FixedResponse.ContentType))
+			if action.FixedResponse.MessageBody != "" {
+				fixedResponseBlock.Body().SetAttributeValue("message_body", cty.StringVal(action.FixedResponse.MessageBody))
+			}
+			fixedResponseBlock.Body().SetAttributeValue("status_code", cty.StringVal(action.FixedResponse.StatusCode))
+		case action.AuthenticateOidc != nil:
+			oidc := action.AuthenticateOidc
+			oidcBlock := actionBody.AppendNewBlock("authenticate_oidc", nil)
+			oidcBlock.Body().SetAttributeValue("authorization_endpoint", cty.StringVal(oidc.AuthorizationEndpoint))
+			oidcBlock.Body().SetAttributeValue("client_id", cty.StringVal(oidc.ClientID))
+			oidcBlock.Body().SetAttributeValue("client_secret", cty.StringVal("REPLACE_ME"))
+			oidcBlock.Body().SetAttributeValue("issuer", cty.StringVal(oidc.Issuer))
+			oidcBlock.Body().SetAttributeValue("token_endpoint", cty.StringVal(oidc.TokenEndpoint))
+			oidcBlock.Body().SetAttributeValue("user_info_endpoint", cty.StringVal(oidc.UserInfoEndpoint))
+			g.appendLbAuthenticateOptions(oidcBlock.Body(), oidc)
+			ignoreTraversals = append(ignoreTraversals, hcl.Traversal{
+				hcl.TraverseRoot{Name: blockName},
+				hcl.TraverseIndex{Key: cty.NumberIntVal(int64(i))},
+				hcl.TraverseAttr{Name: "authenticate_oidc"},
+				hcl.TraverseIndex{Key: cty.NumberIntVal(0)},
+				hcl.TraverseAttr{Name: "client_secret"},
+			})
+		case action.AuthenticateCognito != nil:
+			cognito := action.AuthenticateCognito
+			cognitoBlock := actionBody.AppendNewBlock("authenticate_cognito", nil)
+			cognitoBlock.Body().SetAttributeValue("user_pool_arn", cty.StringVal(cognito.UserPoolArn))
+			cognitoBlock.Body().SetAttributeValue("user_pool_client_id", cty.StringVal(cognito.UserPoolClientID))
+			cognitoBlock.Body().SetAttributeValue("user_pool_domain", cty.StringVal(cognito.UserPoolDomain))
+			g.appendLbAuthenticateOptions(cognitoBlock.Body(), cognito)
+		}
+	}
+
+	return ignoreTraversals
+}
+
+// appendLbAuthenticateOptions は authenticate_oidc と authenticate_cognito に共通する任意項目を追加します。
+func (g *HCLGenerator) appendLbAuthenticateOptions(body *hclwrite.Body, auth *elbv2.DefaultActionAuthenticate) {
+	if len(auth.AuthenticationRequestExtraParams) > 0 {
+		body.SetAttributeValue("authentication_request_extra_params", g.stringMap(auth.AuthenticationRequestExtraParams))
+	}
+	if auth.OnUnauthenticatedRequest != "" {
+		body.SetAttributeValue("on_unauthenticated_request", cty.StringVal(auth.OnUnauthenticatedRequest))
+	}
+	if auth.Scope != "" {
+		body.SetAttributeValue("scope", cty.StringVal(auth.Scope))
+	}
+	if auth.SessionCookieName != "" {
+		body.SetAttributeValue("session_cookie_name", cty.StringVal(auth.SessionCookieName))
+	}
+	if auth.SessionTimeout != nil {
+		body.SetAttributeValue("session_timeout", cty.NumberIntVal(*auth.SessionTimeout))
+	}
+}
+
+// appendLbConditions はリスナールールの condition ブロックを追加します。
+func (g *HCLGenerator) appendLbConditions(body *hclwrite.Body, conditions []elbv2.ListenerRuleCondition) {
+	for _, cond := range conditions {
+		condBlock := body.AppendNewBlock("condition", nil)
+		switch cond.Field {
+		case "host-header":
+			hostHeaderBlock := condBlock.Body().AppendNewBlock("host_header", nil)
+			hostHeaderBlock.Body().SetAttributeValue("values", g.stringList(cond.Values))
+		case "path-pattern":
+			pathPatternBlock := condBlock.Body().AppendNewBlock("path_pattern", nil)
+			pathPatternBlock.Body().SetAttributeValue("values", g.stringList(cond.Values))
+		case "http-header":
+			httpHeaderBlock := condBlock.Body().AppendNewBlock("http_header", nil)
+			httpHeaderBlock.Body().SetAttributeValue("http_header_name", cty.StringVal(cond.HttpHeaderName))
+			httpHeaderBlock.Body().SetAttributeValue("values", g.stringList(cond.Values))
+		case "http-request-method":
+			methodBlock := condBlock.Body().AppendNewBlock("http_request_method", nil)
+			methodBlock.Body().SetAttributeValue("values", g.stringList(cond.Values))
+		case "query-string":
+			for _, qs := range cond.QueryStrings {
+				queryStringBlock := condBlock.Body().AppendNewBlock("query_string", nil)
+				if qs.Key != "" {
+					queryStringBlock.Body().SetAttributeValue("key", cty.StringVal(qs.Key))
+				}
+				queryStringBlock.Body().SetAttributeValue("value", cty.StringVal(qs.Value))
+			}
+		case "source-ip":
+			sourceIPBlock := condBlock.Body().AppendNewBlock("source_ip", nil)
+			sourceIPBlock.Body().SetAttributeValue("values", g.stringList(cond.Values))
+		}
+	}
+}
+
+// lbTargetGroupArnTokens は生成対象のターゲットグループであれば参照を、
+// 他のロードバランサーのターゲットグループであれば ARN をそのまま返します。
+func (g *HCLGenerator) lbTargetGroupArnTokens(tgRefs map[string]hcl.Traversal, arn string) hclwrite.Tokens {
+	if ref, ok := tgRefs[arn]; ok {
+		return hclwrite.TokensForTraversal(append(ref, hcl.TraverseAttr{Name: "arn"}))
+	}
+	return hclwrite.TokensForValue(cty.StringVal(arn))
+}
+
+// appendLoadBalancerAttributes は DescribeLoadBalancerAttributes で取得した属性を aws_lb に追加します。
+func (g *HCLGenerator) appendLoadBalancerAttributes(body *hclwrite.Body, attrs elbv2.LoadBalancerAttributes) {
+	if attrs.IdleTimeout != nil {
+		body.SetAttributeValue("idle_timeout", cty.NumberIntVal(int64(*attrs.IdleTimeout)))
+	}
+	if attrs.DeletionProtection != nil {
+		body.SetAttributeValue("enable_deletion_protection", cty.BoolVal(*attrs.DeletionProtection))
+	}
+	if attrs.DropInvalidHeaderFields != nil {
+		body.SetAttributeValue("drop_invalid_header_fields", cty.BoolVal(*attrs.DropInvalidHeaderFields))
+	}
+	if attrs.AccessLogs != nil && attrs.AccessLogs.Bucket != "" {
+		accessLogsBlock := body.AppendNewBlock("access_logs", nil)
+		accessLogsBlock.Body().SetAttributeValue("bucket", cty.StringVal(attrs.AccessLogs.Bucket))
+		if attrs.AccessLogs.Prefix != "" {
+			accessLogsBlock.Body().SetAttributeValue("prefix", cty.StringVal(attrs.AccessLogs.Prefix))
+		}
+		accessLogsBlock.Body().SetAttributeValue("enabled", cty.BoolVal(attrs.AccessLogs.Enabled))
+	}
+}
+
+// appendTargetGroupAttributes は DescribeTargetGroupAttributes で取得した属性を aws_lb_target_group に追加します。
+func (g *HCLGenerator) appendTargetGroupAttributes(body *hclwrite.Body, attrs elbv2.TargetGroupAttributes) {
+	if attrs.DeregistrationDelay != nil {
+		body.SetAttributeValue("deregistration_delay", cty.NumberIntVal(int64(*attrs.DeregistrationDelay)))
+	}
+	if attrs.SlowStart != nil {
+		body.SetAttributeValue("slow_start", cty.NumberIntVal(int64(*attrs.SlowStart)))
+	}
+	if attrs.Stickiness != nil && attrs.Stickiness.Type != "" {
+		stickinessBlock := body.AppendNewBlock("stickiness", nil)
+		stickinessBlock.Body().SetAttributeValue("type", cty.StringVal(attrs.Stickiness.Type))
+		stickinessBlock.Body().SetAttributeValue("enabled", cty.BoolVal(attrs.Stickiness.Enabled))
+		if attrs.Stickiness.CookieDuration != nil {
+			stickinessBlock.Body().SetAttributeValue("cookie_duration", cty.NumberIntVal(int64(*attrs.Stickiness.CookieDuration)))
+		}
+		if attrs.Stickiness.CookieName != "" {
+			stickinessBlock.Body().SetAttributeValue("cookie_name", cty.StringVal(attrs.Stickiness.CookieName))
+		}
+	}
+}
+
+// RdsRelatedResources はDBクラスタ・インスタンスと同じファイルに出力する関連リソースを保持します。
+type RdsRelatedResources struct {
+	// ClusterParameterGroups はクラスタが使うDBクラスタパラメータグループです。
+	ClusterParameterGroups []rds.DBClusterParameterGroup
+	// SubnetGroups はクラスタ・インスタンスが配置されるDBサブネットグループです。
+	SubnetGroups []rds.DBSubnetGroup
+	// OptionGroups はインスタンスが使うオプショングループです。
+	OptionGroups []rds.OptionGroup
+}
+
+// rdsReferences は同じ実行で生成されたRDS関連リソースの名前からリソース名への対応です。
+type rdsReferences struct {
+	clusters               map[string]string
+	parameterGroups        map[string]string
+	clusterParameterGroups map[string]string
+	subnetGroups           map[string]string
+	optionGroups           map[string]string
+}
+
+// GenerateRdsBlocks はRDSリソースのresourceブロックとimportブロックを生成します。
+// Aurora クラスタのメンバーは aws_db_instance ではなく aws_rds_cluster_instance として出力します。
+func (g *HCLGenerator) GenerateRdsBlocks(clusters []rds.DBCluster, instances []rds.DBInstance, pgs []rds.DBParameterGroup, related RdsRelatedResources) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	refs := rdsReferences{
+		clusters:               make(map[string]string),
+		parameterGroups:        make(map[string]string),
+		clusterParameterGroups: make(map[string]string),
+		subnetGroups:           make(map[string]string),
+		optionGroups:           make(map[string]string),
+	}
+	for _, cluster := range clusters {
+		refs.clusters[cluster.Identifier] = g.sanitize(cluster.Identifier)
+	}
+	for _, pg := range pgs {
+		refs.parameterGroups[pg.Name] = g.sanitize(pg.Name)
+	}
+	for _, pg := range related.ClusterParameterGroups {
+		refs.clusterParameterGroups[pg.Name] = g.sanitize(pg.Name)
+	}
+	for _, sg := range related.SubnetGroups {
+		refs.subnetGroups[sg.Name] = g.sanitize(sg.Name)
+	}
+	for _, og := range related.OptionGroups {
+		refs.optionGroups[og.Name] = g.sanitize(og.Name)
+	}
+
+	for _, sg := range related.SubnetGroups {
+		resourceType := "aws_db_subnet_group"
+		resourceName := refs.subnetGroups[sg.Name]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, sg.Name)
+		sgBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		sgBlock.Body().SetAttributeValue("name", cty.StringVal(sg.Name))
+		sgBlock.Body().SetAttributeValue("description", cty.StringVal(sg.Description))
+		sgBlock.Body().SetAttributeValue("subnet_ids", g.stringList(sg.SubnetIDs))
+		if len(sg.Tags) > 0 {
+			g.appendTags(sgBlock.Body(), sg.Tags)
+		}
+	}
+
+	for _, pg := range related.ClusterParameterGroups {
+		resourceType := "aws_rds_cluster_parameter_group"
+		resourceName := refs.clusterParameterGroups[pg.Name]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, pg.Name)
+		pgBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		pgBlock.Body().SetAttributeValue("name", cty.StringVal(pg.Name))
+		pgBlock.Body().SetAttributeValue("family", cty.StringVal(pg.Family))
+		pgBlock.Body().SetAttributeValue("description", cty.StringVal(pg.Description))
+		g.appendRdsParameters(pgBlock.Body(), pg.Parameters)
+		if len(pg.Tags) > 0 {
+			g.appendTags(pgBlock.Body(), pg.Tags)
+		}
+	}
+
+	for _, og := range related.OptionGroups {
+		resourceType := "aws_db_option_group"
+		resourceName := refs.optionGroups[og.Name]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, og.Name)
+		ogBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		g.appendOptionGroupAttributes(ogBlock.Body(), og)
+	}
+
+	for _, cluster := range clusters {
+		resourceType := "aws_rds_cluster"
+		resourceName := refs.clusters[cluster.Identifier]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, cluster.Identifier)
+		clusterBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		g.appendRdsClusterAttributes(clusterBlock.Body(), cluster, refs)
+	}
+
+	for _, instance := range instances {
+		resourceName := g.sanitize(instance.Identifier)
+		if instance.DBClusterIdentifier != "" {
+			resourceType := "aws_rds_cluster_instance"
+			g.appendImportBlock(importBody, resourceType+"."+resourceName, instance.Identifier)
+			instanceBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+			g.appendRdsClusterInstanceAttributes(instanceBlock.Body(), instance, refs)
+			continue
+		}
+		resourceType := "aws_db_instance"
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, instance.Identifier)
+		instanceBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		g.appendDBInstanceAttributes(instanceBlock.Body(), instance, refs)
+	}
+
+	for _, pg := range pgs {
+		resourceType := "aws_db_parameter_group"
+		resourceName := refs.parameterGroups[pg.Name]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, pg.Name)
+		pgBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		pgBlock.Body().SetAttributeValue("name", cty.StringVal(pg.Name))
+		pgBlock.Body().SetAttributeValue("family", cty.StringVal(pg.Family))
+		pgBlock.Body().SetAttributeValue("description", cty.StringVal(pg.Description))
+		g.appendRdsParameters(pgBlock.Body(), pg.Parameters)
+		if len(pg.Tags) > 0 {
+			g.appendTags(pgBlock.Body(), pg.Tags)
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateRdsProxyBlocks はRDS Proxyと default ターゲットグループ、ターゲットのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateRdsProxyBlocks(proxies []rds.DBProxy) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, proxy := range proxies {
+		resourceType := "aws_db_proxy"
+		resourceName := g.sanitize(proxy.Name)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, proxy.Name)
+		proxyBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		proxyBody := proxyBlock.Body()
+		proxyBody.SetAttributeValue("name", cty.StringVal(proxy.Name))
+		proxyBody.SetAttributeValue("engine_family", cty.StringVal(proxy.EngineFamily))
+		proxyBody.SetAttributeValue("role_arn", cty.StringVal(proxy.RoleArn))
+		proxyBody.SetAttributeValue("vpc_subnet_ids", g.stringList(proxy.VpcSubnetIDs))
+		if len(proxy.VpcSecurityGroupIDs) > 0 {
+			proxyBody.SetAttributeValue("vpc_security_group_ids", g.stringList(proxy.VpcSecurityGroupIDs))
+		}
+		proxyBody.SetAttributeValue("require_tls", cty.BoolVal(proxy.RequireTLS))
+		proxyBody.SetAttributeValue("debug_logging", cty.BoolVal(proxy.DebugLogging))
+		if proxy.IdleClientTimeout > 0 {
+			proxyBody.SetAttributeValue("idle_client_timeout", cty.NumberIntVal(int64(proxy.IdleClientTimeout)))
+		}
+		for _, auth := range proxy.Auth {
+			authBlock := proxyBody.AppendNewBlock("auth", nil)
+			if auth.AuthScheme != "" {
+				authBlock.Body().SetAttributeValue("auth_scheme", cty.StringVal(auth.AuthScheme))
+			}
+			if auth.ClientPasswordAuthType != "" {
+				authBlock.Body().SetAttributeValue("client_password_auth_type", cty.StringVal(auth.ClientPasswordAuthType))
+			}
+			if auth.Description != "" {
+				authBlock.Body().SetAttributeValue("description", cty.StringVal(auth.Description))
+			}
+			if auth.IAMAuth != "" {
+				authBlock.Body().SetAttributeValue("iam_auth", cty.StringVal(auth.IAMAuth))
+			}
+			if auth.SecretArn != "" {
+				authBlock.Body().SetAttributeValue("secret_arn", cty.StringVal(auth.SecretArn))
+			}
+			if auth.Username != "" {
+				authBlock.Body().SetAttributeValue("username", cty.StringVal(auth.Username))
+			}
+		}
+		if len(proxy.Tags) > 0 {
+			g.appendTags(proxyBody, proxy.Tags)
+		}
+
+		if proxy.DefaultTargetGroup == nil {
+			continue
+		}
+		tg := proxy.DefaultTargetGroup
+		tgResourceType := "aws_db_proxy_default_target_group"
+		g.appendImportBlock(importBody, tgResourceType+"."+resourceName, proxy.Name)
+		tgBlock := g.appendResourceBlock(resourceBody, tgResourceType, resourceName)
+		tgBlock.Body().SetAttributeRaw("db_proxy_name", g.reference(resourceType, resourceName, "name"))
+		poolBlock := tgBlock.Body().AppendNewBlock("connection_pool_config", nil)
+		poolBlock.Body().SetAttributeValue("connection_borrow_timeout", cty.NumberIntVal(int64(tg.ConnectionBorrowTimeout)))
+		if tg.InitQuery != "" {
+			poolBlock.Body().SetAttributeValue("init_query", cty.StringVal(tg.InitQuery))
+		}
+		poolBlock.Body().SetAttributeValue("max_connections_percent", cty.NumberIntVal(int64(tg.MaxConnectionsPercent)))
+		poolBlock.Body().SetAttributeValue("max_idle_connections_percent", cty.NumberIntVal(int64(tg.MaxIdleConnectionsPercent)))
+		if len(tg.SessionPinningFilters) > 0 {
+			poolBlock.Body().SetAttributeValue("session_pinning_filters", g.stringList(tg.SessionPinningFilters))
+		}
+
+		for _, target := range proxy.Targets {
+			targetResourceType := "aws_db_proxy_target"
+			targetResourceName := resourceName + "_" + g.sanitize(target.RdsResourceID)
+			g.appendImportBlock(importBody, targetResourceType+"."+targetResourceName, strings.Join([]string{proxy.Name, target.TargetGroupName, target.Type, target.RdsResourceID}, "/"))
+			targetBlock := g.appendResourceBlock(resourceBody, targetResourceType, targetResourceName)
+			targetBlock.Body().SetAttributeRaw("db_proxy_name", g.reference(resourceType, resourceName, "name"))
+			targetBlock.Body().SetAttributeRaw("target_group_name", g.reference(tgResourceType, resourceName, "name"))
+			if target.Type == "TRACKED_CLUSTER" {
+				targetBlock.Body().SetAttributeValue("db_cluster_identifier", cty.StringVal(target.RdsResourceID))
+			} else {
+				targetBlock.Body().SetAttributeValue("db_instance_identifier", cty.StringVal(target.RdsResourceID))
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateRdsGlobalClusterBlocks はグローバルクラスタのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateRdsGlobalClusterBlocks(globalClusters []rds.GlobalCluster) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, gc := range globalClusters {
+		resourceType := "aws_rds_global_cluster"
+		resourceName := g.sanitize(gc.Identifier)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, gc.Identifier)
+		gcBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		gcBlock.Body().SetAttributeValue("global_cluster_identifier", cty.StringVal(gc.Identifier))
+		gcBlock.Body().SetAttributeValue("engine", cty.StringVal(gc.Engine))
+		if gc.EngineVersion != "" {
+			gcBlock.Body().SetAttributeValue("engine_version", cty.StringVal(gc.EngineVersion))
+		}
+		if gc.DatabaseName != "" {
+			gcBlock.Body().SetAttributeValue("database_name", cty.StringVal(gc.DatabaseName))
+		}
+		gcBlock.Body().SetAttributeValue("storage_encrypted", cty.BoolVal(gc.StorageEncrypted))
+		gcBlock.Body().SetAttributeValue("deletion_protection", cty.BoolVal(gc.DeletionProtection))
+		if len(gc.Tags) > 0 {
+			g.appendTags(gcBlock.Body(), gc.Tags)
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateRdsEventSubscriptionBlocks はRDSイベントサブスクリプションのresourceブロックとimportブロックを生成します。
+func (g *HCLGenerator) GenerateRdsEventSubscriptionBlocks(subscriptions []rds.EventSubscription) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, sub := range subscriptions {
+		resourceType := "aws_db_event_subscription"
+		resourceName := g.sanitize(sub.Name)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, sub.Name)
+		subBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		subBlock.Body().SetAttributeValue("name", cty.StringVal(sub.Name))
+		subBlock.Body().SetAttributeValue("sns_topic", cty.StringVal(sub.SnsTopicArn))
+		if sub.SourceType != "" {
+			subBlock.Body().SetAttributeValue("source_type", cty.StringVal(sub.SourceType))
+		}
+		if len(sub.SourceIDs) > 0 {
+			subBlock.Body().SetAttributeValue("source_ids", g.stringList(sub.SourceIDs))
+		}
+		if len(sub.EventCategories) > 0 {
+			subBlock.Body().SetAttributeValue("event_categories", g.stringList(sub.EventCategories))
+		}
+		subBlock.Body().SetAttributeValue("enabled", cty.BoolVal(sub.Enabled))
+		if len(sub.Tags) > 0 {
+			g.appendTags(subBlock.Body(), sub.Tags)
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// appendRdsParameters はパラメータグループの parameter ブロックを設定します。
+func (g *HCLGenerator) appendRdsParameters(body *hclwrite.Body, parameters []rds.Parameter) {
+	for _, p := range parameters {
+		parameterBlock := body.AppendNewBlock("parameter", nil)
+		parameterBlock.Body().SetAttributeValue("name", cty.StringVal(p.Name))
+		parameterBlock.Body().SetAttributeValue("value", cty.StringVal(p.Value))
+		if p.ApplyMethod != "" {
+			parameterBlock.Body().SetAttributeValue("apply_method", cty.StringVal(p.ApplyMethod))
+		}
+	}
+}
+
+// appendOptionGroupAttributes は aws_db_option_group の属性とオプションを設定します。
+func (g *HCLGenerator) appendOptionGroupAttributes(body *hclwrite.Body, og rds.OptionGroup) {
+	body.SetAttributeValue("name", cty.StringVal(og.Name))
+	body.SetAttributeValue("engine_name", cty.StringVal(og.EngineName))
+	body.SetAttributeValue("major_engine_version", cty.StringVal(og.MajorEngineVersion))
+	body.SetAttributeValue("option_group_description", cty.StringVal(og.Description))
+	for _, option := range og.Options {
+		optionBlock := body.AppendNewBlock("option", nil)
+		optionBlock.Body().SetAttributeValue("option_name", cty.StringVal(option.Name))
+		if option.Port > 0 {
+			optionBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(option.Port)))
+		}
+		if option.Version != "" {
+			optionBlock.Body().SetAttributeValue("version", cty.StringVal(option.Version))
+		}
+		if len(option.VpcSecurityGroupIDs) > 0 {
+			optionBlock.Body().SetAttributeValue("vpc_security_group_memberships", g.stringList(option.VpcSecurityGroupIDs))
+		}
+		for _, setting := range option.Settings {
+			settingBlock := optionBlock.Body().AppendNewBlock("option_settings", nil)
+			settingBlock.Body().SetAttributeValue("name", cty.StringVal(setting.Name))
+			settingBlock.Body().SetAttributeValue("value", cty.StringVal(setting.Value))
+		}
+	}
+	if len(og.Tags) > 0 {
+		g.appendTags(body, og.Tags)
+	}
+}
+
+// appendRdsClusterAttributes は aws_rds_cluster の属性を設定します。
+// ストレージ容量や IOPS はマルチAZ DBクラスタでのみ指定できるため、Aurora では出力しません。
+func (g *HCLGenerator) appendRdsClusterAttributes(body *hclwrite.Body, cluster rds.DBCluster, refs rdsReferences) {
+	body.SetAttributeValue("cluster_identifier", cty.StringVal(cluster.Identifier))
+	body.SetAttributeValue("engine", cty.StringVal(cluster.Engine))
+	body.SetAttributeValue("engine_mode", cty.StringVal(cluster.EngineMode))
+	if cluster.EngineVersion != "" {
+		body.SetAttributeValue("engine_version", cty.StringVal(cluster.EngineVersion))
+	}
+	if cluster.DatabaseName != "" {
+		body.SetAttributeValue("database_name", cty.StringVal(cluster.DatabaseName))
+	}
+	if cluster.MasterUsername != "" {
+		body.SetAttributeValue("master_username", cty.StringVal(cluster.MasterUsername))
+	}
+	if cluster.ManageMasterUserPassword {
+		body.SetAttributeValue("manage_master_user_password", cty.True)
+	}
+	if cluster.Port > 0 {
+		body.SetAttributeValue("port", cty.NumberIntVal(int64(cluster.Port)))
+	}
+	if cluster.DBClusterInstanceClass != "" {
+		body.SetAttributeValue("db_cluster_instance_class", cty.StringVal(cluster.DBClusterInstanceClass))
+		body.SetAttributeValue("allocated_storage", cty.NumberIntVal(int64(cluster.AllocatedStorage)))
+		if cluster.Iops > 0 {
+			body.SetAttributeValue("iops", cty.NumberIntVal(int64(cluster.Iops)))
+		}
+	}
+	// Aurora の標準ストレージ ("aurora") は storage_type を省略した状態と同じです。
+	if cluster.StorageType != "" && cluster.StorageType != "aurora" {
+		body.SetAttributeValue("storage_type", cty.StringVal(cluster.StorageType))
+	}
+	body.SetAttributeValue("storage_encrypted", cty.BoolVal(cluster.StorageEncrypted))
+	if cluster.KmsKeyID != "" {
+		body.SetAttributeValue("kms_key_id", cty.StringVal(cluster.KmsKeyID))
+	}
+	if cluster.DBSubnetGroupName != "" {
+		g.setReferenceOrValue(body, "db_subnet_group_name", refs.subnetGroups, "aws_db_subnet_group", cluster.DBSubnetGroupName)
+	}
+	if len(cluster.VpcSecurityGroupIDs) > 0 {
+		body.SetAttributeValue("vpc_security_group_ids", g.stringList(cluster.VpcSecurityGroupIDs))
+	}
+	if cluster.DBClusterParameterGroup != "" {
+		g.setReferenceOrValue(body, "db_cluster_parameter_group_name", refs.clusterParameterGroups, "aws_rds_cluster_parameter_group", cluster.DBClusterParameterGroup)
+	}
+	body.SetAttributeValue("backup_retention_period", cty.NumberIntVal(int64(cluster.BackupRetentionPeriod)))
+	if cluster.PreferredBackupWindow != "" {
+		body.SetAttributeValue("preferred_backup_window", cty.StringVal(cluster.PreferredBackupWindow))
+	}
+	if cluster.PreferredMaintenanceWindow != "" {
+		body.SetAttributeValue("preferred_maintenance_window", cty.StringVal(cluster.PreferredMaintenanceWindow))
+	}
+	body.SetAttributeValue("iam_database_authentication_enabled", cty.BoolVal(cluster.IAMDatabaseAuthenticationEnabled))
+	body.SetAttributeValue("deletion_protection", cty.BoolVal(cluster.DeletionProtection))
+	body.SetAttributeValue("copy_tags_to_snapshot", cty.BoolVal(cluster.CopyTagsToSnapshot))
+	if len(cluster.EnabledCloudwatchLogsExports) > 0 {
+		body.SetAttributeValue("enabled_cloudwatch_logs_exports", g.stringList(cluster.EnabledCloudwatchLogsExports))
+	}
+	if cluster.PerformanceInsightsEnabled {
+		body.SetAttributeValue("performance_insights_enabled", cty.True)
+		if cluster.PerformanceInsightsKMSKeyID != "" {
+			body.SetAttributeValue("performance_insights_kms_key_id", cty.StringVal(cluster.PerformanceInsightsKMSKeyID))
+		}
+		if cluster.PerformanceInsightsRetentionPeriod > 0 {
+			body.SetAttributeValue("performance_insights_retention_period", cty.NumberIntVal(int64(cluster.PerformanceInsightsRetentionPeriod)))
+		}
+	}
+	if cluster.MonitoringInterval > 0 {
+		body.SetAttributeValue("monitoring_interval", cty.NumberIntVal(int64(cluster.MonitoringInterval)))
+		if cluster.MonitoringRoleArn != "" {
+			body.SetAttributeValue("monitoring_role_arn", cty.StringVal(cluster.MonitoringRoleArn))
+		}
+	}
+	if cluster.ServerlessV2Scaling != nil {
+		scalingBlock := body.AppendNewBlock("serverlessv2_scaling_configuration", nil)
+		scalingBlock.Body().SetAttributeValue("min_capacity", cty.NumberFloatVal(cluster.ServerlessV2Scaling.MinCapacity))
+		scalingBlock.Body().SetAttributeValue("max_capacity", cty.NumberFloatVal(cluster.ServerlessV2Scaling.MaxCapacity))
+	}
+	if len(cluster.Tags) > 0 {
+		g.appendTags(body, cluster.Tags)
+	}
+}
+
+// appendDBInstanceAttributes は aws_db_instance の属性を設定します。
+// パラメータグループ等が同じ実行で生成されていれば参照にします。
+func (g *HCLGenerator) appendDBInstanceAttributes(body *hclwrite.Body, instance rds.DBInstance, refs rdsReferences) {
+	body.SetAttributeValue("identifier", cty.StringVal(instance.Identifier))
+	body.SetAttributeValue("engine", cty.StringVal(instance.Engine))
+	if instance.EngineVersion != "" {
+		body.SetAttributeValue("engine_version", cty.StringVal(instance.EngineVersion))
+	}
+	body.SetAttributeValue("instance_class", cty.StringVal(instance.InstanceClass))
+	if instance.DBName != "" {
+		body.SetAttributeValue("db_name", cty.StringVal(instance.DBName))
+	}
+	if instance.MasterUsername != "" {
+		body.SetAttributeValue("username", cty.StringVal(instance.MasterUsername))
+	}
+	if instance.ManageMasterUserPassword {
+		body.SetAttributeValue("manage_master_user_password", cty.True)
+	}
+	if instance.Port > 0 {
+		body.SetAttributeValue("port", cty.NumberIntVal(int64(instance.Port)))
+	}
+	body.SetAttributeValue("allocated_storage", cty.NumberIntVal(int64(instance.AllocatedStorage)))
+	if instance.MaxAllocatedStorage > 0 {
+		body.SetAttributeValue("max_allocated_storage", cty.NumberIntVal(int64(instance.MaxAllocatedStorage)))
+	}
+	if instance.StorageType != "" {
+		body.SetAttributeValue("storage_type", cty.StringVal(instance.StorageType))
+	}
+	if instance.Iops > 0 {
+		body.SetAttributeValue("iops", cty.NumberIntVal(int64(instance.Iops)))
+	}
+	if instance.StorageThroughput > 0 {
+		body.SetAttributeValue("storage_throughput", cty.NumberIntVal(int64(instance.StorageThroughput)))
+	}
+	body.SetAttributeValue("storage_encrypted", cty.BoolVal(instance.StorageEncrypted))
+	if instance.KmsKeyID != "" {
+		body.SetAttributeValue("kms_key_id", cty.StringVal(instance.KmsKeyID))
+	}
+	body.SetAttributeValue("multi_az", cty.BoolVal(instance.MultiAZ))
+	// マルチAZ配置ではフェイルオーバーでAZが変わるため、availability_zone は固定しません。
+	if !instance.MultiAZ && instance.AvailabilityZone != "" {
+		body.SetAttributeValue("availability_zone", cty.StringVal(instance.AvailabilityZone))
+	}
+	body.SetAttributeValue("publicly_accessible", cty.BoolVal(instance.PubliclyAccessible))
+	body.SetAttributeValue("auto_minor_version_upgrade", cty.BoolVal(instance.AutoMinorVersionUpgrade))
+	if instance.CACertificateIdentifier != "" {
+		body.SetAttributeValue("ca_cert_identifier", cty.StringVal(instance.CACertificateIdentifier))
+	}
+	if instance.DBSubnetGroupName != "" {
+		g.setReferenceOrValue(body, "db_subnet_group_name", refs.subnetGroups, "aws_db_subnet_group", instance.DBSubnetGroupName)
+	}
+	if len(instance.VpcSecurityGroupIDs) > 0 {
+		body.SetAttributeValue("vpc_security_group_ids", g.stringList(instance.VpcSecurityGroupIDs))
+	}
+	if len(instance.DBParameterGroups) > 0 {
+		g.setReferenceOrValue(body, "parameter_group_name", refs.parameterGroups, "aws_db_parameter_group", instance.DBParameterGroups[0])
+	}
+	if len(instance.OptionGroupNames) > 0 {
+		g.setReferenceOrValue(body, "option_group_name", refs.optionGroups, "aws_db_option_group", instance.OptionGroupNames[0])
+	}
+	body.SetAttributeValue("backup_retention_period", cty.NumberIntVal(int64(instance.BackupRetentionPeriod)))
+	if instance.PreferredBackupWindow != "" {
+		body.SetAttributeValue("backup_window", cty.StringVal(instance.PreferredBackupWindow))
+	}
+	if instance.PreferredMaintenanceWindow != "" {
+		body.SetAttributeValue("maintenance_window", cty.StringVal(instance.PreferredMaintenanceWindow))
+	}
+	body.SetAttributeValue("iam_database_authentication_enabled", cty.BoolVal(instance.IAMDatabaseAuthenticationEnabled))
+	body.SetAttributeValue("deletion_protection", cty.BoolVal(instance.DeletionProtection))
+	body.SetAttributeValue("copy_tags_to_snapshot", cty.BoolVal(instance.CopyTagsToSnapshot))
+	if len(instance.EnabledCloudwatchLogsExports) > 0 {
+		body.SetAttributeValue("enabled_cloudwatch_logs_exports", g.stringList(instance.EnabledCloudwatchLogsExports))
+	}
+	if instance.PerformanceInsightsEnabled {
+		body.SetAttributeValue("performance_insights_enabled", cty.True)
+		if instance.PerformanceInsightsKMSKeyID != "" {
+			body.SetAttributeValue("performance_insights_kms_key_id", cty.StringVal(instance.PerformanceInsightsKMSKeyID))
+		}
+		if instance.PerformanceInsightsRetentionPeriod > 0 {
+			body.SetAttributeValue("performance_insights_retention_period", cty.NumberIntVal(int64(instance.PerformanceInsightsRetentionPeriod)))
+		}
+	}
+	if instance.MonitoringInterval > 0 {
+		body.SetAttributeValue("monitoring_interval", cty.NumberIntVal(int64(instance.MonitoringInterval)))
+		if instance.MonitoringRoleArn != "" {
+			body.SetAttributeValue("monitoring_role_arn", cty.StringVal(instance.MonitoringRoleArn))
+		}
+	}
+	if len(instance.Tags) > 0 {
+		g.appendTags(body, instance.Tags)
+	}
+}
+
+// appendRdsClusterInstanceAttributes は Aurora クラスタのメンバーを aws_rds_cluster_instance として設定します。
+// ストレージ、バックアップ、暗号化等はクラスタ側の設定のため出力しません。
+func (g *HCLGenerator) appendRdsClusterInstanceAttributes(body *hclwrite.Body, instance rds.DBInstance, refs rdsReferences) {
+	body.SetAttributeValue("identifier", cty.StringVal(instance.Identifier))
+	g.setReferenceOrValue(body, "cluster_identifier", refs.clusters, "aws_rds_cluster", instance.DBClusterIdentifier)
+	body.SetAttributeValue("engine", cty.StringVal(instance.Engine))
+	if instance.EngineVersion != "" {
+		body.SetAttributeValue("engine_version", cty.StringVal(instance.EngineVersion))
+	}
+	body.SetAttributeValue("instance_class", cty.StringVal(instance.InstanceClass))
+	if instance.AvailabilityZone != "" {
+		body.SetAttributeValue("availability_zone", cty.StringVal(instance.AvailabilityZone))
+	}
+	body.SetAttributeValue("promotion_tier", cty.NumberIntVal(int64(instance.PromotionTier)))
+	body.SetAttributeValue("publicly_accessible", cty.BoolVal(instance.PubliclyAccessible))
+	body.SetAttributeValue("auto_minor_version_upgrade", cty.BoolVal(instance.AutoMinorVersionUpgrade))
+	if instance.CACertificateIdentifier != "" {
+		body.SetAttributeValue("ca_cert_identifier", cty.StringVal(instance.CACertificateIdentifier))
+	}
+	if instance.DBSubnetGroupName != "" {
+		g.setReferenceOrValue(body, "db_subnet_group_name", refs.subnetGroups, "aws_db_subnet_group", instance.DBSubnetGroupName)
+	}
+	if len(instance.DBParameterGroups) > 0 {
+		g.setReferenceOrValue(body, "db_parameter_group_name", refs.parameterGroups, "aws_db_parameter_group", instance.DBParameterGroups[0])
+	}
+	if instance.PreferredMaintenanceWindow != "" {
+		body.SetAttributeValue("preferred_maintenance_window", cty.StringVal(instance.PreferredMaintenanceWindow))
+	}
+	body.SetAttributeValue("copy_tags_to_snapshot", cty.BoolVal(instance.CopyTagsToSnapshot))
+	if instance.PerformanceInsightsEnabled {
+		body.SetAttributeValue("performance_insights_enabled", cty.True)
+		if instance.PerformanceInsightsKMSKeyID != "" {
+			body.SetAttributeValue("performance_insights_kms_key_id", cty.StringVal(instance.PerformanceInsightsKMSKeyID))
+		}
+		if instance.PerformanceInsightsRetentionPeriod > 0 {
+			body.SetAttributeValue("performance_insights_retention_period", cty.NumberIntVal(int64(instance.PerformanceInsightsRetentionPeriod)))
+		}
+	}
+	if instance.MonitoringInterval > 0 {
+		body.SetAttributeValue("monitoring_interval", cty.NumberIntVal(int64(instance.MonitoringInterval)))
+		if instance.MonitoringRoleArn != "" {
+			body.SetAttributeValue("monitoring_role_arn", cty.StringVal(instance.MonitoringRoleArn))
+		}
+	}
+	if len(instance.Tags) > 0 {
+		g.appendTags(body, instance.Tags)
+	}
+}
+
+// LambdaReferences はLambdaリソースから参照する、同じ実行で生成される他リソースの情報を保持します。
+type LambdaReferences struct {
+	// Roles はIAMロールのARNからロール名への対応です。
+	Roles map[string]string
+}
+
+// LambdaCodePath はZipパッケージの関数のコードを参照するプレースホルダのパスを返します。
+func (g *HCLGenerator) LambdaCodePath(functionName string) string {
+	return path.Join("lambda", functionName+".zip")
+}
+
+// GenerateLambdaBlocks はLambda関数と、そのエイリアス、パーミッション、イベントソースマッピング、
+// 関数URL、ロググループのresourceブロックとimportブロックを生成します。
+// Zipパッケージのコードは codeBucket が指定された場合は s3_bucket/s3_key で、そうでなければ
+// LambdaCodePath の filename で参照し、コードの差分は ignore_changes で無視します。
+func (g *HCLGenerator) GenerateLambdaBlocks(functions []lambda.Function, refs LambdaReferences, codeBucket string) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, fn := range functions {
+		resourceType := "aws_lambda_function"
+		resourceName := g.sanitize(fn.Name)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, fn.Name)
+		fnBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		g.appendLambdaFunctionAttributes(fnBlock.Body(), fn, refs, codeBucket)
+
+		if lg := fn.LogGroup; lg != nil {
+			lgResourceType := "aws_cloudwatch_log_group"
+			g.appendImportBlock(importBody, lgResourceType+"."+resourceName, lg.Name)
+			lgBlock := g.appendResourceBlock(resourceBody, lgResourceType, resourceName)
+			lgBlock.Body().SetAttributeValue("name", cty.StringVal(lg.Name))
+			if lg.RetentionInDays > 0 {
+				lgBlock.Body().SetAttributeValue("retention_in_days", cty.NumberIntVal(int64(lg.RetentionInDays)))
+			}
+			if lg.KmsKeyID != "" {
+				lgBlock.Body().SetAttributeValue("kms_key_id", cty.StringVal(lg.KmsKeyID))
+			}
+		}
+
+		aliasRefs := make(map[string]string)
+		for _, alias := range fn.Aliases {
+			aliasResourceType := "aws_lambda_alias"
+			aliasResourceName := g.sanitize(fn.Name + "_" + alias.Name)
+			aliasRefs[alias.Name] = aliasResourceName
+			g.appendImportBlock(importBody, aliasResourceType+"."+aliasResourceName, fn.Name+"/"+alias.Name)
+			aliasBlock := g.appendResourceBlock(resourceBody, aliasResourceType, aliasResourceName)
+			aliasBlock.Body().SetAttributeValue("name", cty.StringVal(alias.Name))
+			aliasBlock.Body().SetAttributeRaw("function_name", g.reference(resourceType, resourceName, "function_name"))
+			aliasBlock.Body().SetAttributeValue("function_version", cty.StringVal(alias.FunctionVersion))
+			if alias.Description != "" {
+				aliasBlock.Body().SetAttributeValue("description", cty.StringVal(alias.Description))
+			}
+			if len(alias.AdditionalVersionWeights) > 0 {
+				weights := make(map[string]cty.Value)
+				for version, weight := range alias.AdditionalVersionWeights {
+					weights[version] = cty.NumberFloatVal(weight)
+				}
+				routingBlock := aliasBlock.Body().AppendNewBlock("routing_config", nil)
+				routingBlock.Body().SetAttributeValue("additional_version_weights", cty.MapVal(weights))
+			}
+		}
+
+		for _, p := range fn.Permissions {
+			permResourceType := "aws_lambda_permission"
+			permResourceName := g.sanitize(fn.Name + "_" + p.StatementID)
+			importID := fn.Name + "/" + p.StatementID
+			if p.Qualifier != "" {
+				permResourceName = g.sanitize(fn.Name + "_" + p.Qualifier + "_" + p.StatementID)
+				importID = fn.Name + ":" + p.Qualifier + "/" + p.StatementID
+			}
+			g.appendImportBlock(importBody, permResourceType+"."+permResourceName, importID)
+			permBlock := g.appendResourceBlock(resourceBody, permResourceType, permResourceName)
+			permBlock.Body().SetAttributeValue("statement_id", cty.StringVal(p.StatementID))
+			permBlock.Body().SetAttributeValue("action", cty.StringVal(p.Action))
+			permBlock.Body().SetAttributeRaw("function_name", g.reference(resourceType, resourceName, "function_name"))
+			permBlock.Body().SetAttributeValue("principal", cty.StringVal(p.Principal))
+			if p.Qualifier != "" {
+				g.setLambdaQualifier(permBlock.Body(), aliasRefs, p.Qualifier)
+			}
+			if p.SourceArn != "" {
+				permBlock.Body().SetAttributeValue("source_arn", cty.StringVal(p.SourceArn))
+			}
+			if p.SourceAccount != "" {
+				permBlock.Body().SetAttributeValue("source_account", cty.StringVal(p.SourceAccount))
+			}
+			if p.PrincipalOrgID != "" {
+				permBlock.Body().SetAttributeValue("principal_org_id", cty.StringVal(p.PrincipalOrgID))
+			}
+			if p.EventSourceToken != "" {
+				permBlock.Body().SetAttributeValue("event_source_token", cty.StringVal(p.EventSourceToken))
+			}
+			if p.FunctionURLAuthType != "" {
+				permBlock.Body().SetAttributeValue("function_url_auth_type", cty.StringVal(p.FunctionURLAuthType))
+			}
+		}
+
+		for _, m := range fn.EventSourceMappings {
+			esmResourceType := "aws_lambda_event_source_mapping"
+			esmResourceName := g.sanitize(fn.Name + "_" + strings.SplitN(m.UUID, "-", 2)[0])
+			g.appendImportBlock(importBody, esmResourceType+"."+esmResourceName, m.UUID)
+			esmBlock := g.appendResourceBlock(resourceBody, esmResourceType, esmResourceName)
+			g.appendEventSourceMappingAttributes(esmBlock.Body(), m, g.reference(resourceType, resourceName, "arn"))
+		}
+
+		for _, u := range fn.URLs {
+			urlResourceType := "aws_lambda_function_url"
+			urlResourceName := resourceName
+			importID := fn.Name
+			if u.Qualifier != "" {
+				urlResourceName = g.sanitize(fn.Name + "_" + u.Qualifier)
+				importID = fn.Name + "/" + u.Qualifier
+			}
+			g.appendImportBlock(importBody, urlResourceType+"."+urlResourceName, importID)
+			urlBlock := g.appendResourceBlock(resourceBody, urlResourceType, urlResourceName)
+			urlBlock.Body().SetAttributeRaw("function_name", g.reference(resourceType, resourceName, "function_name"))
+			if u.Qualifier != "" {
+				g.setLambdaQualifier(urlBlock.Body(), aliasRefs, u.Qualifier)
+			}
+			urlBlock.Body().SetAttributeValue("authorization_type", cty.StringVal(u.AuthType))
+			if u.InvokeMode != "" {
+				urlBlock.Body().SetAttributeValue("invoke_mode", cty.StringVal(u.InvokeMode))
+			}
+			if c := u.Cors; c != nil {
+				corsBlock := urlBlock.Body().AppendNewBlock("cors", nil)
+				corsBlock.Body().SetAttributeValue("allow_credentials", cty.BoolVal(c.AllowCredentials))
+				if len(c.AllowHeaders) > 0 {
+					corsBlock.Body().SetAttributeValue("allow_headers", g.stringList(c.AllowHeaders))
+				}
+				if len(c.AllowMethods) > 0 {
+					corsBlock.Body().SetAttributeValue("allow_methods", g.stringList(c.AllowMethods))
+				}
+				if len(c.AllowOrigins) > 0 {
+					corsBlock.Body().SetAttributeValue("allow_origins", g.stringList(c.AllowOrigins))
+				}
+				if len(c.ExposeHeaders) > 0 {
+					corsBlock.Body().SetAttributeValue("expose_headers", g.stringList(c.ExposeHeaders))
+				}
+				if c.MaxAge > 0 {
+					corsBlock.Body().SetAttributeValue("max_age", cty.NumberIntVal(int64(c.MaxAge)))
+				}
 			}
-			serviceBlock.Body().SetAttributeRaw("cluster", hclwrite.TokensForTraversal(clusterTraversal))
 		}
 	}
 
 	return resourceFile, importFile, nil
 }
 
-// GenerateElbBlocks はELBv2リソースのresourceブロックとimportブロックを生成します。
-func (g *HCLGenerator) GenerateElbBlocks(lbs []*elbv2.LoadBalancer) (*hclwrite.File, *hclwrite.File, error) {
+// appendLambdaFunctionAttributes は aws_lambda_function の属性を設定します。
+func (g *HCLGenerator) appendLambdaFunctionAttributes(body *hclwrite.Body, fn lambda.Function, refs LambdaReferences, codeBucket string) {
+	body.SetAttributeValue("function_name", cty.StringVal(fn.Name))
+	g.setRoleArn(body, "role", refs.Roles, fn.RoleArn)
+	if fn.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(fn.Description))
+	}
+
+	// コード本体は取得しないため、プレースホルダで参照し差分を無視します。
+	var ignoreAttrs []string
+	if fn.PackageType == "Image" {
+		body.SetAttributeValue("package_type", cty.StringVal(fn.PackageType))
+		body.SetAttributeValue("image_uri", cty.StringVal(fn.ImageURI))
+		ignoreAttrs = []string{"image_uri"}
+	} else {
+		body.SetAttributeValue("runtime", cty.StringVal(fn.Runtime))
+		body.SetAttributeValue("handler", cty.StringVal(fn.Handler))
+		if codeBucket != "" {
+			body.SetAttributeValue("s3_bucket", cty.StringVal(codeBucket))
+			body.SetAttributeValue("s3_key", cty.StringVal(fn.Name+".zip"))
+			ignoreAttrs = []string{"s3_bucket", "s3_key", "source_code_hash"}
+		} else {
+			body.SetAttributeValue("filename", cty.StringVal(g.LambdaCodePath(fn.Name)))
+			ignoreAttrs = []string{"filename", "source_code_hash"}
+		}
+	}
+
+	body.SetAttributeValue("memory_size", cty.NumberIntVal(int64(fn.MemorySize)))
+	body.SetAttributeValue("timeout", cty.NumberIntVal(int64(fn.Timeout)))
+	if len(fn.Architectures) > 0 {
+		body.SetAttributeValue("architectures", g.stringList(fn.Architectures))
+	}
+	if len(fn.Layers) > 0 {
+		body.SetAttributeValue("layers", g.stringList(fn.Layers))
+	}
+	if fn.ReservedConcurrency != nil {
+		body.SetAttributeValue("reserved_concurrent_executions", cty.NumberIntVal(int64(*fn.ReservedConcurrency)))
+	}
+	if fn.KmsKeyArn != "" {
+		body.SetAttributeValue("kms_key_arn", cty.StringVal(fn.KmsKeyArn))
+	}
+	if len(fn.Environment) > 0 {
+		envBlock := body.AppendNewBlock("environment", nil)
+		envBlock.Body().SetAttributeValue("variables", g.stringMap(fn.Environment))
+	}
+	if vpc := fn.VpcConfig; vpc != nil {
+		vpcBlock := body.AppendNewBlock("vpc_config", nil)
+		vpcBlock.Body().SetAttributeValue("subnet_ids", g.stringList(vpc.SubnetIDs))
+		vpcBlock.Body().SetAttributeValue("security_group_ids", g.stringList(vpc.SecurityGroupIDs))
+	}
+	if fn.TracingMode != "" && fn.TracingMode != "PassThrough" {
+		tracingBlock := body.AppendNewBlock("tracing_config", nil)
+		tracingBlock.Body().SetAttributeValue("mode", cty.StringVal(fn.TracingMode))
+	}
+	if fn.EphemeralStorageSize > 0 && fn.EphemeralStorageSize != 512 {
+		storageBlock := body.AppendNewBlock("ephemeral_storage", nil)
+		storageBlock.Body().SetAttributeValue("size", cty.NumberIntVal(int64(fn.EphemeralStorageSize)))
+	}
+	if fn.DeadLetterTargetArn != "" {
+		dlqBlock := body.AppendNewBlock("dead_letter_config", nil)
+		dlqBlock.Body().SetAttributeValue("target_arn", cty.StringVal(fn.DeadLetterTargetArn))
+	}
+	if len(fn.Tags) > 0 {
+		g.appendTags(body, fn.Tags)
+	}
+	g.appendIgnoreChanges(body, g.ignoreAttrs(ignoreAttrs...)...)
+}
+
+// appendEventSourceMappingAttributes は aws_lambda_event_source_mapping の属性を設定します。
+func (g *HCLGenerator) appendEventSourceMappingAttributes(body *hclwrite.Body, m lambda.EventSourceMapping, functionArn hclwrite.Tokens) {
+	body.SetAttributeValue("event_source_arn", cty.StringVal(m.EventSourceArn))
+	body.SetAttributeRaw("function_name", functionArn)
+	if m.BatchSize > 0 {
+		body.SetAttributeValue("batch_size", cty.NumberIntVal(int64(m.BatchSize)))
+	}
+	body.SetAttributeValue("enabled", cty.BoolVal(m.Enabled))
+	if m.StartingPosition != "" {
+		body.SetAttributeValue("starting_position", cty.StringVal(m.StartingPosition))
+	}
+	if m.MaximumBatchingWindowInSeconds > 0 {
+		body.SetAttributeValue("maximum_batching_window_in_seconds", cty.NumberIntVal(int64(m.MaximumBatchingWindowInSeconds)))
+	}
+	if m.MaximumRetryAttempts != nil {
+		body.SetAttributeValue("maximum_retry_attempts", cty.NumberIntVal(int64(*m.MaximumRetryAttempts)))
+	}
+	if m.MaximumRecordAgeInSeconds != nil {
+		body.SetAttributeValue("maximum_record_age_in_seconds", cty.NumberIntVal(int64(*m.MaximumRecordAgeInSeconds)))
+	}
+	if m.BisectBatchOnFunctionError {
+		body.SetAttributeValue("bisect_batch_on_function_error", cty.True)
+	}
+	if m.ParallelizationFactor > 0 {
+		body.SetAttributeValue("parallelization_factor", cty.NumberIntVal(int64(m.ParallelizationFactor)))
+	}
+	if len(m.FunctionResponseTypes) > 0 {
+		body.SetAttributeValue("function_response_types", g.stringList(m.FunctionResponseTypes))
+	}
+	if len(m.FilterPatterns) > 0 {
+		criteriaBlock := body.AppendNewBlock("filter_criteria", nil)
+		for _, pattern := range m.FilterPatterns {
+			filterBlock := criteriaBlock.Body().AppendNewBlock("filter", nil)
+			filterBlock.Body().SetAttributeValue("pattern", cty.StringVal(pattern))
+		}
+	}
+}
+
+// setLambdaQualifier はエイリアスが同じファイルに出力される場合は aws_lambda_alias.<name>.name を、
+// そうでなければ(バージョン番号など)文字列を設定します。
+func (g *HCLGenerator) setLambdaQualifier(body *hclwrite.Body, aliasRefs map[string]string, qualifier string) {
+	if aliasResourceName, ok := aliasRefs[qualifier]; ok {
+		body.SetAttributeRaw("qualifier", g.reference("aws_lambda_alias", aliasResourceName, "name"))
+		return
+	}
+	body.SetAttributeValue("qualifier", cty.StringVal(qualifier))
+}
+
+// ApiGatewayRelatedResources はREST APIと同じファイルに出力する関連リソースを保持します。
+type ApiGatewayRelatedResources struct {
+	// UsagePlans はREST APIのステージに関連付けられた使用量プランです。
+	UsagePlans []apigateway.UsagePlan
+	// ApiKeys は使用量プランに関連付けられたAPIキーです。
+	ApiKeys []apigateway.ApiKey
+	// DomainNames はREST APIにマッピングされたカスタムドメイン名です。
+	DomainNames []apigateway.DomainName
+}
+
+// RestAPIBodyPath はREST APIのOpenAPI定義を書き出すファイルのパスを返します。
+func (g *HCLGenerator) RestAPIBodyPath(apiID string) string {
+	return path.Join("api_gateway", apiID+".json")
+}
+
+// GenerateApiGatewayBlocks はREST APIと、そのリソース、メソッド、統合、デプロイメント、ステージ、
+// 使用量プラン、APIキー、カスタムドメイン名のresourceブロックとimportブロックを生成します。
+// OpenAPI定義(Body)があるREST APIは body に RestAPIBodyPath のファイルを指定し、
+// リソース、メソッド、統合は個別に出力しません。body はエクスポートしたステージのデプロイメントの定義で、
+// put_rest_api_mode の既定値 overwrite により apply 時にAPI全体がこの定義で置き換えられます。
+// REST API、使用量プラン、APIキーの名前は重複できるため、重複する場合はリソース名にIDを付けます。
+// REST APIの子リソースのリソース名はREST APIのリソース名から組み立てます。
+func (g *HCLGenerator) GenerateApiGatewayBlocks(apis []apigateway.RestAPI, related ApiGatewayRelatedResources) (*hclwrite.File, *hclwrite.File, error) {
 	resourceFile := hclwrite.NewEmptyFile()
 	importFile := hclwrite.NewEmptyFile()
 	resourceBody := resourceFile.Body()
 	importBody := importFile.Body()
 
-	for _, lb := range lbs {
-		// Target Groups
-		tgRefs := make(map[string]hcl.Traversal)
-		for _, tg := range lb.TargetGroups {
-			tgResourceType := "aws_lb_target_group"
-			tgResourceName := tg.Name
-			g.appendImportBlock(importBody, tgResourceType+"."+tgResourceName, tg.Arn)
-			tgBlock := g.appendResourceBlock(resourceBody, tgResourceType, tgResourceName)
-			tgBlock.Body().SetAttributeValue("name", cty.StringVal(tg.Name))
-			tgBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(tg.Port)))
-			tgBlock.Body().SetAttributeValue("protocol", cty.StringVal(string(tg.Protocol)))
-			tgBlock.Body().SetAttributeValue("vpc_id", cty.StringVal(tg.VpcId))
-			tgBlock.Body().SetAttributeValue("target_type", cty.StringVal(tg.TargetType))
-
-			if tg.HealthCheck != nil {
-				hcBlock := tgBlock.Body().AppendNewBlock("health_check", nil)
-				hcBlock.Body().SetAttributeValue("enabled", cty.BoolVal(tg.HealthCheck.Enabled))
-				hcBlock.Body().SetAttributeValue("path", cty.StringVal(tg.HealthCheck.Path))
-				hcBlock.Body().SetAttributeValue("port", cty.StringVal(tg.HealthCheck.Port))
-				hcBlock.Body().SetAttributeValue("protocol", cty.StringVal(string(tg.HealthCheck.Protocol)))
-				hcBlock.Body().SetAttributeValue("interval", cty.NumberIntVal(int64(tg.HealthCheck.Interval)))
-				hcBlock.Body().SetAttributeValue("timeout", cty.NumberIntVal(int64(tg.HealthCheck.Timeout)))
-				hcBlock.Body().SetAttributeValue("healthy_threshold", cty.NumberIntVal(int64(tg.HealthCheck.HealthyThreshold)))
-				hcBlock.Body().SetAttributeValue("unhealthy_threshold", cty.NumberIntVal(int64(tg.HealthCheck.UnhealthyThreshold)))
-				hcBlock.Body().SetAttributeValue("matcher", cty.StringVal(tg.HealthCheck.Matcher))
-			}
-
-			tgRefParts := strings.SplitN(tgResourceType+"."+tgResourceName, ".", 2)
-			tgRefs[tg.Arn] = hcl.Traversal{
-				hcl.TraverseRoot{Name: tgRefParts[0]},
-				hcl.TraverseAttr{Name: tgRefParts[1]},
+	var apiNames, apiIDs []string
+	for _, api := range apis {
+		apiNames = append(apiNames, api.Name)
+		apiIDs = append(apiIDs, api.ID)
+	}
+	apiRefs := g.uniqueResourceNames(apiNames, apiIDs)
+	// stageRefs は "<REST API ID>/<ステージ名>" からステージのリソース名への対応です。
+	stageRefs := make(map[string]string)
+	for _, api := range apis {
+		resourceType := "aws_api_gateway_rest_api"
+		resourceName := apiRefs[api.ID]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, api.ID)
+		apiBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		g.appendRestAPIAttributes(resourceBody, apiBlock.Body(), api, resourceName)
+		apiIDTokens := g.reference(resourceType, resourceName, "id")
+
+		if len(api.Body) == 0 {
+			g.appendRestAPIResources(resourceBody, importBody, api, resourceName)
+		}
+
+		deploymentRefs := make(map[string]string)
+		for _, d := range api.Deployments {
+			dResourceType := "aws_api_gateway_deployment"
+			dResourceName := g.sanitize(resourceName, d.ID)
+			deploymentRefs[d.ID] = dResourceName
+			g.appendImportBlock(importBody, dResourceType+"."+dResourceName, api.ID+"/"+d.ID)
+			dBlock := g.appendResourceBlock(resourceBody, dResourceType, dResourceName)
+			dBlock.Body().SetAttributeRaw("rest_api_id", apiIDTokens)
+			if d.Description != "" {
+				dBlock.Body().SetAttributeValue("description", cty.StringVal(d.Description))
 			}
 		}
 
-		// Load Balancer
-		lbResourceType := "aws_lb"
-		lbResourceName := lb.Name
-		g.appendImportBlock(importBody, lbResourceType+"."+lbResourceName, lb.Arn)
-		lbBlock := g.appendResourceBlock(resourceBody, lbResourceType, lbResourceName)
-		lbBlock.Body().SetAttributeValue("name", cty.StringVal(lb.Name))
-		lbBlock.Body().SetAttributeValue("load_balancer_type", cty.StringVal(string(lb.Type)))
-		subnetVals := []cty.Value{}
-		for _, subnet := range lb.Subnets {
-			subnetVals = append(subnetVals, cty.StringVal(subnet))
+		for _, st := range api.Stages {
+			stResourceType := "aws_api_gateway_stage"
+			stResourceName := g.sanitize(resourceName, st.Name)
+			stageRefs[api.ID+"/"+st.Name] = stResourceName
+			g.appendImportBlock(importBody, stResourceType+"."+stResourceName, api.ID+"/"+st.Name)
+			stBlock := g.appendResourceBlock(resourceBody, stResourceType, stResourceName)
+			stBlock.Body().SetAttributeValue("stage_name", cty.StringVal(st.Name))
+			stBlock.Body().SetAttributeRaw("rest_api_id", apiIDTokens)
+			g.setReferenceOrValue(stBlock.Body(), "deployment_id", deploymentRefs, "aws_api_gateway_deployment", st.DeploymentID)
+			if st.Description != "" {
+				stBlock.Body().SetAttributeValue("description", cty.StringVal(st.Description))
+			}
+			if st.CacheClusterEnabled {
+				stBlock.Body().SetAttributeValue("cache_cluster_enabled", cty.True)
+				stBlock.Body().SetAttributeValue("cache_cluster_size", cty.StringVal(st.CacheClusterSize))
+			}
+			if st.TracingEnabled {
+				stBlock.Body().SetAttributeValue("xray_tracing_enabled", cty.True)
+			}
+			if st.ClientCertificateID != "" {
+				stBlock.Body().SetAttributeValue("client_certificate_id", cty.StringVal(st.ClientCertificateID))
+			}
+			if len(st.Variables) > 0 {
+				stBlock.Body().SetAttributeValue("variables", g.stringMap(st.Variables))
+			}
+			if als := st.AccessLogSettings; als != nil {
+				alsBlock := stBlock.Body().AppendNewBlock("access_log_settings", nil)
+				alsBlock.Body().SetAttributeValue("destination_arn", cty.StringVal(als.DestinationArn))
+				alsBlock.Body().SetAttributeValue("format", cty.StringVal(als.Format))
+			}
+			if len(st.Tags) > 0 {
+				g.appendTags(stBlock.Body(), st.Tags)
+			}
 		}
-		lbBlock.Body().SetAttributeValue("subnets", cty.ListVal(subnetVals))
+	}
 
-		lbRefParts := strings.SplitN(lbResourceType+"."+lbResourceName, ".", 2)
-		lbTraversal := hcl.Traversal{
-			hcl.TraverseRoot{Name: lbRefParts[0]},
-			hcl.TraverseAttr{Name: lbRefParts[1]},
+	var keyNames, keyIDs []string
+	for _, key := range related.ApiKeys {
+		keyNames = append(keyNames, key.Name)
+		keyIDs = append(keyIDs, key.ID)
+	}
+	keyRefs := g.uniqueResourceNames(keyNames, keyIDs)
+	for _, key := range related.ApiKeys {
+		resourceType := "aws_api_gateway_api_key"
+		resourceName := keyRefs[key.ID]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, key.ID)
+		keyBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		keyBlock.Body().SetAttributeValue("name", cty.StringVal(key.Name))
+		if key.Description != "" {
+			keyBlock.Body().SetAttributeValue("description", cty.StringVal(key.Description))
+		}
+		keyBlock.Body().SetAttributeValue("enabled", cty.BoolVal(key.Enabled))
+		if len(key.Tags) > 0 {
+			g.appendTags(keyBlock.Body(), key.Tags)
 		}
+	}
 
-		// Listeners
-		listenerRefs := make(map[string]hcl.Traversal)
-		for _, listener := range lb.Listeners {
-			listenerResourceType := "aws_lb_listener"
-			listenerResourceName := fmt.Sprintf("%s_%d", lb.Name, listener.Port)
-			g.appendImportBlock(importBody, listenerResourceType+"."+listenerResourceName, listener.Arn)
-			listenerBlock := g.appendResourceBlock(resourceBody, listenerResourceType, listenerResourceName)
+	var planNames, planIDs []string
+	for _, plan := range related.UsagePlans {
+		planNames = append(planNames, plan.Name)
+		planIDs = append(planIDs, plan.ID)
+	}
+	planRefs := g.uniqueResourceNames(planNames, planIDs)
+	for _, plan := range related.UsagePlans {
+		resourceType := "aws_api_gateway_usage_plan"
+		resourceName := planRefs[plan.ID]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, plan.ID)
+		planBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		planBlock.Body().SetAttributeValue("name", cty.StringVal(plan.Name))
+		if plan.Description != "" {
+			planBlock.Body().SetAttributeValue("description", cty.StringVal(plan.Description))
+		}
+		for _, as := range plan.APIStages {
+			asBlock := planBlock.Body().AppendNewBlock("api_stages", nil)
+			g.setReferenceOrValue(asBlock.Body(), "api_id", apiRefs, "aws_api_gateway_rest_api", as.APIID)
+			if stResourceName, ok := stageRefs[as.APIID+"/"+as.Stage]; ok {
+				asBlock.Body().SetAttributeRaw("stage", g.reference("aws_api_gateway_stage", stResourceName, "stage_name"))
+			} else {
+				asBlock.Body().SetAttributeValue("stage", cty.StringVal(as.Stage))
+			}
+		}
+		if t := plan.Throttle; t != nil {
+			throttleBlock := planBlock.Body().AppendNewBlock("throttle_settings", nil)
+			throttleBlock.Body().SetAttributeValue("burst_limit", cty.NumberIntVal(int64(t.BurstLimit)))
+			throttleBlock.Body().SetAttributeValue("rate_limit", cty.NumberFloatVal(t.RateLimit))
+		}
+		if q := plan.Quota; q != nil {
+			quotaBlock := planBlock.Body().AppendNewBlock("quota_settings", nil)
+			quotaBlock.Body().SetAttributeValue("limit", cty.NumberIntVal(int64(q.Limit)))
+			if q.Offset > 0 {
+				quotaBlock.Body().SetAttributeValue("offset", cty.NumberIntVal(int64(q.Offset)))
+			}
+			quotaBlock.Body().SetAttributeValue("period", cty.StringVal(q.Period))
+		}
+		if len(plan.Tags) > 0 {
+			g.appendTags(planBlock.Body(), plan.Tags)
+		}
 
-			listenerRefParts := strings.SplitN(listenerResourceType+"."+listenerResourceName, ".", 2)
-			listenerTraversal := hcl.Traversal{
-				hcl.TraverseRoot{Name: listenerRefParts[0]},
-				hcl.TraverseAttr{Name: listenerRefParts[1]},
+		for _, k := range plan.Keys {
+			pkResourceType := "aws_api_gateway_usage_plan_key"
+			pkResourceName := g.sanitize(resourceName, k.ID)
+			g.appendImportBlock(importBody, pkResourceType+"."+pkResourceName, plan.ID+"/"+k.ID)
+			pkBlock := g.appendResourceBlock(resourceBody, pkResourceType, pkResourceName)
+			g.setReferenceOrValue(pkBlock.Body(), "key_id", keyRefs, "aws_api_gateway_api_key", k.ID)
+			pkBlock.Body().SetAttributeValue("key_type", cty.StringVal(k.Type))
+			pkBlock.Body().SetAttributeRaw("usage_plan_id", g.reference(resourceType, resourceName, "id"))
+		}
+	}
+
+	for _, domain := range related.DomainNames {
+		resourceType := "aws_api_gateway_domain_name"
+		resourceName := g.sanitize(domain.Name)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, domain.Name)
+		domainBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		domainBlock.Body().SetAttributeValue("domain_name", cty.StringVal(domain.Name))
+		if domain.CertificateArn != "" {
+			domainBlock.Body().SetAttributeValue("certificate_arn", cty.StringVal(domain.CertificateArn))
+		}
+		if domain.RegionalCertificateArn != "" {
+			domainBlock.Body().SetAttributeValue("regional_certificate_arn", cty.StringVal(domain.RegionalCertificateArn))
+		}
+		if len(domain.EndpointTypes) > 0 {
+			ecBlock := domainBlock.Body().AppendNewBlock("endpoint_configuration", nil)
+			ecBlock.Body().SetAttributeValue("types", g.stringList(domain.EndpointTypes))
+		}
+		if domain.SecurityPolicy != "" {
+			domainBlock.Body().SetAttributeValue("security_policy", cty.StringVal(domain.SecurityPolicy))
+		}
+		if len(domain.Tags) > 0 {
+			g.appendTags(domainBlock.Body(), domain.Tags)
+		}
+
+		for _, m := range domain.BasePathMappings {
+			mResourceType := "aws_api_gateway_base_path_mapping"
+			mResourceName := g.sanitize(domain.Name, "root")
+			if m.BasePath != "" {
+				mResourceName = g.sanitize(domain.Name, m.BasePath)
 			}
-			listenerRefs[listener.Arn] = listenerTraversal
+			g.appendImportBlock(importBody, mResourceType+"."+mResourceName, domain.Name+"/"+m.BasePath)
+			mBlock := g.appendResourceBlock(resourceBody, mResourceType, mResourceName)
+			g.setReferenceOrValue(mBlock.Body(), "api_id", apiRefs, "aws_api_gateway_rest_api", m.RestAPIID)
+			if stResourceName, ok := stageRefs[m.RestAPIID+"/"+m.Stage]; ok {
+				mBlock.Body().SetAttributeRaw("stage_name", g.reference("aws_api_gateway_stage", stResourceName, "stage_name"))
+			} else if m.Stage != "" {
+				mBlock.Body().SetAttributeValue("stage_name", cty.StringVal(m.Stage))
+			}
+			mBlock.Body().SetAttributeRaw("domain_name", g.reference(resourceType, resourceName, "domain_name"))
+			if m.BasePath != "" {
+				mBlock.Body().SetAttributeValue("base_path", cty.StringVal(m.BasePath))
+			}
+		}
+	}
 
-			listenerBlock.Body().SetAttributeRaw("load_balancer_arn", hclwrite.TokensForTraversal(append(lbTraversal, hcl.TraverseAttr{Name: "arn"})))
-			listenerBlock.Body().SetAttributeValue("port", cty.NumberIntVal(int64(listener.Port)))
-			listenerBlock.Body().SetAttributeValue("protocol", cty.StringVal(string(listener.Protocol)))
-			if listener.CertificateArn != nil {
-				listenerBlock.Body().SetAttributeValue("certificate_arn", cty.StringVal(*listener.CertificateArn))
+	return resourceFile, importFile, nil
+}
+
+// appendRestAPIAttributes は aws_api_gateway_rest_api の属性を設定します。ポリシーは jsonencode で出力します。
+func (g *HCLGenerator) appendRestAPIAttributes(resourceBody, body *hclwrite.Body, api apigateway.RestAPI, resourceName string) {
+	body.SetAttributeValue("name", cty.StringVal(api.Name))
+	if api.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(api.Description))
+	}
+	if len(api.Body) > 0 {
+		body.SetAttributeRaw("body", g.fileFunction("file", g.RestAPIBodyPath(api.ID)))
+	}
+	if len(api.EndpointTypes) > 0 {
+		ecBlock := body.AppendNewBlock("endpoint_configuration", nil)
+		ecBlock.Body().SetAttributeValue("types", g.stringList(api.EndpointTypes))
+		if len(api.VpcEndpointIDs) > 0 {
+			ecBlock.Body().SetAttributeValue("vpc_endpoint_ids", g.stringList(api.VpcEndpointIDs))
+		}
+	}
+	if len(api.BinaryMediaTypes) > 0 {
+		body.SetAttributeValue("binary_media_types", g.stringList(api.BinaryMediaTypes))
+	}
+	if api.MinimumCompressionSize != nil {
+		body.SetAttributeValue("minimum_compression_size", cty.StringVal(fmt.Sprintf("%d", *api.MinimumCompressionSize)))
+	}
+	if api.ApiKeySource != "" {
+		body.SetAttributeValue("api_key_source", cty.StringVal(api.ApiKeySource))
+	}
+	if api.DisableExecuteApiEndpoint {
+		body.SetAttributeValue("disable_execute_api_endpoint", cty.True)
+	}
+	if api.Policy != "" {
+		g.setPolicyDocument(resourceBody, body, "policy", api.Policy, resourceName, PolicyFormatJSONEncode)
+	}
+	if len(api.Tags) > 0 {
+		g.appendTags(body, api.Tags)
+	}
+}
+
+// appendRestAPIResources はREST APIのリソースツリーを aws_api_gateway_resource、aws_api_gateway_method、
+// aws_api_gateway_integration として出力します。ルートリソースは rest_api の root_resource_id で参照します。
+func (g *HCLGenerator) appendRestAPIResources(resourceBody, importBody *hclwrite.Body, api apigateway.RestAPI, apiResourceName string) {
+	apiIDTokens := g.reference("aws_api_gateway_rest_api", apiResourceName, "id")
+	resourceIDTokens := func(resourceRefs map[string]string, id string) hclwrite.Tokens {
+		if id == api.RootResourceID {
+			return g.reference("aws_api_gateway_rest_api", apiResourceName, "root_resource_id")
+		}
+		return g.reference("aws_api_gateway_resource", resourceRefs[id], "id")
+	}
+
+	// "/users/{id}" と "/users/id" のようにパスが同じ名前になるリソースは、IDを付けて区別します。
+	var pathNames, pathIDs []string
+	for _, r := range api.Resources {
+		if r.ID != api.RootResourceID {
+			pathNames = append(pathNames, apiResourceName+"_"+r.Path)
+			pathIDs = append(pathIDs, r.ID)
+		}
+	}
+	pathRefs := g.uniqueResourceNames(pathNames, pathIDs)
+
+	resourceRefs := make(map[string]string)
+	for _, r := range api.Resources {
+		// ルートリソースのメソッドは "<REST APIのリソース名>_<HTTPメソッド>" とし、"/root" のメソッドと区別します。
+		if r.ID == api.RootResourceID {
+			resourceRefs[r.ID] = apiResourceName
+			continue
+		}
+		resourceType := "aws_api_gateway_resource"
+		resourceName := pathRefs[r.ID]
+		resourceRefs[r.ID] = resourceName
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, api.ID+"/"+r.ID)
+		rBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		rBlock.Body().SetAttributeRaw("rest_api_id", apiIDTokens)
+		rBlock.Body().SetAttributeRaw("parent_id", resourceIDTokens(resourceRefs, r.ParentID))
+		rBlock.Body().SetAttributeValue("path_part", cty.StringVal(r.PathPart))
+	}
+
+	for _, r := range api.Resources {
+		for _, m := range r.Methods {
+			methodResourceType := "aws_api_gateway_method"
+			methodResourceName := g.sanitize(resourceRefs[r.ID], m.HTTPMethod)
+			importID := api.ID + "/" + r.ID + "/" + m.HTTPMethod
+			g.appendImportBlock(importBody, methodResourceType+"."+methodResourceName, importID)
+			mBlock := g.appendResourceBlock(resourceBody, methodResourceType, methodResourceName)
+			mBlock.Body().SetAttributeRaw("rest_api_id", apiIDTokens)
+			mBlock.Body().SetAttributeRaw("resource_id", resourceIDTokens(resourceRefs, r.ID))
+			mBlock.Body().SetAttributeValue("http_method", cty.StringVal(m.HTTPMethod))
+			mBlock.Body().SetAttributeValue("authorization", cty.StringVal(m.AuthorizationType))
+			if m.AuthorizerID != "" {
+				mBlock.Body().SetAttributeValue("authorizer_id", cty.StringVal(m.AuthorizerID))
+			}
+			if len(m.AuthorizationScopes) > 0 {
+				mBlock.Body().SetAttributeValue("authorization_scopes", g.stringList(m.AuthorizationScopes))
+			}
+			if m.ApiKeyRequired {
+				mBlock.Body().SetAttributeValue("api_key_required", cty.True)
+			}
+			if m.OperationName != "" {
+				mBlock.Body().SetAttributeValue("operation_name", cty.StringVal(m.OperationName))
+			}
+			if m.RequestValidatorID != "" {
+				mBlock.Body().SetAttributeValue("request_validator_id", cty.StringVal(m.RequestValidatorID))
+			}
+			if len(m.RequestParameters) > 0 {
+				params := make(map[string]cty.Value)
+				for k, v := range m.RequestParameters {
+					params[k] = cty.BoolVal(v)
+				}
+				mBlock.Body().SetAttributeValue("request_parameters", cty.MapVal(params))
+			}
+			if len(m.RequestModels) > 0 {
+				mBlock.Body().SetAttributeValue("request_models", g.stringMap(m.RequestModels))
+			}
+
+			in := m.Integration
+			if in == nil {
+				continue
+			}
+			inResourceType := "aws_api_gateway_integration"
+			g.appendImportBlock(importBody, inResourceType+"."+methodResourceName, importID)
+			inBlock := g.appendResourceBlock(resourceBody, inResourceType, methodResourceName)
+			inBlock.Body().SetAttributeRaw("rest_api_id", apiIDTokens)
+			inBlock.Body().SetAttributeRaw("resource_id", resourceIDTokens(resourceRefs, r.ID))
+			inBlock.Body().SetAttributeRaw("http_method", g.reference(methodResourceType, methodResourceName, "http_method"))
+			inBlock.Body().SetAttributeValue("type", cty.StringVal(in.Type))
+			if in.HTTPMethod != "" {
+				inBlock.Body().SetAttributeValue("integration_http_method", cty.StringVal(in.HTTPMethod))
+			}
+			if in.URI != "" {
+				inBlock.Body().SetAttributeValue("uri", cty.StringVal(in.URI))
+			}
+			if in.ConnectionType != "" && in.ConnectionType != "INTERNET" {
+				inBlock.Body().SetAttributeValue("connection_type", cty.StringVal(in.ConnectionType))
+				inBlock.Body().SetAttributeValue("connection_id", cty.StringVal(in.ConnectionID))
+			}
+			if in.Credentials != "" {
+				inBlock.Body().SetAttributeValue("credentials", cty.StringVal(in.Credentials))
+			}
+			if len(in.RequestParameters) > 0 {
+				inBlock.Body().SetAttributeValue("request_parameters", g.stringMap(in.RequestParameters))
+			}
+			if len(in.RequestTemplates) > 0 {
+				inBlock.Body().SetAttributeValue("request_templates", g.stringMap(in.RequestTemplates))
+			}
+			if in.PassthroughBehavior != "" {
+				inBlock.Body().SetAttributeValue("passthrough_behavior", cty.StringVal(in.PassthroughBehavior))
+			}
+			if in.ContentHandling != "" {
+				inBlock.Body().SetAttributeValue("content_handling", cty.StringVal(in.ContentHandling))
+			}
+			if in.TimeoutInMillis > 0 {
+				inBlock.Body().SetAttributeValue("timeout_milliseconds", cty.NumberIntVal(int64(in.TimeoutInMillis)))
+			}
+			if len(in.CacheKeyParameters) > 0 {
+				inBlock.Body().SetAttributeValue("cache_key_parameters", g.stringList(in.CacheKeyParameters))
+			}
+		}
+	}
+}
+
+// defaultRouteSelectionExpression はHTTP APIのルート選択式の既定値です。
+const defaultRouteSelectionExpression = "$request.method $request.path"
+
+// GenerateApiGatewayV2Blocks はHTTP API / WebSocket APIと、そのルート、統合、ステージ、オーソライザー、
+// カスタムドメイン名のresourceブロックとimportブロックを生成します。
+// APIの名前が重複する場合はリソース名にAPI IDを付け、子リソースのリソース名はAPIのリソース名から組み立てます。
+func (g *HCLGenerator) GenerateApiGatewayV2Blocks(apis []apigatewayv2.API, domains []apigatewayv2.DomainName) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	var apiNames, apiIDs []string
+	for _, api := range apis {
+		apiNames = append(apiNames, api.Name)
+		apiIDs = append(apiIDs, api.ID)
+	}
+	apiRefs := g.uniqueResourceNames(apiNames, apiIDs)
+	// stageRefs は "<API ID>/<ステージ名>" からステージのリソース名への対応です。
+	stageRefs := make(map[string]string)
+	for _, api := range apis {
+		resourceType := "aws_apigatewayv2_api"
+		resourceName := apiRefs[api.ID]
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, api.ID)
+		apiBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		apiBlock.Body().SetAttributeValue("name", cty.StringVal(api.Name))
+		apiBlock.Body().SetAttributeValue("protocol_type", cty.StringVal(api.ProtocolType))
+		if api.Description != "" {
+			apiBlock.Body().SetAttributeValue("description", cty.StringVal(api.Description))
+		}
+		if api.ProtocolType == "WEBSOCKET" || api.RouteSelectionExpression != defaultRouteSelectionExpression {
+			apiBlock.Body().SetAttributeValue("route_selection_expression", cty.StringVal(api.RouteSelectionExpression))
+		}
+		if api.ProtocolType == "WEBSOCKET" && api.ApiKeySelectionExpression != "" {
+			apiBlock.Body().SetAttributeValue("api_key_selection_expression", cty.StringVal(api.ApiKeySelectionExpression))
+		}
+		if api.DisableExecuteApiEndpoint {
+			apiBlock.Body().SetAttributeValue("disable_execute_api_endpoint", cty.True)
+		}
+		if c := api.Cors; c != nil {
+			corsBlock := apiBlock.Body().AppendNewBlock("cors_configuration", nil)
+			corsBlock.Body().SetAttributeValue("allow_credentials", cty.BoolVal(c.AllowCredentials))
+			if len(c.AllowHeaders) > 0 {
+				corsBlock.Body().SetAttributeValue("allow_headers", g.stringList(c.AllowHeaders))
+			}
+			if len(c.AllowMethods) > 0 {
+				corsBlock.Body().SetAttributeValue("allow_methods", g.stringList(c.AllowMethods))
+			}
+			if len(c.AllowOrigins) > 0 {
+				corsBlock.Body().SetAttributeValue("allow_origins", g.stringList(c.AllowOrigins))
+			}
+			if len(c.ExposeHeaders) > 0 {
+				corsBlock.Body().SetAttributeValue("expose_headers", g.stringList(c.ExposeHeaders))
+			}
+			if c.MaxAge > 0 {
+				corsBlock.Body().SetAttributeValue("max_age", cty.NumberIntVal(int64(c.MaxAge)))
+			}
+		}
+		if len(api.Tags) > 0 {
+			g.appendTags(apiBlock.Body(), api.Tags)
+		}
+		apiIDTokens := g.reference(resourceType, resourceName, "id")
+
+		authorizerRefs := make(map[string]string)
+		for _, au := range api.Authorizers {
+			auResourceType := "aws_apigatewayv2_authorizer"
+			auResourceName := g.sanitize(resourceName, au.Name)
+			authorizerRefs[au.ID] = auResourceName
+			g.appendImportBlock(importBody, auResourceType+"."+auResourceName, api.ID+"/"+au.ID)
+			auBlock := g.appendResourceBlock(resourceBody, auResourceType, auResourceName)
+			auBlock.Body().SetAttributeRaw("api_id", apiIDTokens)
+			auBlock.Body().SetAttributeValue("name", cty.StringVal(au.Name))
+			auBlock.Body().SetAttributeValue("authorizer_type", cty.StringVal(au.Type))
+			if len(au.IdentitySources) > 0 {
+				auBlock.Body().SetAttributeValue("identity_sources", g.stringList(au.IdentitySources))
+			}
+			if au.URI != "" {
+				auBlock.Body().SetAttributeValue("authorizer_uri", cty.StringVal(au.URI))
+			}
+			if au.CredentialsArn != "" {
+				auBlock.Body().SetAttributeValue("authorizer_credentials_arn", cty.StringVal(au.CredentialsArn))
+			}
+			if au.PayloadFormatVersion != "" {
+				auBlock.Body().SetAttributeValue("authorizer_payload_format_version", cty.StringVal(au.PayloadFormatVersion))
 			}
+			if au.EnableSimpleResponses {
+				auBlock.Body().SetAttributeValue("enable_simple_responses", cty.True)
+			}
+			if au.ResultTtlInSeconds != nil {
+				auBlock.Body().SetAttributeValue("authorizer_result_ttl_in_seconds", cty.NumberIntVal(int64(*au.ResultTtlInSeconds)))
+			}
+			if au.JwtIssuer != "" {
+				jwtBlock := auBlock.Body().AppendNewBlock("jwt_configuration", nil)
+				jwtBlock.Body().SetAttributeValue("audience", g.stringList(au.JwtAudience))
+				jwtBlock.Body().SetAttributeValue("issuer", cty.StringVal(au.JwtIssuer))
+			}
+		}
 
-			// Default Action
-			for _, da := range listener.DefaultActions {
-				daBlock := listenerBlock.Body().AppendNewBlock("default_action", nil)
-				daBlock.Body().SetAttributeValue("type", cty.StringVal(string(da.Type)))
-
-				if da.Type == "forward" && da.Forward != nil {
-					forwardBlock := daBlock.Body().AppendNewBlock("forward", nil)
-					for _, tg := range da.Forward.TargetGroups {
-						tgBlock := forwardBlock.Body().AppendNewBlock("target_group", nil)
-						tgTraversal := tgRefs[tg.Arn]
-						tgBlock.Body().SetAttributeRaw("arn", hclwrite.TokensForTraversal(append(tgTraversal, hcl.TraverseAttr{Name: "arn"})))
-						if tg.Weight != nil {
-							tgBlock.Body().SetAttributeValue("weight", cty.NumberIntVal(*tg.Weight))
-						}
-					}
-					if da.Forward.Stickiness != nil {
-						stickinessBlock := forwardBlock.Body().AppendNewBlock("stickiness", nil)
-						stickinessBlock.Body().SetAttributeValue("enabled", cty.BoolVal(da.Forward.Stickiness.Enabled))
-						stickinessBlock.Body().SetAttributeValue("duration", cty.NumberIntVal(int64(da.Forward.Stickiness.Duration)))
-					}
-				} else if da.Type == "redirect" && da.Redirect != nil {
-					redirectBlock := daBlock.Body().AppendNewBlock("redirect", nil)
-					redirectBlock.Body().SetAttributeValue("port", cty.StringVal(da.Redirect.Port))
-					redirectBlock.Body().SetAttributeValue("protocol", cty.StringVal(da.Redirect.Protocol))
-					redirectBlock.Body().SetAttributeValue("status_code", cty.StringVal(da.Redirect.StatusCode))
+		integrationRefs := make(map[string]string)
+		for _, in := range api.Integrations {
+			inResourceType := "aws_apigatewayv2_integration"
+			inResourceName := g.sanitize(resourceName, in.ID)
+			integrationRefs[in.ID] = inResourceName
+			g.appendImportBlock(importBody, inResourceType+"."+inResourceName, api.ID+"/"+in.ID)
+			inBlock := g.appendResourceBlock(resourceBody, inResourceType, inResourceName)
+			g.appendApiGatewayV2IntegrationAttributes(inBlock.Body(), in, apiIDTokens)
+		}
+
+		// "$default" と "default" のようにルートキーが同じ名前になるルートは、IDを付けて区別します。
+		var routeNames, routeIDs []string
+		for _, r := range api.Routes {
+			routeNames = append(routeNames, resourceName+"_"+r.RouteKey)
+			routeIDs = append(routeIDs, r.ID)
+		}
+		routeRefs := g.uniqueResourceNames(routeNames, routeIDs)
+
+		for _, r := range api.Routes {
+			rResourceType := "aws_apigatewayv2_route"
+			rResourceName := routeRefs[r.ID]
+			g.appendImportBlock(importBody, rResourceType+"."+rResourceName, api.ID+"/"+r.ID)
+			rBlock := g.appendResourceBlock(resourceBody, rResourceType, rResourceName)
+			rBlock.Body().SetAttributeRaw("api_id", apiIDTokens)
+			rBlock.Body().SetAttributeValue("route_key", cty.StringVal(r.RouteKey))
+			if r.Target != "" {
+				integrationID := strings.TrimPrefix(r.Target, "integrations/")
+				if inResourceName, ok := integrationRefs[integrationID]; ok {
+					rBlock.Body().SetAttributeRaw("target", g.interpolation("integrations/", g.reference("aws_apigatewayv2_integration", inResourceName, "id")))
+				} else {
+					rBlock.Body().SetAttributeValue("target", cty.StringVal(r.Target))
 				}
 			}
+			if r.AuthorizationType != "" && r.AuthorizationType != "NONE" {
+				rBlock.Body().SetAttributeValue("authorization_type", cty.StringVal(r.AuthorizationType))
+			}
+			if r.AuthorizerID != "" {
+				g.setReferenceOrValue(rBlock.Body(), "authorizer_id", authorizerRefs, "aws_apigatewayv2_authorizer", r.AuthorizerID)
+			}
+			if len(r.AuthorizationScopes) > 0 {
+				rBlock.Body().SetAttributeValue("authorization_scopes", g.stringList(r.AuthorizationScopes))
+			}
+			if r.ApiKeyRequired {
+				rBlock.Body().SetAttributeValue("api_key_required", cty.True)
+			}
+			if r.OperationName != "" {
+				rBlock.Body().SetAttributeValue("operation_name", cty.StringVal(r.OperationName))
+			}
+		}
 
-			// Listener Rules
-			for _, rule := range listener.Rules {
-				ruleResourceType := "aws_lb_listener_rule"
-				// A bit of a hack to create a unique name. A better approach might be needed.
-				ruleResourceName := fmt.Sprintf("%s_rule_%s", listenerResourceName, rule.Priority)
-				g.appendImportBlock(importBody, ruleResourceType+"."+ruleResourceName, rule.Arn)
-				ruleBlock := g.appendResourceBlock(resourceBody, ruleResourceType, ruleResourceName)
-				listenerRef := listenerRefs[listener.Arn]
-				ruleBlock.Body().SetAttributeRaw("listener_arn", hclwrite.TokensForTraversal(append(listenerRef, hcl.TraverseAttr{Name: "arn"})))
-				ruleBlock.Body().SetAttributeValue("priority", cty.StringVal(rule.Priority))
+		for _, st := range api.Stages {
+			stResourceType := "aws_apigatewayv2_stage"
+			stResourceName := g.sanitize(resourceName, st.Name)
+			stageRefs[api.ID+"/"+st.Name] = stResourceName
+			g.appendImportBlock(importBody, stResourceType+"."+stResourceName, api.ID+"/"+st.Name)
+			stBlock := g.appendResourceBlock(resourceBody, stResourceType, stResourceName)
+			stBlock.Body().SetAttributeRaw("api_id", apiIDTokens)
+			stBlock.Body().SetAttributeValue("name", cty.StringVal(st.Name))
+			if st.AutoDeploy {
+				stBlock.Body().SetAttributeValue("auto_deploy", cty.True)
+			} else if st.DeploymentID != "" {
+				stBlock.Body().SetAttributeValue("deployment_id", cty.StringVal(st.DeploymentID))
+			}
+			if st.Description != "" {
+				stBlock.Body().SetAttributeValue("description", cty.StringVal(st.Description))
+			}
+			if len(st.StageVariables) > 0 {
+				stBlock.Body().SetAttributeValue("stage_variables", g.stringMap(st.StageVariables))
+			}
+			if als := st.AccessLogSettings; als != nil {
+				alsBlock := stBlock.Body().AppendNewBlock("access_log_settings", nil)
+				alsBlock.Body().SetAttributeValue("destination_arn", cty.StringVal(als.DestinationArn))
+				alsBlock.Body().SetAttributeValue("format", cty.StringVal(als.Format))
+			}
+			if len(st.Tags) > 0 {
+				g.appendTags(stBlock.Body(), st.Tags)
+			}
+		}
+	}
 
-				// Actions
-				for _, action := range rule.Actions {
-					actionBlock := ruleBlock.Body().AppendNewBlock("action", nil)
-					actionBlock.Body().SetAttributeValue("type", cty.StringVal(string(action.Type)))
-					if action.Forward != nil {
-						tgTraversal := tgRefs[action.Forward.TargetGroupArn]
-						actionBlock.Body().SetAttributeRaw("target_group_arn", hclwrite.TokensForTraversal(append(tgTraversal, hcl.TraverseAttr{Name: "arn"})))
-					}
-				}
+	for _, domain := range domains {
+		resourceType := "aws_apigatewayv2_domain_name"
+		resourceName := g.sanitize(domain.Name)
+		g.appendImportBlock(importBody, resourceType+"."+resourceName, domain.Name)
+		domainBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
+		domainBlock.Body().SetAttributeValue("domain_name", cty.StringVal(domain.Name))
+		dncBlock := domainBlock.Body().AppendNewBlock("domain_name_configuration", nil)
+		dncBlock.Body().SetAttributeValue("certificate_arn", cty.StringVal(domain.CertificateArn))
+		dncBlock.Body().SetAttributeValue("endpoint_type", cty.StringVal(domain.EndpointType))
+		dncBlock.Body().SetAttributeValue("security_policy", cty.StringVal(domain.SecurityPolicy))
+		if len(domain.Tags) > 0 {
+			g.appendTags(domainBlock.Body(), domain.Tags)
+		}
 
-				// Conditions
-				for _, cond := range rule.Conditions {
-					condBlock := ruleBlock.Body().AppendNewBlock("condition", nil)
-					if cond.Field == "host-header" {
-						hostHeaderBlock := condBlock.Body().AppendNewBlock("host_header", nil)
-						var vals []cty.Value
-						for _, v := range cond.Values {
-							vals = append(vals, cty.StringVal(v))
-						}
-						hostHeaderBlock.Body().SetAttributeValue("values", cty.ListVal(vals))
-					}
-					if cond.Field == "path-pattern" {
-						pathPatternBlock := condBlock.Body().AppendNewBlock("path_pattern", nil)
-						var vals []cty.Value
-						for _, v := range cond.Values {
-							vals = append(vals, cty.StringVal(v))
-						}
-						pathPatternBlock.Body().SetAttributeValue("values", cty.ListVal(vals))
-					}
-				}
+		for _, m := range domain.ApiMappings {
+			mResourceType := "aws_apigatewayv2_api_mapping"
+			mResourceName := g.sanitize(domain.Name, "root")
+			if m.MappingKey != "" {
+				mResourceName = g.sanitize(domain.Name, m.MappingKey)
+			}
+			g.appendImportBlock(importBody, mResourceType+"."+mResourceName, m.ID+"/"+domain.Name)
+			mBlock := g.appendResourceBlock(resourceBody, mResourceType, mResourceName)
+			g.setReferenceOrValue(mBlock.Body(), "api_id", apiRefs, "aws_apigatewayv2_api", m.APIID)
+			mBlock.Body().SetAttributeRaw("domain_name", g.reference(resourceType, resourceName, "id"))
+			if stResourceName, ok := stageRefs[m.APIID+"/"+m.Stage]; ok {
+				mBlock.Body().SetAttributeRaw("stage", g.reference("aws_apigatewayv2_stage", stResourceName, "id"))
+			} else {
+				mBlock.Body().SetAttributeValue("stage", cty.StringVal(m.Stage))
+			}
+			if m.MappingKey != "" {
+				mBlock.Body().SetAttributeValue("api_mapping_key", cty.StringVal(m.MappingKey))
 			}
 		}
 	}
@@ -373,52 +4054,79 @@ func (g *HCLGenerator) GenerateElbBlocks(lbs []*elbv2.LoadBalancer) (*hclwrite.F
 	return resourceFile, importFile, nil
 }
 
-// GenerateRdsBlocks はRDSリソースのresourceブロックとimportブロックを生成します。
-func (g *HCLGenerator) GenerateRdsBlocks(clusters []rds.DBCluster, instances []rds.DBInstance, pgs []rds.DBParameterGroup) (*hclwrite.File, *hclwrite.File, error) {
-	resourceFile := hclwrite.NewEmptyFile()
-	importFile := hclwrite.NewEmptyFile()
-	resourceBody := resourceFile.Body()
-	importBody := importFile.Body()
-
-	for _, cluster := range clusters {
-		resourceType := "aws_rds_cluster"
-		resourceName := g.sanitize(cluster.Identifier)
-		g.appendImportBlock(importBody, resourceType+"."+resourceName, cluster.Identifier)
-		clusterBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
-		clusterBlock.Body().SetAttributeValue("cluster_identifier", cty.StringVal(cluster.Identifier))
-		clusterBlock.Body().SetAttributeValue("engine", cty.StringVal(cluster.Engine))
-		clusterBlock.Body().SetAttributeValue("engine_mode", cty.StringVal(cluster.EngineMode))
-		if len(cluster.Tags) > 0 {
-			g.appendTags(clusterBlock.Body(), cluster.Tags)
-		}
+// appendApiGatewayV2IntegrationAttributes は aws_apigatewayv2_integration の属性を設定します。
+func (g *HCLGenerator) appendApiGatewayV2IntegrationAttributes(body *hclwrite.Body, in apigatewayv2.Integration, apiIDTokens hclwrite.Tokens) {
+	body.SetAttributeRaw("api_id", apiIDTokens)
+	body.SetAttributeValue("integration_type", cty.StringVal(in.Type))
+	if in.Subtype != "" {
+		body.SetAttributeValue("integration_subtype", cty.StringVal(in.Subtype))
 	}
-
-	for _, instance := range instances {
-		resourceType := "aws_db_instance"
-		resourceName := g.sanitize(instance.Identifier)
-		g.appendImportBlock(importBody, resourceType+"."+resourceName, instance.Identifier)
-		instanceBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
-		instanceBlock.Body().SetAttributeValue("identifier", cty.StringVal(instance.Identifier))
-		instanceBlock.Body().SetAttributeValue("engine", cty.StringVal(instance.Engine))
-		instanceBlock.Body().SetAttributeValue("instance_class", cty.StringVal(instance.InstanceClass))
-		if len(instance.Tags) > 0 {
-			g.appendTags(instanceBlock.Body(), instance.Tags)
-		}
+	if in.URI != "" {
+		body.SetAttributeValue("integration_uri", cty.StringVal(in.URI))
+	}
+	if in.Method != "" {
+		body.SetAttributeValue("integration_method", cty.StringVal(in.Method))
+	}
+	if in.ConnectionType != "" && in.ConnectionType != "INTERNET" {
+		body.SetAttributeValue("connection_type", cty.StringVal(in.ConnectionType))
+		body.SetAttributeValue("connection_id", cty.StringVal(in.ConnectionID))
+	}
+	if in.CredentialsArn != "" {
+		body.SetAttributeValue("credentials_arn", cty.StringVal(in.CredentialsArn))
 	}
+	if in.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(in.Description))
+	}
+	if in.PayloadFormatVersion != "" {
+		body.SetAttributeValue("payload_format_version", cty.StringVal(in.PayloadFormatVersion))
+	}
+	if in.PassthroughBehavior != "" {
+		body.SetAttributeValue("passthrough_behavior", cty.StringVal(in.PassthroughBehavior))
+	}
+	if in.TemplateSelectionExpression != "" {
+		body.SetAttributeValue("template_selection_expression", cty.StringVal(in.TemplateSelectionExpression))
+	}
+	if in.TimeoutInMillis > 0 {
+		body.SetAttributeValue("timeout_milliseconds", cty.NumberIntVal(int64(in.TimeoutInMillis)))
+	}
+	if len(in.RequestParameters) > 0 {
+		body.SetAttributeValue("request_parameters", g.stringMap(in.RequestParameters))
+	}
+	if len(in.RequestTemplates) > 0 {
+		body.SetAttributeValue("request_templates", g.stringMap(in.RequestTemplates))
+	}
+}
 
-	for _, pg := range pgs {
-		resourceType := "aws_db_parameter_group"
-		resourceName := g.sanitize(pg.Name)
-		g.appendImportBlock(importBody, resourceType+"."+resourceName, pg.Name)
-		pgBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
-		pgBlock.Body().SetAttributeValue("name", cty.StringVal(pg.Name))
-		pgBlock.Body().SetAttributeValue("family", cty.StringVal(pg.Family))
-		if len(pg.Tags) > 0 {
-			g.appendTags(pgBlock.Body(), pg.Tags)
+// uniqueResourceNames は ids[i] から names[i] を sanitize したリソース名への対応を返します。
+// sanitize した名前が重複する場合は、それぞれの名前に ID を付けて区別します。
+func (g *HCLGenerator) uniqueResourceNames(names, ids []string) map[string]string {
+	counts := make(map[string]int)
+	for _, name := range names {
+		counts[g.sanitize(name)]++
+	}
+	refs := make(map[string]string)
+	for i, name := range names {
+		if counts[g.sanitize(name)] > 1 {
+			refs[ids[i]] = g.sanitize(name, ids[i])
+			continue
 		}
+		refs[ids[i]] = g.sanitize(name)
 	}
+	return refs
+}
 
-	return resourceFile, importFile, nil
+// interpolation は "<prefix>${<ref>}" のテンプレート文字列のトークンを返します。
+func (g *HCLGenerator) interpolation(prefix string, ref hclwrite.Tokens) hclwrite.Tokens {
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOQuote, Bytes: []byte(`"`)},
+		{Type: hclsyntax.TokenQuotedLit, Bytes: []byte(prefix)},
+		{Type: hclsyntax.TokenTemplateInterp, Bytes: []byte(`${`)},
+	}
+	tokens = append(tokens, ref...)
+	return append(tokens,
+		&hclwrite.Token{Type: hclsyntax.TokenTemplateSeqEnd, Bytes: []byte(`}`)},
+		&hclwrite.Token{Type: hclsyntax.TokenCQuote, Bytes: []byte(`"`)},
+	)
 }
 
 func (g *HCLGenerator) appendImportBlock(body *hclwrite.Body, to, id string) {
@@ -448,74 +4156,636 @@ func (g *HCLGenerator) appendTags(body *hclwrite.Body, tags map[string]string) {
 	body.SetAttributeValue("tags", cty.MapVal(tagMap))
 }
 
-func (g *HCLGenerator) sanitize(name string) string {
-	return strings.ReplaceAll(name, "-", "_")
+// sanitize は名前(複数指定した場合は "_" で連結したもの)を Terraform のリソース名に使える識別子に変換します。
+// 英数字以外の文字は "_" に置き換えて連続する "_" をまとめ、先頭が数字の場合や空になる場合は "_" を前置します。
+// 例えば ("my-api", "GET /users/{id}") は "my_api_GET_users_id"、"2024-api" は "_2024_api" になります。
+func (g *HCLGenerator) sanitize(parts ...string) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, strings.Join(parts, "_"))
+	for strings.Contains(name, "__") {
+		name = strings.ReplaceAll(name, "__", "_")
+	}
+	name = strings.Trim(name, "_")
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// reference は resourceType.resourceName.attr 形式の参照トークンを返します。
+func (g *HCLGenerator) reference(resourceType, resourceName, attr string) hclwrite.Tokens {
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+		hcl.TraverseAttr{Name: attr},
+	})
+}
+
+// setReferenceOrValue はIDが同じ実行で生成されたリソースであれば参照を、そうでなければIDの文字列を設定します。
+func (g *HCLGenerator) setReferenceOrValue(body *hclwrite.Body, name string, refs map[string]string, resourceType, id string) {
+	if resourceName, ok := refs[id]; ok {
+		body.SetAttributeRaw(name, g.reference(resourceType, resourceName, "id"))
+		return
+	}
+	body.SetAttributeValue(name, cty.StringVal(id))
+}
+
+// referenceList はIDのリストを、参照可能なものは参照に置き換えたタプルのトークンとして返します。
+func (g *HCLGenerator) referenceList(ids []string, refs map[string]string, resourceType, attr string) hclwrite.Tokens {
+	var elems []hclwrite.Tokens
+	for _, id := range ids {
+		if resourceName, ok := refs[id]; ok {
+			elems = append(elems, g.reference(resourceType, resourceName, attr))
+		} else {
+			elems = append(elems, hclwrite.TokensForValue(cty.StringVal(id)))
+		}
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+// fileFunction は <funcName>("${path.module}/<relativePath>") のトークンを返します。
+// funcNameにはfileやfilebase64を指定します。
+func (g *HCLGenerator) fileFunction(funcName, relativePath string) hclwrite.Tokens {
+	pathTokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOQuote, Bytes: []byte(`"`)},
+		{Type: hclsyntax.TokenTemplateInterp, Bytes: []byte(`${`)},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte(`path`)},
+		{Type: hclsyntax.TokenDot, Bytes: []byte(`.`)},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte(`module`)},
+		{Type: hclsyntax.TokenTemplateSeqEnd, Bytes: []byte(`}`)},
+		{Type: hclsyntax.TokenQuotedLit, Bytes: []byte("/" + relativePath)},
+		{Type: hclsyntax.TokenCQuote, Bytes: []byte(`"`)},
+	}
+	return hclwrite.TokensForFunctionCall(funcName, pathTokens)
+}
+
+// appendIgnoreChanges は traversals を lifecycle { ignore_changes = [...] } に追加します。
+// lifecycle ブロックが既にある場合は、そのブロックの ignore_changes に追記します。
+func (g *HCLGenerator) appendIgnoreChanges(body *hclwrite.Body, traversals ...hcl.Traversal) {
+	if len(traversals) == 0 {
+		return
+	}
+	lifecycleBlock := body.FirstMatchingBlock("lifecycle", nil)
+	if lifecycleBlock == nil {
+		lifecycleBlock = body.AppendNewBlock("lifecycle", nil)
+	}
+	var elems []hclwrite.Tokens
+	if attr := lifecycleBlock.Body().GetAttribute("ignore_changes"); attr != nil {
+		elems = tupleElements(attr.Expr().BuildTokens(nil))
+	}
+	for _, traversal := range traversals {
+		elems = append(elems, hclwrite.TokensForTraversal(traversal))
+	}
+	lifecycleBlock.Body().SetAttributeRaw("ignore_changes", hclwrite.TokensForTuple(elems))
+}
+
+// ignoreAttrs はトップレベルの属性名を ignore_changes に指定するトラバーサルに変換します。
+func (g *HCLGenerator) ignoreAttrs(names ...string) []hcl.Traversal {
+	var traversals []hcl.Traversal
+	for _, name := range names {
+		traversals = append(traversals, hcl.Traversal{hcl.TraverseRoot{Name: name}})
+	}
+	return traversals
+}
+
+// tupleElements は appendIgnoreChanges が出力したタプルのトークンを要素ごとのトークンに分割します。
+func tupleElements(tokens hclwrite.Tokens) []hclwrite.Tokens {
+	var elems []hclwrite.Tokens
+	var elem hclwrite.Tokens
+	depth := 0
+	for _, t := range tokens {
+		switch t.Type {
+		case hclsyntax.TokenOBrack:
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case hclsyntax.TokenCBrack:
+			depth--
+			if depth == 0 {
+				continue
+			}
+		case hclsyntax.TokenComma:
+			if depth == 1 {
+				elems = append(elems, elem)
+				elem = nil
+				continue
+			}
+		case hclsyntax.TokenNewline:
+			continue
+		}
+		elem = append(elem, t)
+	}
+	if len(elem) > 0 {
+		elems = append(elems, elem)
+	}
+	return elems
+}
+
+// stringMap は文字列のマップをcty.Valueのマップに変換します。空の場合は空マップを返します。
+func (g *HCLGenerator) stringMap(values map[string]string) cty.Value {
+	if len(values) == 0 {
+		return cty.MapValEmpty(cty.String)
+	}
+	vals := make(map[string]cty.Value)
+	for k, v := range values {
+		vals[k] = cty.StringVal(v)
+	}
+	return cty.MapVal(vals)
+}
+
+// documentValue はJSONドキュメント相当のmap/sliceのツリーをcty.Valueに変換します。
+// 要素の型が揃わないため、オブジェクトとタプルとして扱います。
+func (g *HCLGenerator) documentValue(v interface{}) cty.Value {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+		attrs := make(map[string]cty.Value)
+		for k, elem := range v {
+			attrs[k] = g.documentValue(elem)
+		}
+		return cty.ObjectVal(attrs)
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal
+		}
+		var elems []cty.Value
+		for _, elem := range v {
+			elems = append(elems, g.documentValue(elem))
+		}
+		return cty.TupleVal(elems)
+	case string:
+		return cty.StringVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case int64:
+		return cty.NumberIntVal(v)
+	case float64:
+		return cty.NumberFloatVal(v)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+// stringList は文字列のスライスをcty.Valueのリストに変換します。空の場合は空リストを返します。
+func (g *HCLGenerator) stringList(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	var vals []cty.Value
+	for _, v := range values {
+		vals = append(vals, cty.StringVal(v))
+	}
+	return cty.ListVal(vals)
 }
 
 // GenerateIamBlocks はIAMリソースのresourceブロックとimportブロックを生成します。
-func (g *HCLGenerator) GenerateIamBlocks(policies []iam.Policy, roles []iam.Role) (*hclwrite.File, *hclwrite.File, error) {
+// policyFormat でポリシードキュメントの出力形式を指定します。
+func (g *HCLGenerator) GenerateIamBlocks(policies []iam.Policy, roles []iam.Role, policyFormat PolicyFormat) (*hclwrite.File, *hclwrite.File, error) {
 	resourceFile := hclwrite.NewEmptyFile()
 	importFile := hclwrite.NewEmptyFile()
 	resourceBody := resourceFile.Body()
 	importBody := importFile.Body()
 
-	policyData := make(map[string]struct {
-		Name      string
-		Traversal hcl.Traversal
-	})
+	// refs.Policies は同じファイルに出力する aws_iam_policy の ARN の集合です。
+	refs := IamReferences{Policies: make(map[string]struct{})}
 
 	// IAM Policies
 	for _, p := range policies {
 		resourceType := "aws_iam_policy"
-		resourceName := p.Name
+		resourceName := g.sanitize(p.Name)
 		g.appendImportBlock(importBody, resourceType+"."+resourceName, p.Arn)
 		policyBlock := g.appendResourceBlock(resourceBody, resourceType, resourceName)
 		policyBlock.Body().SetAttributeValue("name", cty.StringVal(p.Name))
-		policyBlock.Body().SetAttributeValue("policy", cty.StringVal(p.PolicyDocument))
-
-		refParts := strings.SplitN(resourceType+"."+resourceName, ".", 2)
-		policyData[p.Arn] = struct {
-			Name      string
-			Traversal hcl.Traversal
-		}{
-			Name: resourceName,
-			Traversal: hcl.Traversal{
-				hcl.TraverseRoot{Name: refParts[0]},
-				hcl.TraverseAttr{Name: refParts[1]},
-			},
-		}
+		g.setPolicyDocument(resourceBody, policyBlock.Body(), "policy", p.PolicyDocument, "policy_"+resourceName, policyFormat)
+		refs.Policies[p.Arn] = struct{}{}
 	}
 
 	// IAM Roles
 	for _, r := range roles {
+		if r.ServiceLinked {
+			g.appendServiceLinkedRole(resourceBody, importBody, r)
+			continue
+		}
 		roleResourceType := "aws_iam_role"
-		roleResourceName := r.Name
+		roleResourceName := g.sanitize(r.Name)
 		g.appendImportBlock(importBody, roleResourceType+"."+roleResourceName, r.Name) // IAM Role ID is its name
 		roleBlock := g.appendResourceBlock(resourceBody, roleResourceType, roleResourceName)
 		roleBlock.Body().SetAttributeValue("name", cty.StringVal(r.Name))
-		roleBlock.Body().SetAttributeValue("assume_role_policy", cty.StringVal(r.AssumeRolePolicy))
+		if r.Path != "" && r.Path != "/" {
+			roleBlock.Body().SetAttributeValue("path", cty.StringVal(r.Path))
+		}
+		if r.Description != "" {
+			roleBlock.Body().SetAttributeValue("description", cty.StringVal(r.Description))
+		}
+		if r.MaxSessionDuration != 0 {
+			roleBlock.Body().SetAttributeValue("max_session_duration", cty.NumberIntVal(int64(r.MaxSessionDuration)))
+		}
+		g.setPolicyDocument(resourceBody, roleBlock.Body(), "assume_role_policy", r.AssumeRolePolicy, "assume_role_"+roleResourceName, policyFormat)
+		if r.PermissionsBoundary != "" {
+			roleBlock.Body().SetAttributeRaw("permissions_boundary", g.iamPolicyArnTokens(r.PermissionsBoundary, refs))
+		}
+		if len(r.Tags) > 0 {
+			g.appendTags(roleBlock.Body(), r.Tags)
+		}
+		roleNameTokens := g.reference(roleResourceType, roleResourceName, "name")
 
-		// IAM Role Policy Attachments
+		// IAM Role Policy Attachments (同じ実行で出力しないポリシーは ARN をそのまま設定します)
 		for _, policyArn := range r.AttachedPolicyArns {
-			if data, ok := policyData[policyArn]; ok {
-				attachmentResourceName := fmt.Sprintf("%s_%s_attachment", r.Name, data.Name)
-				attachmentResourceType := "aws_iam_role_policy_attachment"
-				// No import block for attachments, they are managed with the role.
-				attachmentBlock := g.appendResourceBlock(resourceBody, attachmentResourceType, attachmentResourceName)
+			policyResourceName := g.sanitize(path.Base(policyArn))
+			attachmentResourceName := fmt.Sprintf("%s_%s_attachment", roleResourceName, policyResourceName)
+			attachmentResourceType := "aws_iam_role_policy_attachment"
+			g.appendImportBlock(importBody, attachmentResourceType+"."+attachmentResourceName, r.Name+"/"+policyArn)
+			attachmentBlock := g.appendResourceBlock(resourceBody, attachmentResourceType, attachmentResourceName)
+			attachmentBlock.Body().SetAttributeRaw("role", roleNameTokens)
+			attachmentBlock.Body().SetAttributeRaw("policy_arn", g.iamPolicyArnTokens(policyArn, refs))
+		}
+
+		// Inline Policies (import ID は "ロール名:ポリシー名" の形式です)
+		for _, p := range r.InlinePolicies {
+			inlineResourceName := fmt.Sprintf("%s_%s", roleResourceName, g.sanitize(p.Name))
+			g.appendImportBlock(importBody, "aws_iam_role_policy."+inlineResourceName, r.Name+":"+p.Name)
+			inlineBlock := g.appendResourceBlock(resourceBody, "aws_iam_role_policy", inlineResourceName)
+			inlineBlock.Body().SetAttributeValue("name", cty.StringVal(p.Name))
+			inlineBlock.Body().SetAttributeRaw("role", g.reference(roleResourceType, roleResourceName, "id"))
+			g.setPolicyDocument(resourceBody, inlineBlock.Body(), "policy", p.PolicyDocument, "role_policy_"+inlineResourceName, policyFormat)
+		}
+
+		// Instance Profiles
+		for _, ip := range r.InstanceProfiles {
+			profileResourceName := g.sanitize(ip.Name)
+			g.appendImportBlock(importBody, "aws_iam_instance_profile."+profileResourceName, ip.Name)
+			profileBlock := g.appendResourceBlock(resourceBody, "aws_iam_instance_profile", profileResourceName)
+			profileBlock.Body().SetAttributeValue("name", cty.StringVal(ip.Name))
+			if ip.Path != "" && ip.Path != "/" {
+				profileBlock.Body().SetAttributeValue("path", cty.StringVal(ip.Path))
+			}
+			profileBlock.Body().SetAttributeRaw("role", roleNameTokens)
+			if len(ip.Tags) > 0 {
+				g.appendTags(profileBlock.Body(), ip.Tags)
+			}
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// appendServiceLinkedRole はサービスにリンクされたロールを aws_iam_service_linked_role として追加します。
+// ロール名の "_" 以降はカスタムサフィックスとして扱います。
+func (g *HCLGenerator) appendServiceLinkedRole(resourceBody, importBody *hclwrite.Body, r iam.Role) {
+	resourceName := g.sanitize(r.Name)
+	g.appendImportBlock(importBody, "aws_iam_service_linked_role."+resourceName, r.Arn)
+	roleBlock := g.appendResourceBlock(resourceBody, "aws_iam_service_linked_role", resourceName)
+	roleBlock.Body().SetAttributeValue("aws_service_name", cty.StringVal(r.AWSServiceName))
+	if i := strings.Index(r.Name, "_"); i >= 0 {
+		roleBlock.Body().SetAttributeValue("custom_suffix", cty.StringVal(r.Name[i+1:]))
+	}
+	if r.Description != "" {
+		roleBlock.Body().SetAttributeValue("description", cty.StringVal(r.Description))
+	}
+	if len(r.Tags) > 0 {
+		g.appendTags(roleBlock.Body(), r.Tags)
+	}
+}
+
+// SamlMetadataPath は SAML プロバイダーのメタデータドキュメントを書き出すファイルのパスを返します。
+func (g *HCLGenerator) SamlMetadataPath(name string) string {
+	return path.Join("saml_metadata", name+".xml")
+}
+
+// GenerateIamProviderBlocks は OIDC プロバイダーと SAML プロバイダーのブロックを生成します。
+// SAML のメタデータドキュメントは SamlMetadataPath のファイルを file() で参照します。
+func (g *HCLGenerator) GenerateIamProviderBlocks(oidcProviders []iam.OpenIDConnectProvider, samlProviders []iam.SAMLProvider) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, p := range oidcProviders {
+		resourceName := g.sanitize(strings.ReplaceAll(p.Url, "/", "_"))
+		g.appendImportBlock(importBody, "aws_iam_openid_connect_provider."+resourceName, p.Arn)
+		providerBlock := g.appendResourceBlock(resourceBody, "aws_iam_openid_connect_provider", resourceName)
+		// API は URL をスキーム無しで返すため https:// を補います。
+		providerBlock.Body().SetAttributeValue("url", cty.StringVal("https://"+p.Url))
+		providerBlock.Body().SetAttributeValue("client_id_list", g.stringList(p.ClientIDList))
+		providerBlock.Body().SetAttributeValue("thumbprint_list", g.stringList(p.ThumbprintList))
+		if len(p.Tags) > 0 {
+			g.appendTags(providerBlock.Body(), p.Tags)
+		}
+	}
+
+	for _, p := range samlProviders {
+		resourceName := g.sanitize(p.Name)
+		g.appendImportBlock(importBody, "aws_iam_saml_provider."+resourceName, p.Arn)
+		providerBlock := g.appendResourceBlock(resourceBody, "aws_iam_saml_provider", resourceName)
+		providerBlock.Body().SetAttributeValue("name", cty.StringVal(p.Name))
+		providerBlock.Body().SetAttributeRaw("saml_metadata_document", g.fileFunction("file", g.SamlMetadataPath(p.Name)))
+		if len(p.Tags) > 0 {
+			g.appendTags(providerBlock.Body(), p.Tags)
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// PolicyFormat は IAM ポリシードキュメントの出力形式です。
+type PolicyFormat string
+
+const (
+	// PolicyFormatString は JSON 文字列のまま出力します。
+	PolicyFormatString PolicyFormat = "string"
+	// PolicyFormatJSONEncode は jsonencode() に HCL のオブジェクト構文で渡す形で出力します。
+	PolicyFormatJSONEncode PolicyFormat = "jsonencode"
+	// PolicyFormatDocument は data "aws_iam_policy_document" の statement ブロックとして出力し、その json を参照します。
+	PolicyFormatDocument PolicyFormat = "policy_document"
+)
 
-				roleRefParts := strings.SplitN(roleResourceType+"."+roleResourceName, ".", 2)
-				roleTraversal := hcl.Traversal{
-					hcl.TraverseRoot{Name: roleRefParts[0]},
-					hcl.TraverseAttr{Name: roleRefParts[1]},
+// setPolicyDocument はポリシードキュメントを policyFormat に従って attrName に設定します。
+// PolicyFormatDocument の場合は dataName という名前の data ブロックを resourceBody に追加します。
+// data ブロックはポリシーの種類をまたいで同じ名前空間になるため、dataName には種類ごとの接頭辞を付けます。
+// JSON として解釈できないドキュメントは文字列のまま出力します。
+func (g *HCLGenerator) setPolicyDocument(resourceBody, body *hclwrite.Body, attrName, document, dataName string, policyFormat PolicyFormat) {
+	var doc map[string]interface{}
+	if policyFormat == PolicyFormatString || policyFormat == "" || json.Unmarshal([]byte(document), &doc) != nil {
+		body.SetAttributeValue(attrName, cty.StringVal(document))
+		return
+	}
+	normalizePolicyDocument(doc)
+
+	if policyFormat == PolicyFormatJSONEncode {
+		body.SetAttributeRaw(attrName, hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(g.documentValue(doc))))
+		return
+	}
+
+	dataBlock := resourceBody.AppendNewBlock("data", []string{"aws_iam_policy_document", dataName})
+	resourceBody.AppendNewline()
+	g.appendPolicyDocumentStatements(dataBlock.Body(), doc)
+	body.SetAttributeRaw(attrName, hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "data"},
+		hcl.TraverseAttr{Name: "aws_iam_policy_document"},
+		hcl.TraverseAttr{Name: dataName},
+		hcl.TraverseAttr{Name: "json"},
+	}))
+}
+
+// normalizePolicyDocument は単一の値でも配列でも書ける Statement, Action, Resource,
+// Principal, Condition の値を配列にそろえます。
+func normalizePolicyDocument(doc map[string]interface{}) {
+	if statement, ok := doc["Statement"].(map[string]interface{}); ok {
+		doc["Statement"] = []interface{}{statement}
+	}
+	statements, _ := doc["Statement"].([]interface{})
+	for _, st := range statements {
+		statement, ok := st.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+			if v, ok := statement[key].(string); ok {
+				statement[key] = []interface{}{v}
+			}
+		}
+		for _, key := range []string{"Principal", "NotPrincipal"} {
+			if principals, ok := statement[key].(map[string]interface{}); ok {
+				for k, v := range principals {
+					if id, ok := v.(string); ok {
+						principals[k] = []interface{}{id}
+					}
+				}
+			}
+		}
+		if conditions, ok := statement["Condition"].(map[string]interface{}); ok {
+			for _, c := range conditions {
+				variables, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for k, v := range variables {
+					if _, ok := v.([]interface{}); !ok {
+						variables[k] = []interface{}{v}
+					}
 				}
+			}
+		}
+	}
+}
 
-				policyTraversal := data.Traversal
+// appendPolicyDocumentStatements は正規化済みのポリシードキュメントを aws_iam_policy_document の属性と statement ブロックに変換します。
+func (g *HCLGenerator) appendPolicyDocumentStatements(body *hclwrite.Body, doc map[string]interface{}) {
+	if version, ok := doc["Version"].(string); ok {
+		body.SetAttributeValue("version", cty.StringVal(version))
+	}
+	if id, ok := doc["Id"].(string); ok {
+		body.SetAttributeValue("policy_id", cty.StringVal(id))
+	}
 
-				attachmentBlock.Body().SetAttributeRaw("role", hclwrite.TokensForTraversal(append(roleTraversal, hcl.TraverseAttr{Name: "name"})))
-				attachmentBlock.Body().SetAttributeRaw("policy_arn", hclwrite.TokensForTraversal(append(policyTraversal, hcl.TraverseAttr{Name: "arn"})))
+	statements, _ := doc["Statement"].([]interface{})
+	for _, st := range statements {
+		statement, ok := st.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		stBody := body.AppendNewBlock("statement", nil).Body()
+		if sid, ok := statement["Sid"].(string); ok && sid != "" {
+			stBody.SetAttributeValue("sid", cty.StringVal(sid))
+		}
+		if effect, ok := statement["Effect"].(string); ok {
+			stBody.SetAttributeValue("effect", cty.StringVal(effect))
+		}
+		for _, field := range []struct{ key, attr string }{
+			{"Action", "actions"},
+			{"NotAction", "not_actions"},
+			{"Resource", "resources"},
+			{"NotResource", "not_resources"},
+		} {
+			if values, ok := statement[field.key].([]interface{}); ok {
+				stBody.SetAttributeValue(field.attr, g.stringList(policyStrings(values)))
+			}
+		}
+		for _, field := range []struct{ key, block string }{
+			{"Principal", "principals"},
+			{"NotPrincipal", "not_principals"},
+		} {
+			switch principal := statement[field.key].(type) {
+			case string:
+				// "Principal": "*" は type, identifiers ともに "*" として表現します。
+				principalBody := stBody.AppendNewBlock(field.block, nil).Body()
+				principalBody.SetAttributeValue("type", cty.StringVal(principal))
+				principalBody.SetAttributeValue("identifiers", g.stringList([]string{principal}))
+			case map[string]interface{}:
+				for _, principalType := range sortedKeys(principal) {
+					identifiers, _ := principal[principalType].([]interface{})
+					principalBody := stBody.AppendNewBlock(field.block, nil).Body()
+					principalBody.SetAttributeValue("type", cty.StringVal(principalType))
+					principalBody.SetAttributeValue("identifiers", g.stringList(policyStrings(identifiers)))
+				}
 			}
 		}
+		if conditions, ok := statement["Condition"].(map[string]interface{}); ok {
+			for _, test := range sortedKeys(conditions) {
+				variables, _ := conditions[test].(map[string]interface{})
+				for _, variable := range sortedKeys(variables) {
+					values, _ := variables[variable].([]interface{})
+					conditionBody := stBody.AppendNewBlock("condition", nil).Body()
+					conditionBody.SetAttributeValue("test", cty.StringVal(test))
+					conditionBody.SetAttributeValue("variable", cty.StringVal(variable))
+					conditionBody.SetAttributeValue("values", g.stringList(policyStrings(values)))
+				}
+			}
+		}
+	}
+}
+
+// policyStrings はポリシーの値を文字列にそろえます。Condition の数値や真偽値も文字列として扱います。
+func policyStrings(values []interface{}) []string {
+	var result []string
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			result = append(result, v)
+		default:
+			b, _ := json.Marshal(v)
+			result = append(result, string(b))
+		}
+	}
+	return result
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IamReferences は同じ実行で出力される IAM リソースを表し、該当するものは参照で出力します。
+// Policies はカスタマー管理ポリシーの ARN、Users と Groups は名前をキーに持ちます。
+type IamReferences struct {
+	Policies map[string]struct{}
+	Users    map[string]struct{}
+	Groups   map[string]struct{}
+}
+
+// GenerateIamUserBlocks は IAM ユーザーと、そのログインプロファイル、ポリシーのアタッチ、
+// グループへの所属 (aws_iam_user_group_membership) のブロックを生成します。
+func (g *HCLGenerator) GenerateIamUserBlocks(users []iam.User, refs IamReferences) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, u := range users {
+		userResourceName := g.sanitize(u.Name)
+		g.appendImportBlock(importBody, "aws_iam_user."+userResourceName, u.Name)
+		userBlock := g.appendResourceBlock(resourceBody, "aws_iam_user", userResourceName)
+		userBlock.Body().SetAttributeValue("name", cty.StringVal(u.Name))
+		if u.Path != "" && u.Path != "/" {
+			userBlock.Body().SetAttributeValue("path", cty.StringVal(u.Path))
+		}
+		if u.PermissionsBoundary != "" {
+			userBlock.Body().SetAttributeRaw("permissions_boundary", g.iamPolicyArnTokens(u.PermissionsBoundary, refs))
+		}
+		if len(u.Tags) > 0 {
+			g.appendTags(userBlock.Body(), u.Tags)
+		}
+		userNameTokens := g.reference("aws_iam_user", userResourceName, "name")
+
+		// Login profile (パスワードは取得できないため、Terraform のドキュメントに従い ignore_changes を設定します)
+		if u.LoginProfile != nil {
+			g.appendImportBlock(importBody, "aws_iam_user_login_profile."+userResourceName, u.Name)
+			profileBlock := g.appendResourceBlock(resourceBody, "aws_iam_user_login_profile", userResourceName)
+			profileBlock.Body().SetAttributeRaw("user", userNameTokens)
+			profileBlock.Body().SetAttributeValue("password_reset_required", cty.BoolVal(u.LoginProfile.PasswordResetRequired))
+			g.appendIgnoreChanges(profileBlock.Body(), g.ignoreAttrs("password_length", "password_reset_required", "pgp_key")...)
+		}
+
+		// Policy attachments
+		for _, policyArn := range u.AttachedPolicyArns {
+			attachmentResourceName := fmt.Sprintf("%s_%s_attachment", userResourceName, g.sanitize(path.Base(policyArn)))
+			g.appendImportBlock(importBody, "aws_iam_user_policy_attachment."+attachmentResourceName, u.Name+"/"+policyArn)
+			attachmentBlock := g.appendResourceBlock(resourceBody, "aws_iam_user_policy_attachment", attachmentResourceName)
+			attachmentBlock.Body().SetAttributeRaw("user", userNameTokens)
+			attachmentBlock.Body().SetAttributeRaw("policy_arn", g.iamPolicyArnTokens(policyArn, refs))
+		}
+
+		// Group membership (import ID は "ユーザー名/グループ名1/グループ名2..." の形式です)
+		if len(u.GroupNames) > 0 {
+			membershipResourceName := userResourceName + "_groups"
+			g.appendImportBlock(importBody, "aws_iam_user_group_membership."+membershipResourceName, u.Name+"/"+strings.Join(u.GroupNames, "/"))
+			membershipBlock := g.appendResourceBlock(resourceBody, "aws_iam_user_group_membership", membershipResourceName)
+			membershipBlock.Body().SetAttributeRaw("user", userNameTokens)
+			membershipBlock.Body().SetAttributeRaw("groups", g.iamNameList("aws_iam_group", u.GroupNames, refs.Groups))
+		}
+	}
+
+	return resourceFile, importFile, nil
+}
+
+// GenerateIamGroupBlocks は IAM グループとポリシーのアタッチのブロックを生成します。
+// exclusiveMembership が true の場合、メンバーを aws_iam_group_membership としても出力します。
+// aws_iam_group_membership はグループのメンバーを排他的に管理するため、
+// aws_iam_user_group_membership と同時には出力しません。
+func (g *HCLGenerator) GenerateIamGroupBlocks(groups []iam.Group, refs IamReferences, exclusiveMembership bool) (*hclwrite.File, *hclwrite.File, error) {
+	resourceFile := hclwrite.NewEmptyFile()
+	importFile := hclwrite.NewEmptyFile()
+	resourceBody := resourceFile.Body()
+	importBody := importFile.Body()
+
+	for _, gr := range groups {
+		groupResourceName := g.sanitize(gr.Name)
+		g.appendImportBlock(importBody, "aws_iam_group."+groupResourceName, gr.Name)
+		groupBlock := g.appendResourceBlock(resourceBody, "aws_iam_group", groupResourceName)
+		groupBlock.Body().SetAttributeValue("name", cty.StringVal(gr.Name))
+		if gr.Path != "" && gr.Path != "/" {
+			groupBlock.Body().SetAttributeValue("path", cty.StringVal(gr.Path))
+		}
+		groupNameTokens := g.reference("aws_iam_group", groupResourceName, "name")
+
+		// Policy attachments
+		for _, policyArn := range gr.AttachedPolicyArns {
+			attachmentResourceName := fmt.Sprintf("%s_%s_attachment", groupResourceName, g.sanitize(path.Base(policyArn)))
+			g.appendImportBlock(importBody, "aws_iam_group_policy_attachment."+attachmentResourceName, gr.Name+"/"+policyArn)
+			attachmentBlock := g.appendResourceBlock(resourceBody, "aws_iam_group_policy_attachment", attachmentResourceName)
+			attachmentBlock.Body().SetAttributeRaw("group", groupNameTokens)
+			attachmentBlock.Body().SetAttributeRaw("policy_arn", g.iamPolicyArnTokens(policyArn, refs))
+		}
+
+		// Group membership (importには対応していないため、resourceブロックのみ出力します)
+		if exclusiveMembership && len(gr.MemberNames) > 0 {
+			membershipBlock := g.appendResourceBlock(resourceBody, "aws_iam_group_membership", groupResourceName+"_members")
+			membershipBlock.Body().SetAttributeValue("name", cty.StringVal(gr.Name+"-members"))
+			membershipBlock.Body().SetAttributeRaw("group", groupNameTokens)
+			membershipBlock.Body().SetAttributeRaw("users", g.iamNameList("aws_iam_user", gr.MemberNames, refs.Users))
+		}
 	}
 
 	return resourceFile, importFile, nil
-} 
+}
+
+// iamPolicyArnTokens は同じ実行で出力されるカスタマー管理ポリシーであれば参照を、それ以外は ARN をそのまま返します。
+func (g *HCLGenerator) iamPolicyArnTokens(policyArn string, refs IamReferences) hclwrite.Tokens {
+	if _, ok := refs.Policies[policyArn]; ok {
+		return g.reference("aws_iam_policy", g.sanitize(path.Base(policyArn)), "arn")
+	}
+	return hclwrite.TokensForValue(cty.StringVal(policyArn))
+}
+
+// iamNameList は同じ実行で出力されるユーザーまたはグループであれば参照を、それ以外は名前をそのまま並べます。
+func (g *HCLGenerator) iamNameList(resourceType string, names []string, refs map[string]struct{}) hclwrite.Tokens {
+	var elems []hclwrite.Tokens
+	for _, name := range names {
+		if _, ok := refs[name]; ok {
+			elems = append(elems, g.reference(resourceType, g.sanitize(name), "name"))
+		} else {
+			elems = append(elems, hclwrite.TokensForValue(cty.StringVal(name)))
+		}
+	}
+	return hclwrite.TokensForTuple(elems)
+}
@@ -4,6 +4,7 @@ package writer
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/hashicorp/hcl/v2/hclwrite"
 )
@@ -29,4 +30,15 @@ func (w *FileWriter) WriteFile(path string, file *hclwrite.File) error {
 		return fmt.Errorf("failed to write to file %s: %w", path, err)
 	}
 	return nil
-} 
+}
+
+// WriteRawFile は指定されたパスにバイト列をそのまま書き込みます。親ディレクトリが無ければ作成します。
+func (w *FileWriter) WriteRawFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write to file %s: %w", path, err)
+	}
+	return nil
+}